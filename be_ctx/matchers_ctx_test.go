@@ -2,11 +2,10 @@ package be_ctx_test
 
 import (
 	"context"
+	"testing"
 	"time"
 
-	. "github.com/onsi/ginkgo/v2"
-	. "github.com/onsi/gomega"
-
+	"github.com/expectto/be"
 	"github.com/expectto/be/be_ctx"
 	"github.com/expectto/be/types"
 )
@@ -54,113 +53,137 @@ func deadlineExceededCtx() context.Context {
 	return ctx
 }
 
-var _ = Describe("BeCtx", func() {
-	DescribeTable(
-		"should positively match",
-		func(matcher types.BeMatcher, actual any) {
-			// check gomega-compatible matching:
-			success, err := matcher.Match(actual)
-			Expect(err).Should(Succeed())
-			Expect(success).To(BeTrue())
-
-			// check gomock-compatible matching:
-			success = matcher.Matches(actual)
-			Expect(success).To(BeTrue())
-		},
-		// Ctx() with no args matches any context.Context:
-		Entry("background ctx is a ctx", be_ctx.Ctx(), plainCtx()),
-		Entry("TODO ctx is a ctx", be_ctx.Ctx(), context.TODO()),
-		Entry("value ctx is a ctx", be_ctx.Ctx(), valueCtx("k", "v")),
-		Entry("canceled ctx is a ctx", be_ctx.Ctx(), canceledCtx()),
-		// CtxWithValue: existence-only matching (no value arg):
-		Entry("ctx has value for key `foobar`", be_ctx.CtxWithValue("foobar"), valueCtx("foobar", 100)),
-		Entry("ctx has value for key `name`", be_ctx.CtxWithValue("name"), valueCtx("name", "alice")),
-		// CtxWithValue: exact value matching:
-		Entry("ctx value foobar==100", be_ctx.CtxWithValue("foobar", 100), valueCtx("foobar", 100)),
-		Entry("ctx value name==alice", be_ctx.CtxWithValue("name", "alice"), valueCtx("name", "alice")),
-		// CtxWithDeadline: exact deadline matching against the actual time.Time:
-		Entry("ctx deadline matches fixed deadline", be_ctx.CtxWithDeadline(fixedDeadline), deadlineCtx()),
-		// CtxWithError: cancellation / deadline-exceeded errors:
-		Entry("canceled ctx err is context.Canceled", be_ctx.CtxWithError(context.Canceled), canceledCtx()),
-		Entry(
-			"expired ctx err is context.DeadlineExceeded",
-			be_ctx.CtxWithError(context.DeadlineExceeded),
-			deadlineExceededCtx(),
-		),
-		Entry("live ctx err is nil", be_ctx.CtxWithError(nil), plainCtx()),
-	)
-
-	DescribeTable(
-		"should negatively match",
-		func(matcher types.BeMatcher, actual any) {
-			// check gomega-compatible matching:
-			success, err := matcher.Match(actual)
-			Expect(err).Should(Succeed())
-			Expect(success).To(BeFalse())
-
-			// check gomock-compatible matching:
-			success = matcher.Matches(actual)
-			Expect(success).To(BeFalse())
-		},
-		// not a context.Context at all:
-		Entry("string is not a ctx", be_ctx.CtxWithValue("k"), "not-a-ctx"),
-		Entry("int is not a ctx", be_ctx.CtxWithError(nil), 42),
-		// CtxWithValue: missing key:
-		Entry("ctx misses key `absent`", be_ctx.CtxWithValue("absent"), valueCtx("foobar", 100)),
-		Entry("background ctx has no values", be_ctx.CtxWithValue("foobar"), plainCtx()),
-		// CtxWithValue: wrong value:
-		Entry("ctx value foobar!=200", be_ctx.CtxWithValue("foobar", 200), valueCtx("foobar", 100)),
-		Entry("ctx value name!=bob", be_ctx.CtxWithValue("name", "bob"), valueCtx("name", "alice")),
-		// CtxWithDeadline: no deadline present:
-		Entry("background ctx has no deadline", be_ctx.CtxWithDeadline(fixedDeadline), plainCtx()),
-		// CtxWithDeadline: wrong deadline:
-		Entry(
-			"ctx deadline differs from expected",
-			be_ctx.CtxWithDeadline(fixedDeadline.Add(time.Hour)),
-			deadlineCtx(),
-		),
-		// CtxWithError: wrong / unexpected error state:
-		Entry("live ctx err is not context.Canceled", be_ctx.CtxWithError(context.Canceled), plainCtx()),
-		Entry("canceled ctx err is not DeadlineExceeded", be_ctx.CtxWithError(context.DeadlineExceeded), canceledCtx()),
-		// timeoutCtx does have a deadline but is still live (err == nil), so it
-		// must NOT match an expectation of context.Canceled:
-		Entry("timeout ctx err is not context.Canceled", be_ctx.CtxWithError(context.Canceled), timeoutCtx()),
-	)
-
-	DescribeTable("should return a valid failure message", func(matcher types.BeMatcher, actual any, message string) {
-		// FailureMessage is considered to be called after matching:
-		_, _ = matcher.Match(actual)
-
-		Expect(matcher.FailureMessage(actual)).To(Equal(message))
-	},
-		Entry("not a context.Context",
-			be_ctx.CtxWithError(nil), "not-a-ctx",
-			"Expected\n    <string>: not-a-ctx\nto be a ctx",
-		),
-		Entry(
-			"missing ctx value key",
-			be_ctx.CtxWithValue("absent"),
-			plainCtx(),
-			"Expected\n    <context.backgroundCtx>: {\n        emptyCtx: <suppressed context>,\n    }\nto have the ctx.value key=`absent`",
-		),
-	)
+func TestBeCtx(t *testing.T) {
+	t.Run("should positively match", func(t *testing.T) {
+		for _, tc := range []struct {
+			name    string
+			matcher types.BeMatcher
+			actual  any
+		}{
+			// Ctx() with no args matches any context.Context:
+			{"background ctx is a ctx", be_ctx.Ctx(), plainCtx()},
+			{"TODO ctx is a ctx", be_ctx.Ctx(), context.TODO()},
+			{"value ctx is a ctx", be_ctx.Ctx(), valueCtx("k", "v")},
+			{"canceled ctx is a ctx", be_ctx.Ctx(), canceledCtx()},
+			// CtxWithValue: existence-only matching (no value arg):
+			{"ctx has value for key `foobar`", be_ctx.CtxWithValue("foobar"), valueCtx("foobar", 100)},
+			{"ctx has value for key `name`", be_ctx.CtxWithValue("name"), valueCtx("name", "alice")},
+			// CtxWithValue: exact value matching:
+			{"ctx value foobar==100", be_ctx.CtxWithValue("foobar", 100), valueCtx("foobar", 100)},
+			{"ctx value name==alice", be_ctx.CtxWithValue("name", "alice"), valueCtx("name", "alice")},
+			// CtxWithDeadline: exact deadline matching against the actual time.Time:
+			{"ctx deadline matches fixed deadline", be_ctx.CtxWithDeadline(fixedDeadline), deadlineCtx()},
+			// CtxWithError: cancellation / deadline-exceeded errors:
+			{"canceled ctx err is context.Canceled", be_ctx.CtxWithError(context.Canceled), canceledCtx()},
+			{"expired ctx err is context.DeadlineExceeded",
+				be_ctx.CtxWithError(context.DeadlineExceeded),
+				deadlineExceededCtx()},
+			{"live ctx err is nil", be_ctx.CtxWithError(nil), plainCtx()},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				// check gomega-compatible matching:
+				success, err := tc.matcher.Match(tc.actual)
+				be.Expect(t, err).To(be.Succeed())
+				be.Expect(t, success).To(be.True())
+
+				// check gomock-compatible matching:
+				success = tc.matcher.Matches(tc.actual)
+				be.Expect(t, success).To(be.True())
+			})
+		}
+	})
+
+	t.Run("should negatively match", func(t *testing.T) {
+		for _, tc := range []struct {
+			name    string
+			matcher types.BeMatcher
+			actual  any
+		}{
+			// not a context.Context at all:
+			{"string is not a ctx", be_ctx.CtxWithValue("k"), "not-a-ctx"},
+			{"int is not a ctx", be_ctx.CtxWithError(nil), 42},
+			// CtxWithValue: missing key:
+			{"ctx misses key `absent`", be_ctx.CtxWithValue("absent"), valueCtx("foobar", 100)},
+			{"background ctx has no values", be_ctx.CtxWithValue("foobar"), plainCtx()},
+			// CtxWithValue: wrong value:
+			{"ctx value foobar!=200", be_ctx.CtxWithValue("foobar", 200), valueCtx("foobar", 100)},
+			{"ctx value name!=bob", be_ctx.CtxWithValue("name", "bob"), valueCtx("name", "alice")},
+			// CtxWithDeadline: no deadline present:
+			{"background ctx has no deadline", be_ctx.CtxWithDeadline(fixedDeadline), plainCtx()},
+			// CtxWithDeadline: wrong deadline:
+			{"ctx deadline differs from expected",
+				be_ctx.CtxWithDeadline(fixedDeadline.Add(time.Hour)),
+				deadlineCtx()},
+			// CtxWithError: wrong / unexpected error state:
+			{"live ctx err is not context.Canceled", be_ctx.CtxWithError(context.Canceled), plainCtx()},
+			{"canceled ctx err is not DeadlineExceeded", be_ctx.CtxWithError(context.DeadlineExceeded), canceledCtx()},
+			// timeoutCtx does have a deadline but is still live (err == nil), so it
+			// must NOT match an expectation of context.Canceled:
+			{"timeout ctx err is not context.Canceled", be_ctx.CtxWithError(context.Canceled), timeoutCtx()},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				// check gomega-compatible matching:
+				success, err := tc.matcher.Match(tc.actual)
+				be.Expect(t, err).To(be.Succeed())
+				be.Expect(t, success).To(be.False())
+
+				// check gomock-compatible matching:
+				success = tc.matcher.Matches(tc.actual)
+				be.Expect(t, success).To(be.False())
+			})
+		}
+	})
+
+	t.Run("should return a valid failure message", func(t *testing.T) {
+		for _, tc := range []struct {
+			name    string
+			matcher types.BeMatcher
+			actual  any
+			message string
+		}{
+			{"not a context.Context",
+				be_ctx.CtxWithError(nil), "not-a-ctx",
+				"Expected\n    <string>: not-a-ctx\nto be a ctx"},
+			{"missing ctx value key",
+				be_ctx.CtxWithValue("absent"),
+				plainCtx(),
+				"Expected\n    <context.backgroundCtx>: context.Background\nto have the ctx.value key=`absent`"},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				// FailureMessage is considered to be called after matching:
+				_, _ = tc.matcher.Match(tc.actual)
+
+				be.Expect(t, tc.matcher.FailureMessage(tc.actual)).To(be.Eq(tc.message))
+			})
+		}
+	})
 
 	// CtxWithError(nil) asserts the context carries NO error. It must reject a
 	// context that has errored (previously it matched any context).
-	DescribeTable("CtxWithError(nil) asserts no error", func(actual context.Context, wantMatch bool) {
-		success, err := be_ctx.CtxWithError(nil).Match(actual)
-		Expect(err).Should(Succeed())
-		Expect(success).To(Equal(wantMatch))
-	},
-		Entry("live context has no error -> match", plainCtx(), true),
-		Entry("canceled context has an error -> no match", canceledCtx(), false),
-		Entry("deadline-exceeded context has an error -> no match", deadlineExceededCtx(), false),
-	)
+
+	t.Run("CtxWithError(nil) asserts no error", func(t *testing.T) {
+		for _, tc := range []struct {
+			name      string
+			actual    context.Context
+			wantMatch bool
+		}{
+			{"live context has no error -> match", plainCtx(), true},
+			{"canceled context has an error -> no match", canceledCtx(), false},
+			{"deadline-exceeded context has an error -> no match", deadlineExceededCtx(), false},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				success, err := be_ctx.CtxWithError(nil).Match(tc.actual)
+				be.Expect(t, err).To(be.Succeed())
+				be.Expect(t, success).To(be.Eq(tc.wantMatch))
+			})
+		}
+	})
 
 	// Ctx(args...) must first enforce ctx-ness, then apply the sub-matchers.
-	It("Ctx(subMatcher) enforces the actual is a context.Context", func() {
-		Expect(valueCtx("k", "v")).To(be_ctx.Ctx(be_ctx.CtxWithValue("k", "v")))
+
+	t.Run("Ctx(subMatcher) enforces the actual is a context.Context", func(t *testing.T) {
+		be.Expect(t, valueCtx("k", "v")).To(be_ctx.Ctx(be_ctx.CtxWithValue("k", "v")))
 		// A non-context actual must not match even when paired with sub-matchers.
-		Expect("not a ctx").NotTo(be_ctx.Ctx(be_ctx.CtxWithValue("k", "v")))
+		be.Expect(t, "not a ctx").NotTo(be_ctx.Ctx(be_ctx.CtxWithValue("k", "v")))
 	})
-})
+}
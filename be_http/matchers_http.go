@@ -8,7 +8,6 @@ import (
 	"net/http"
 
 	"github.com/amberpixels/k1/cast"
-	"github.com/onsi/gomega"
 
 	"github.com/expectto/be/be_json"
 	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
@@ -42,10 +41,10 @@ func Request(args ...any) types.BeMatcher {
 		// match given string to whole url
 		return psi_matchers.NewReqPropertyMatcher("Url", "", func(req *http.Request) any {
 			return req.URL.String()
-		}, gomega.Equal(cast.AsString(args[0])))
+		}, NewEqMatcher(cast.AsString(args[0])))
 	}
 
-	return Psi(args...)
+	return WithPath("request", Psi(args...))
 }
 
 // HavingMethod succeeds if the actual value is a *http.Request and its HTTP method matches the provided arguments.
@@ -95,12 +94,20 @@ func HavingBody(args ...any) types.BeMatcher {
 			body, _ := io.ReadAll(req.Body)
 			req.Body = io.NopCloser(bytes.NewBuffer(body))
 
-			return io.NopCloser(bytes.NewBuffer(body))
+			return bodyReader{bytes.NewReader(body)}
 		},
 		args...,
 	)
 }
 
+// bodyReader is the request body given to the HavingBody matchers. It's seekable,
+// so transforms rewind it and every evaluation (e.g. by be.Check) reads the same body.
+type bodyReader struct {
+	*bytes.Reader
+}
+
+func (bodyReader) Close() error { return nil }
+
 // HavingHost succeeds if the actual value is a *http.Request and its Host matches the provided arguments.
 func HavingHost(args ...any) types.BeMatcher {
 	return psi_matchers.NewReqPropertyMatcher(
@@ -5,19 +5,19 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strings"
-
-	. "github.com/onsi/ginkgo/v2"
-	. "github.com/onsi/gomega"
+	"testing"
 
 	"github.com/expectto/be"
 	"github.com/expectto/be/be_ctx"
 	"github.com/expectto/be/be_http"
 	"github.com/expectto/be/be_json"
+	"github.com/expectto/be/be_string"
 	"github.com/expectto/be/be_url"
 	"github.com/expectto/be/types"
 )
 
-// newRequest builds a *http.Request via http.NewRequest, failing the spec on error.
+// newRequest builds a *http.Request via http.NewRequest, panicking on error
+// (as httptest.NewRequest does): it's called while building test tables.
 func newRequest(method, url, body string) *http.Request {
 	var r *http.Request
 	var err error
@@ -26,7 +26,9 @@ func newRequest(method, url, body string) *http.Request {
 	} else {
 		r, err = http.NewRequest(method, url, strings.NewReader(body))
 	}
-	Expect(err).ShouldNot(HaveOccurred())
+	if err != nil {
+		panic(err)
+	}
 	return r
 }
 
@@ -37,196 +39,231 @@ func jsonRequest(url, body string) *http.Request {
 	return r
 }
 
-var _ = Describe("MatchersHttp", func() {
-	DescribeTable("should positively match", func(matcher types.BeMatcher, actual any) {
-		// check gomega-compatible matching:
-		success, err := matcher.Match(actual)
-		Expect(err).Should(Succeed())
-		Expect(success).To(BeTrue())
-
-		// check gomock-compatible matching:
-		success = matcher.Matches(actual)
-		Expect(success).To(BeTrue())
-	},
-		// Request: any valid *http.Request
-		Entry("Request() matches any *http.Request",
-			be_http.Request(), newRequest(http.MethodGet, "https://example.com/path", "")),
-		Entry("Request(string) matches the whole URL",
-			be_http.Request("https://example.com/path?status=active"),
-			newRequest(http.MethodGet, "https://example.com/path?status=active", "")),
-
-		// Method matching
-		Entry("GET() matches a GET request",
-			be_http.GET(), newRequest(http.MethodGet, "https://example.com", "")),
-		Entry("POST() matches a POST request",
-			be_http.POST(), newRequest(http.MethodPost, "https://example.com", "")),
-		Entry("PUT() matches a PUT request",
-			be_http.PUT(), newRequest(http.MethodPut, "https://example.com", "")),
-		Entry("PATCH() matches a PATCH request",
-			be_http.PATCH(), newRequest(http.MethodPatch, "https://example.com", "")),
-		Entry("DELETE() matches a DELETE request",
-			be_http.DELETE(), newRequest(http.MethodDelete, "https://example.com", "")),
-		Entry("HavingMethod(POST) matches a POST request",
-			be_http.HavingMethod(http.MethodPost), newRequest(http.MethodPost, "https://example.com", "")),
-		Entry("HavingMethod(HavePrefix) matches by matcher",
-			be_http.HavingMethod(HavePrefix("PO")), newRequest(http.MethodPost, "https://example.com", "")),
-
-		// Host matching
-		Entry("HavingHost matches host",
-			be_http.HavingHost("example.com"), newRequest(http.MethodGet, "https://example.com/path", "")),
-		Entry("HavingHost(HaveSuffix) matches host by matcher",
-			be_http.HavingHost(HaveSuffix(".com")), newRequest(http.MethodGet, "https://example.com/path", "")),
-
-		// Proto matching
-		Entry("HavingProto matches HTTP/1.1 (httptest default)",
-			be_http.HavingProto("HTTP/1.1"), httptest.NewRequest(http.MethodGet, "https://example.com", http.NoBody)),
-
-		// URL matching composed with be_url matchers
-		Entry("HavingURL+be_url.HavingPath matches path",
-			be_http.HavingURL(be_url.HavingPath("/path")),
-			newRequest(http.MethodGet, "https://example.com/path", "")),
-		Entry("HavingURL+be_url.URL composes multiple be_url matchers",
-			be_http.HavingURL(be_url.URL(
-				be_url.WithHttps(),
-				be_url.HavingHost("example.com"),
-				be_url.HavingPath("/path"),
-				be_url.HavingSearchParam("status", "active"),
-			)),
-			newRequest(http.MethodGet, "https://example.com/path?status=active", "")),
-
-		// Header matching
-		Entry("HavingHeader by key only matches presence",
-			be_http.HavingHeader("Content-Type"),
-			jsonRequest("https://example.com", `{}`)),
-		Entry("HavingHeader by key+value matches",
-			be_http.HavingHeader("Content-Type", "application/json"),
-			jsonRequest("https://example.com", `{}`)),
-		Entry("HavingHeader by key+matcher matches",
-			be_http.HavingHeader("Content-Type", HavePrefix("application/")),
-			jsonRequest("https://example.com", `{}`)),
-
-		// Body matching composed with be.JSON / be_json.HaveKeyValue
-		Entry("HavingBody+be.JSON+HaveKeyValue matches body field",
-			be_http.HavingBody(be.JSON(
-				be_json.JsonAsReader,
-				be_json.HaveKeyValue("hello", "world"),
-			)),
-			jsonRequest("https://example.com", `{"hello":"world"}`)),
-		Entry("HavingBody+be.JSON multiple key/values",
-			be_http.HavingBody(be.JSON(
-				be_json.JsonAsReader,
-				be_json.HaveKeyValue("hello", "world"),
-				be_json.HaveKeyValue("active", true),
-			)),
-			jsonRequest("https://example.com", `{"hello":"world","active":true}`)),
-
-		// Combined request matcher
-		Entry("Request(...) composes method+url+header",
-			be_http.Request(
-				be_http.POST(),
+func TestMatchersHttp(t *testing.T) {
+	t.Run("should positively match", func(t *testing.T) {
+		for _, tc := range []struct {
+			name    string
+			matcher types.BeMatcher
+			actual  any
+		}{
+			// Request: any valid *http.Request
+			{"Request() matches any *http.Request",
+				be_http.Request(), newRequest(http.MethodGet, "https://example.com/path", "")},
+			{"Request(string) matches the whole URL",
+				be_http.Request("https://example.com/path?status=active"),
+				newRequest(http.MethodGet, "https://example.com/path?status=active", "")},
+
+			// Method matching
+			{"GET() matches a GET request",
+				be_http.GET(), newRequest(http.MethodGet, "https://example.com", "")},
+			{"POST() matches a POST request",
+				be_http.POST(), newRequest(http.MethodPost, "https://example.com", "")},
+			{"PUT() matches a PUT request",
+				be_http.PUT(), newRequest(http.MethodPut, "https://example.com", "")},
+			{"PATCH() matches a PATCH request",
+				be_http.PATCH(), newRequest(http.MethodPatch, "https://example.com", "")},
+			{"DELETE() matches a DELETE request",
+				be_http.DELETE(), newRequest(http.MethodDelete, "https://example.com", "")},
+			{"HavingMethod(POST) matches a POST request",
+				be_http.HavingMethod(http.MethodPost), newRequest(http.MethodPost, "https://example.com", "")},
+			{"HavingMethod(HavePrefix) matches by matcher",
+				be_http.HavingMethod(be_string.HavingPrefix("PO")), newRequest(http.MethodPost, "https://example.com", "")},
+
+			// Host matching
+			{"HavingHost matches host",
+				be_http.HavingHost("example.com"), newRequest(http.MethodGet, "https://example.com/path", "")},
+			{"HavingHost(HaveSuffix) matches host by matcher",
+				be_http.HavingHost(be_string.HavingSuffix(".com")), newRequest(http.MethodGet, "https://example.com/path", "")},
+
+			// Proto matching
+			{"HavingProto matches HTTP/1.1 (httptest default)",
+				be_http.HavingProto("HTTP/1.1"), httptest.NewRequest(http.MethodGet, "https://example.com", http.NoBody)},
+
+			// URL matching composed with be_url matchers
+			{"HavingURL+be_url.HavingPath matches path",
 				be_http.HavingURL(be_url.HavingPath("/path")),
+				newRequest(http.MethodGet, "https://example.com/path", "")},
+			{"HavingURL+be_url.URL composes multiple be_url matchers",
+				be_http.HavingURL(be_url.URL(
+					be_url.WithHttps(),
+					be_url.HavingHost("example.com"),
+					be_url.HavingPath("/path"),
+					be_url.HavingSearchParam("status", "active"),
+				)),
+				newRequest(http.MethodGet, "https://example.com/path?status=active", "")},
+
+			// Header matching
+			{"HavingHeader by key only matches presence",
+				be_http.HavingHeader("Content-Type"),
+				jsonRequest("https://example.com", `{}`)},
+			{"HavingHeader by key+value matches",
 				be_http.HavingHeader("Content-Type", "application/json"),
-			),
-			jsonRequest("https://example.com/path", `{}`)),
-	)
-
-	DescribeTable("should negatively match", func(matcher types.BeMatcher, actual any) {
-		// check gomega-compatible matching:
-		success, err := matcher.Match(actual)
-		Expect(err).Should(Succeed())
-		Expect(success).To(BeFalse())
-
-		// check gomock-compatible matching:
-		success = matcher.Matches(actual)
-		Expect(success).To(BeFalse())
-	},
-		Entry("Request(string) does not match a different URL",
-			be_http.Request("https://example.com/other"),
-			newRequest(http.MethodGet, "https://example.com/path", "")),
-
-		Entry("GET() does not match a POST request",
-			be_http.GET(), newRequest(http.MethodPost, "https://example.com", "")),
-		Entry("POST() does not match a GET request",
-			be_http.POST(), newRequest(http.MethodGet, "https://example.com", "")),
-		Entry("HavingMethod(POST) does not match a GET request",
-			be_http.HavingMethod(http.MethodPost), newRequest(http.MethodGet, "https://example.com", "")),
-
-		Entry("HavingHost does not match a different host",
-			be_http.HavingHost("other.com"), newRequest(http.MethodGet, "https://example.com/path", "")),
-
-		Entry("HavingURL+be_url.HavingPath does not match a different path",
-			be_http.HavingURL(be_url.HavingPath("/other")),
-			newRequest(http.MethodGet, "https://example.com/path", "")),
-
-		Entry("HavingHeader does not match a missing header",
-			be_http.HavingHeader("X-Absent"),
-			newRequest(http.MethodGet, "https://example.com", "")),
-		Entry("HavingHeader does not match a wrong header value",
-			be_http.HavingHeader("Content-Type", "text/plain"),
-			jsonRequest("https://example.com", `{}`)),
-
-		Entry("HavingBody+be.JSON does not match a wrong field value",
-			be_http.HavingBody(be.JSON(
-				be_json.JsonAsReader,
-				be_json.HaveKeyValue("hello", "mars"),
-			)),
-			jsonRequest("https://example.com", `{"hello":"world"}`)),
-		Entry("HavingBody+be.JSON does not match a missing field",
-			be_http.HavingBody(be.JSON(
-				be_json.JsonAsReader,
-				be_json.HaveKeyValue("missing"),
-			)),
-			jsonRequest("https://example.com", `{"hello":"world"}`)),
-	)
-
-	DescribeTable("should fail (no match) on non-*http.Request input", func(matcher types.BeMatcher, actual any) {
-		Expect(func() {
-			success, _ := matcher.Match(actual)
-			Expect(success).To(BeFalse())
-		}).NotTo(Panic())
-	},
-		Entry("Request() on a string", be_http.Request(), "not-a-request"),
-		Entry("GET() on a number", be_http.GET(), 42),
-		Entry("HavingHost() on nil", be_http.HavingHost("x"), nil),
-	)
+				jsonRequest("https://example.com", `{}`)},
+			{"HavingHeader by key+matcher matches",
+				be_http.HavingHeader("Content-Type", be_string.HavingPrefix("application/")),
+				jsonRequest("https://example.com", `{}`)},
+
+			// Body matching composed with be.JSON / be_json.HaveKeyValue
+			{"HavingBody+be.JSON+HaveKeyValue matches body field",
+				be_http.HavingBody(be.JSON(
+					be_json.JsonAsReader,
+					be_json.HaveKeyValue("hello", "world"),
+				)),
+				jsonRequest("https://example.com", `{"hello":"world"}`)},
+			{"HavingBody+be.JSON multiple key/values",
+				be_http.HavingBody(be.JSON(
+					be_json.JsonAsReader,
+					be_json.HaveKeyValue("hello", "world"),
+					be_json.HaveKeyValue("active", true),
+				)),
+				jsonRequest("https://example.com", `{"hello":"world","active":true}`)},
+
+			// Combined request matcher
+			{"Request(...) composes method+url+header",
+				be_http.Request(
+					be_http.POST(),
+					be_http.HavingURL(be_url.HavingPath("/path")),
+					be_http.HavingHeader("Content-Type", "application/json"),
+				),
+				jsonRequest("https://example.com/path", `{}`)},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				// check gomega-compatible matching:
+				success, err := tc.matcher.Match(tc.actual)
+				be.Expect(t, err).To(be.Succeed())
+				be.Expect(t, success).To(be.True())
+
+				// check gomock-compatible matching:
+				success = tc.matcher.Matches(tc.actual)
+				be.Expect(t, success).To(be.True())
+			})
+		}
+	})
+
+	t.Run("should negatively match", func(t *testing.T) {
+		for _, tc := range []struct {
+			name    string
+			matcher types.BeMatcher
+			actual  any
+		}{
+			{"Request(string) does not match a different URL",
+				be_http.Request("https://example.com/other"),
+				newRequest(http.MethodGet, "https://example.com/path", "")},
+
+			{"GET() does not match a POST request",
+				be_http.GET(), newRequest(http.MethodPost, "https://example.com", "")},
+			{"POST() does not match a GET request",
+				be_http.POST(), newRequest(http.MethodGet, "https://example.com", "")},
+			{"HavingMethod(POST) does not match a GET request",
+				be_http.HavingMethod(http.MethodPost), newRequest(http.MethodGet, "https://example.com", "")},
+
+			{"HavingHost does not match a different host",
+				be_http.HavingHost("other.com"), newRequest(http.MethodGet, "https://example.com/path", "")},
+
+			{"HavingURL+be_url.HavingPath does not match a different path",
+				be_http.HavingURL(be_url.HavingPath("/other")),
+				newRequest(http.MethodGet, "https://example.com/path", "")},
+
+			{"HavingHeader does not match a missing header",
+				be_http.HavingHeader("X-Absent"),
+				newRequest(http.MethodGet, "https://example.com", "")},
+			{"HavingHeader does not match a wrong header value",
+				be_http.HavingHeader("Content-Type", "text/plain"),
+				jsonRequest("https://example.com", `{}`)},
+
+			{"HavingBody+be.JSON does not match a wrong field value",
+				be_http.HavingBody(be.JSON(
+					be_json.JsonAsReader,
+					be_json.HaveKeyValue("hello", "mars"),
+				)),
+				jsonRequest("https://example.com", `{"hello":"world"}`)},
+			{"HavingBody+be.JSON does not match a missing field",
+				be_http.HavingBody(be.JSON(
+					be_json.JsonAsReader,
+					be_json.HaveKeyValue("missing"),
+				)),
+				jsonRequest("https://example.com", `{"hello":"world"}`)},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				// check gomega-compatible matching:
+				success, err := tc.matcher.Match(tc.actual)
+				be.Expect(t, err).To(be.Succeed())
+				be.Expect(t, success).To(be.False())
+
+				// check gomock-compatible matching:
+				success = tc.matcher.Matches(tc.actual)
+				be.Expect(t, success).To(be.False())
+			})
+		}
+	})
+
+	t.Run("should fail (no match) on non-*http.Request input", func(t *testing.T) {
+		for _, tc := range []struct {
+			name    string
+			matcher types.BeMatcher
+			actual  any
+		}{
+			{"Request() on a string", be_http.Request(), "not-a-request"},
+			{"GET() on a number", be_http.GET(), 42},
+			{"HavingHost() on nil", be_http.HavingHost("x"), nil},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				be.Expect(t, func() {
+					success, _ := tc.matcher.Match(tc.actual)
+					be.Expect(t, success).To(be.False())
+				}).NotTo(be.Panic())
+			})
+		}
+	})
 
 	// Regression: HavingBody must not panic when the request carries no body
 	// (req.Body == nil, e.g. a GET built with a nil body).
-	It("HavingBody does not panic on a nil-body request", func() {
+
+	t.Run("HavingBody does not panic on a nil-body request", func(t *testing.T) {
 		req := newRequest(http.MethodGet, "https://example.com", "")
 		req.Body = nil // force the nil-body case this regression guards (helper uses http.NoBody)
-		Expect(req.Body).To(BeNil())
-		Expect(func() {
+		be.Expect(t, req.Body).To(be.Nil())
+		be.Expect(t, func() {
 			success, _ := be_http.HavingBody(
 				be.JSON(be_json.JsonAsReader, be_json.HaveKeyValue("x", "y")),
 			).Match(req)
-			Expect(success).To(BeFalse())
-		}).NotTo(Panic())
+			be.Expect(t, success).To(be.False())
+		}).NotTo(be.Panic())
 	})
 
 	// HavingCtx matches against the request's context (req.Context()).
-	It("HavingCtx matches the request context via be_ctx matchers", func() {
+
+	t.Run("HavingCtx matches the request context via be_ctx matchers", func(t *testing.T) {
 		type ctxKey string
 		req := newRequest(http.MethodGet, "https://example.com", "")
 		req = req.WithContext(context.WithValue(req.Context(), ctxKey("requestID"), "abc-123"))
 
-		Expect(req).To(be_http.HavingCtx(be_ctx.CtxWithValue(ctxKey("requestID"), "abc-123")))
-		Expect(req).NotTo(be_http.HavingCtx(be_ctx.CtxWithValue(ctxKey("requestID"), "nope")))
+		be.Expect(t, req).To(be_http.HavingCtx(be_ctx.CtxWithValue(ctxKey("requestID"), "abc-123")))
+		be.Expect(t, req).NotTo(be_http.HavingCtx(be_ctx.CtxWithValue(ctxKey("requestID"), "nope")))
 	})
 
-	DescribeTable("should return a valid failure message", func(matcher types.BeMatcher, actual any, substr string) {
-		// FailureMessage is considered to be called after matching:
-		_, _ = matcher.Match(actual)
-
-		failureMessage := matcher.FailureMessage(actual)
-		Expect(failureMessage).To(ContainSubstring(substr))
-	},
-		Entry("GET() vs POST request reports the actual method",
-			be_http.GET(), newRequest(http.MethodPost, "https://example.com", ""), http.MethodPost),
-		Entry("HavingHost mismatch reports the expected host",
-			be_http.HavingHost("other.com"), newRequest(http.MethodGet, "https://example.com", ""), "other.com"),
-		Entry("HavingURL mismatch reports the expected path",
-			be_http.HavingURL(be_url.HavingPath("/other")),
-			newRequest(http.MethodGet, "https://example.com/path", ""), "/other"),
-	)
-})
+	t.Run("should return a valid failure message", func(t *testing.T) {
+		for _, tc := range []struct {
+			name    string
+			matcher types.BeMatcher
+			actual  any
+			substr  string
+		}{
+			{"GET() vs POST request reports the actual method",
+				be_http.GET(), newRequest(http.MethodPost, "https://example.com", ""), http.MethodPost},
+			{"HavingHost mismatch reports the expected host",
+				be_http.HavingHost("other.com"), newRequest(http.MethodGet, "https://example.com", ""), "other.com"},
+			{"HavingURL mismatch reports the expected path",
+				be_http.HavingURL(be_url.HavingPath("/other")),
+				newRequest(http.MethodGet, "https://example.com/path", ""), "/other"},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				// FailureMessage is considered to be called after matching:
+				_, _ = tc.matcher.Match(tc.actual)
+
+				failureMessage := tc.matcher.FailureMessage(tc.actual)
+				be.Expect(t, failureMessage).To(be.ContainSubstring(tc.substr))
+			})
+		}
+	})
+}
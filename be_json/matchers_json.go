@@ -3,13 +3,14 @@
 package be_json
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
+	"io/fs"
 	"reflect"
 
 	"github.com/amberpixels/k1/cast"
-	"github.com/onsi/gomega"
 
 	"github.com/expectto/be/be_reflected"
 	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
@@ -96,65 +97,12 @@ func Matcher(args ...any) types.BeMatcher {
 		return inputMatcher
 	}
 
-	return &psi_matchers.AllMatcher{Matchers: []types.BeMatcher{
+	return &psi_matchers.AllMatcher{Path: "json", Matchers: []types.BeMatcher{
 		inputMatcher,
 
 		// JSON expects arguments to be matchers upon map[string]any
 		// So let's perform a transform: raw => any
-		WithFallibleTransform(func(actual any) any {
-			// `actual` may be an io.Reader that is decoded directly
-			if reader, ok := actual.(io.Reader); ok {
-				var data any
-				if err := json.NewDecoder(reader).Decode(&data); err != nil {
-					return NewTransformError(fmt.Errorf("to read json: %w", err), actual)
-				}
-				if closer, ok := actual.(io.Closer); ok {
-					_ = closer.Close()
-				}
-
-				return data
-			}
-
-			if actualStringer, ok := actual.(fmt.Stringer); ok {
-				var data any
-				if err := json.Unmarshal([]byte(actualStringer.String()), &data); err != nil {
-					return NewTransformError(fmt.Errorf("be a valid json: %w", err), actual)
-				}
-
-				return data
-			}
-
-			// convert `actual` into `any` (if `actual` is bytes/string):
-			// it will end up `[]any` or `map[string]any` underneath it
-			if cast.IsStringish(actual) {
-				var data any
-				if err := json.Unmarshal(cast.AsBytes(actual), &data); err != nil {
-					return NewTransformError(fmt.Errorf("be a valid json: %w", err), actual)
-				}
-
-				return data
-			}
-
-			// TODO refactor
-			if reflect.TypeOf(actual).Kind() == reflect.Struct {
-				// remarshal via JSON:
-				contents, err := json.Marshal(actual)
-				if err != nil {
-					return NewTransformError(fmt.Errorf("to read json: %w", err), actual)
-				}
-
-				var data any
-				if err := json.Unmarshal(contents, &data); err != nil {
-					return NewTransformError(fmt.Errorf("be a valid json: %w", err), actual)
-				}
-
-				return data
-			}
-
-			// no conversion is needed, `actual` will be checked via matchers directly
-			return actual
-		},
-
+		WithFallibleTransform(decode,
 			// Applying given matchers to the raw JSON
 			func() types.BeMatcher {
 				// If we have just one arg then we match against it
@@ -171,7 +119,7 @@ func Matcher(args ...any) types.BeMatcher {
 						argData = args[0]
 					}
 
-					return psi_matchers.NewEqMatcher(argData)
+					return NewEqMatcher(argData)
 				}
 
 				return Psi(args...)
@@ -180,16 +128,304 @@ func Matcher(args ...any) types.BeMatcher {
 	}}
 }
 
-// HaveKeyValue is a facade to gomega.HaveKey & gomega.HaveKeyWithValue
+// HaveKeyValue succeeds if actual is a map (a decoded JSON object, for instance) having the given key.
+// If args are given, the value under the key must match them as well.
+// A value mismatch is reported with the key as a path segment, e.g. `at json["details"]: ...`.
+// Key may be a matcher itself: then any of the matching keys may hold the matching value.
 func HaveKeyValue(key any, args ...any) types.BeMatcher {
-	if len(args) == 0 {
-		return Psi(gomega.HaveKey(key))
+	return psi_matchers.NewHaveKeyValueMatcher(key, args...)
+}
+
+// At succeeds if the JSON (any input Matcher accepts: a JSON string/bytes/reader,
+// a decoded object or array, a struct) has a value at the given JSON Pointer (RFC 6901).
+// If args are given, the value must match them as well:
+//
+//	be.Expect(t, body).To(be_json.At("/details/0/key", "foo"))
+//	be.Expect(t, body).To(be_json.At("/meta/total", be_math.GreaterThan(10)))
+//
+// A missing value is reported as where the pointer breaks off
+// (e.g. `but ["details"] has 1 element, there is no [3]`),
+// a mismatching value by the message of the matcher at the value's path
+// (e.g. `at ["details"][0]["key"]: ...`). An invalid pointer fails with its error (negated as well).
+func At(pointer string, args ...any) types.BeMatcher {
+	matcher, err := psi_matchers.NewJsonPointerMatcher(pointer, args...)
+	if err != nil {
+		return psi_matchers.NewNeverMatcher(err)
 	}
+	return WithFallibleTransform(decode, matcher)
+}
 
-	// todo: optimize for gomock messages ?
-	// todo: should we optimize (check if len(args)==1,
-	// 		 to reduce level of wrapping) ?
-	return Psi(
-		gomega.HaveKeyWithValue(key, Psi(args...)),
-	)
+// Query succeeds if the values the JSONPath query selects from the JSON (any input
+// Matcher accepts) match the given args. The selected values are a []any in document
+// order, so match them with collection matchers. With no args it succeeds if
+// the query selects anything:
+//
+//	be.Expect(t, body).To(be_json.Query("$.items[?(@.status=='active')].id", be.ConsistOf(1.0, 3.0)))
+//	be.Expect(t, body).To(be_json.Query("$..errors[*]", be.Empty()))
+//
+// A subset of JSONPath is supported: members (`.name`, `['name']`), elements (`[0]`, `[-1]`),
+// wildcards (`.*`, `[*]`), descendants (`..name`) and filters (`[?(@.price < 10 && @.tags)]`).
+// When nothing is selected, the failure says so. An invalid query fails with its error (negated as well).
+func Query(query string, args ...any) types.BeMatcher {
+	matcher, err := psi_matchers.NewJsonQueryMatcher(query, args...)
+	if err != nil {
+		return psi_matchers.NewNeverMatcher(err)
+	}
+	return WithFallibleTransform(decode, matcher)
+}
+
+// MatchSchema succeeds if the JSON (any input Matcher accepts) is valid against
+// the JSON Schema given as a JSON string or bytes (or an already decoded schema):
+//
+//	be.Expect(t, body).To(be_json.MatchSchema(`{"type": "object", "required": ["id"]}`))
+//
+// Draft 2020-12 is the default (`$schema` selects another draft), with the core,
+// applicator and validation vocabularies; `format` is an annotation only.
+// A failure lists every violation with its instance path and the keyword location in the schema:
+//
+//	["items"][0]["id"]: minimum: got 0, want 1 (#/$defs/id/minimum)
+//
+// An invalid schema fails with its error (negated as well).
+func MatchSchema(schema any) types.BeMatcher {
+	matcher, err := psi_matchers.NewJsonSchemaMatcher(schema)
+	if err != nil {
+		return psi_matchers.NewNeverMatcher(err)
+	}
+	return WithFallibleTransform(decode, matcher)
+}
+
+// MatchSchemaFile is MatchSchema of the schema file of fsys (e.g. an embed.FS or os.DirFS).
+// Relative `$ref`s to other files are loaded from fsys as well:
+//
+//	//go:embed contracts
+//	var contracts embed.FS
+//
+//	be.Expect(t, body).To(be_json.MatchSchemaFile(contracts, "contracts/user.json"))
+func MatchSchemaFile(fsys fs.FS, name string) types.BeMatcher {
+	matcher, err := psi_matchers.NewJsonSchemaFileMatcher(fsys, name)
+	if err != nil {
+		return psi_matchers.NewNeverMatcher(err)
+	}
+	return WithFallibleTransform(decode, matcher)
+}
+
+// EquivalentOption relaxes the comparison of Equivalent.
+type EquivalentOption func(*psi_matchers.JsonEquivalentMatcher)
+
+// IgnorePaths skips the values at the given JSON Pointers on both sides,
+// a "*" token stands for any key or index: IgnorePaths("/id", "/items/*/createdAt").
+func IgnorePaths(pointers ...string) EquivalentOption {
+	return func(m *psi_matchers.JsonEquivalentMatcher) { m.Ignore = append(m.Ignore, pointers...) }
+}
+
+// UnorderedArrays compares the arrays at the given JSON Pointers regardless of
+// the order of their elements. With no pointers given, every array is unordered.
+func UnorderedArrays(pointers ...string) EquivalentOption {
+	return func(m *psi_matchers.JsonEquivalentMatcher) {
+		m.Unordered = append(m.Unordered, pointers...)
+		m.AllUnordered = m.AllUnordered || len(pointers) == 0
+	}
+}
+
+// NumberTolerance treats numbers differing by no more than the tolerance as equal.
+func NumberTolerance(tolerance float64) EquivalentOption {
+	return func(m *psi_matchers.JsonEquivalentMatcher) { m.Tolerance = tolerance }
+}
+
+// AllowExtraKeys lets actual objects have keys the expected ones don't.
+func AllowExtraKeys() EquivalentOption {
+	return func(m *psi_matchers.JsonEquivalentMatcher) { m.AllowExtraKeys = true }
+}
+
+// Equivalent succeeds if the JSON (any input Matcher accepts) is semantically equal
+// to the expected JSON (a JSON string/bytes or any value that marshals to JSON):
+// regardless of the key order and the formatting. Options relax the comparison:
+//
+//	be.Expect(t, body).To(be_json.Equivalent(`{"name": "gopher", "tags": ["a", "b"]}`,
+//		be_json.IgnorePaths("/id", "/meta/createdAt"),
+//		be_json.UnorderedArrays("/tags"),
+//	))
+//
+// A failure shows all the differences at once, by path:
+//
+//	differences (- expected, + actual):
+//	  - ["tags"][1]: "b"
+//	  + ["tags"][1]: "c"
+//
+// An invalid expected JSON fails with its error (negated as well).
+func Equivalent(expected any, opts ...EquivalentOption) types.BeMatcher {
+	matcher, err := psi_matchers.NewJsonEquivalentMatcher(expected)
+	if err != nil {
+		return psi_matchers.NewNeverMatcher(err)
+	}
+	for _, opt := range opts {
+		opt(matcher)
+	}
+	return WithFallibleTransform(decode, matcher)
+}
+
+// V creates a value matching the placeholder of the given name in MatchTemplate:
+// a raw value or a matcher.
+var V = psi_matchers.V
+
+// MatchTemplate succeeds if the JSON (any input Matcher accepts) matches the JSON template:
+// the expected JSON text with `{{name}}` placeholders, each matched by the value (V) of the same name.
+// The response reads like a fixture while still tolerating dynamic values:
+//
+//	be.Expect(t, body).To(be_json.MatchTemplate(`{"id": "{{id}}", "email": "{{email}}", "items": [{"n": {{n}}}]}`,
+//		be_json.V("id", be_string.HavingPrefix("usr_")),
+//		be_json.V("email", be_string.ValidEmail()),
+//		be_json.V("n", be.Gt(0)),
+//	))
+//
+// A quoted placeholder (`"{{id}}"`) stands for a string, a bare one (`{{n}}`) for any JSON value,
+// placeholders within a string (`"Bearer {{jwt}}"`) are matched as be_string.MatchTemplate does.
+// A placeholder with no value matches anything, and all the occurrences of a placeholder
+// must be the same value. The rest is compared as Equivalent does, a failure shows
+// the differences by path.
+//
+// An invalid template or a value with no placeholder fails with its error (negated as well).
+func MatchTemplate(template string, values ...*psi_matchers.Value) types.BeMatcher {
+	matcher, err := psi_matchers.NewJsonTemplateMatcher(template, values...)
+	if err != nil {
+		return psi_matchers.NewNeverMatcher(err)
+	}
+	return WithFallibleTransform(decode, matcher)
+}
+
+// DecodeOption configures how As decodes the JSON.
+type DecodeOption func(*json.Decoder)
+
+// DisallowUnknownFields makes As fail on JSON object keys that don't match
+// any (non-ignored, exported) field of the struct decoded into.
+func DisallowUnknownFields() DecodeOption {
+	return func(d *json.Decoder) { d.DisallowUnknownFields() }
+}
+
+// As decodes the JSON (any input Matcher accepts) into a T and succeeds if it matches
+// the given args (if any), so the value is matched with its Go types rather than
+// as a map[string]any with float64 numbers:
+//
+//	be.Expect(t, body).To(be_json.As[User](
+//		be.HaveField("Age", be.Gt(18)),
+//		be_struct.HavingField[User]("Email", be_string.ValidEmail()),
+//		be_json.DisallowUnknownFields(),
+//	))
+//
+// DecodeOption args (e.g. DisallowUnknownFields) configure the decoding.
+// JSON that can't be decoded into a T is an error, telling why.
+func As[T any](args ...any) types.BeMatcher {
+	var opts []DecodeOption
+	var matchers []any
+	for _, arg := range args {
+		if opt, ok := arg.(DecodeOption); ok {
+			opts = append(opts, opt)
+			continue
+		}
+		matchers = append(matchers, arg)
+	}
+
+	var matcher types.BeMatcher
+	if len(matchers) > 0 {
+		matcher = Psi(matchers...)
+	}
+
+	return WithFallibleTransform(func(actual any) any {
+		contents, err := encode(actual)
+		if err != nil {
+			return NewTransformError(fmt.Errorf("to read json: %w", err), actual)
+		}
+
+		decoder := json.NewDecoder(bytes.NewReader(contents))
+		for _, opt := range opts {
+			opt(decoder)
+		}
+		var v T
+		if err := decoder.Decode(&v); err != nil {
+			return NewTransformError(fmt.Errorf("decode as %s: %w", reflect.TypeFor[T](), err), actual)
+		}
+		return v
+	}, matcher)
+}
+
+// encode returns the JSON text of a JSON input (see Matcher):
+// read, converted to bytes or marshaled from an already decoded value (or a struct).
+func encode(actual any) ([]byte, error) {
+	if reader, ok := actual.(io.Reader); ok {
+		defer closeConsumed(reader)
+		return io.ReadAll(reader)
+	}
+	if actualStringer, ok := actual.(fmt.Stringer); ok {
+		return []byte(actualStringer.String()), nil
+	}
+	if cast.IsStringish(actual) {
+		return cast.AsBytes(actual), nil
+	}
+	return json.Marshal(actual)
+}
+
+// closeConsumed closes a reader that was read through (e.g. an http.Response body).
+// Seekable readers (e.g. an *os.File) are left open: the transform seeks them back
+// so they can be read again, and closing them is up to the caller.
+func closeConsumed(reader io.Reader) {
+	if _, ok := reader.(io.Seeker); ok {
+		return
+	}
+	if closer, ok := reader.(io.Closer); ok {
+		_ = closer.Close()
+	}
+}
+
+// decode transforms a JSON input (see Matcher) into its decoded value:
+// `[]any`, `map[string]any` or a scalar. Already decoded values are returned as they are.
+func decode(actual any) any {
+	// `actual` may be an io.Reader that is decoded directly
+	if reader, ok := actual.(io.Reader); ok {
+		var data any
+		if err := json.NewDecoder(reader).Decode(&data); err != nil {
+			return NewTransformError(fmt.Errorf("to read json: %w", err), actual)
+		}
+		closeConsumed(reader)
+
+		return data
+	}
+
+	if actualStringer, ok := actual.(fmt.Stringer); ok {
+		var data any
+		if err := json.Unmarshal([]byte(actualStringer.String()), &data); err != nil {
+			return NewTransformError(fmt.Errorf("be a valid json: %w", err), actual)
+		}
+
+		return data
+	}
+
+	// convert `actual` into `any` (if `actual` is bytes/string):
+	// it will end up `[]any` or `map[string]any` underneath it
+	if cast.IsStringish(actual) {
+		var data any
+		if err := json.Unmarshal(cast.AsBytes(actual), &data); err != nil {
+			return NewTransformError(fmt.Errorf("be a valid json: %w", err), actual)
+		}
+
+		return data
+	}
+
+	// TODO refactor
+	if actual != nil && reflect.TypeOf(actual).Kind() == reflect.Struct {
+		// remarshal via JSON:
+		contents, err := json.Marshal(actual)
+		if err != nil {
+			return NewTransformError(fmt.Errorf("to read json: %w", err), actual)
+		}
+
+		var data any
+		if err := json.Unmarshal(contents, &data); err != nil {
+			return NewTransformError(fmt.Errorf("be a valid json: %w", err), actual)
+		}
+
+		return data
+	}
+
+	// no conversion is needed, `actual` will be checked via matchers directly
+	return actual
 }
@@ -2,15 +2,17 @@ package be_json_test
 
 import (
 	"io"
+	"os"
 	"strings"
+	"testing"
+	"testing/fstest"
 
-	. "github.com/onsi/ginkgo/v2"
-	. "github.com/onsi/gomega"
-
+	"github.com/expectto/be"
 	"github.com/expectto/be/be_json"
 	"github.com/expectto/be/be_math"
 	"github.com/expectto/be/be_reflected"
 	"github.com/expectto/be/be_string"
+	"github.com/expectto/be/be_struct"
 	"github.com/expectto/be/types"
 )
 
@@ -24,207 +26,494 @@ const sampleJSON = `{
 	"items": [{"key": "foo"}, {"key": "bar"}]
 }`
 
+// sampleSchema is a JSON schema sampleJSON is valid against.
+const sampleSchema = `{
+	"type": "object",
+	"required": ["name", "n"],
+	"properties": {
+		"name": {"type": "string"},
+		"n": {"type": "integer", "minimum": 0},
+		"tags": {"type": "array", "items": {"type": "string"}, "uniqueItems": true}
+	}
+}`
+
 // NOTE: JSON numbers always decode to float64. So a value like 42 is matched by
 // be_reflected.AsFloat() (NOT be_reflected.AsInteger(), which inspects reflect.Kind
 // and only succeeds for Go integer kinds). See the report for details.
 
-var _ = Describe("BeJson", func() {
-	DescribeTable("should positively match (string-like input)", func(matcher types.BeMatcher, actual any) {
-		// check gomega-compatible matching:
-		success, err := matcher.Match(actual)
-		Expect(err).Should(Succeed())
-		Expect(success).To(BeTrue())
-
-		// check gomock-compatible matching:
-		success = matcher.Matches(actual)
-		Expect(success).To(BeTrue())
-	},
-		// JsonAsString mode -- simple string value
-		Entry("string value via HaveKeyValue",
-			be_json.Matcher(be_json.JsonAsString, be_json.HaveKeyValue("name", "gopher")),
-			sampleJSON),
-
-		// numeric value (JSON numbers decode to float64)
-		Entry("numeric value via HaveKeyValue",
-			be_json.Matcher(be_json.JsonAsString, be_json.HaveKeyValue("n", float64(42))),
-			sampleJSON),
-
-		// key presence only (no value assertion)
-		Entry("key presence only",
-			be_json.Matcher(be_json.JsonAsString, be_json.HaveKeyValue("email")),
-			sampleJSON),
-
-		// numeric value matched by other be matchers as the value
-		Entry("numeric value matched by AsFloat + GreaterThan",
-			be_json.Matcher(be_json.JsonAsString,
-				be_json.HaveKeyValue("n", be_reflected.AsFloat(), be_math.GreaterThan(10))),
-			sampleJSON),
-
-		// string value matched by be_string matchers
-		Entry("string value matched by ValidEmail",
-			be_json.Matcher(be_json.JsonAsString,
-				be_json.HaveKeyValue("email", be_string.ValidEmail())),
-			sampleJSON),
-
-		Entry("string value matched by ValidEmail + ContainingSubstring",
-			be_json.Matcher(be_json.JsonAsString,
-				be_json.HaveKeyValue("email", be_string.ValidEmail(), be_string.ContainingSubstring("@tests.com"))),
-			sampleJSON),
-
-		Entry("string value matched by NonEmptyString",
-			be_json.Matcher(be_json.JsonAsString,
-				be_json.HaveKeyValue("name", be_string.NonEmptyString())),
-			sampleJSON),
-
-		// nested object value
-		Entry("nested object value via HaveKeyValue",
-			be_json.Matcher(be_json.JsonAsString,
-				be_json.HaveKeyValue("nested", be_json.HaveKeyValue("inner", "value"))),
-			sampleJSON),
-
-		Entry("nested object with reflected/math matcher on inner key",
-			be_json.Matcher(be_json.JsonAsString,
-				be_json.HaveKeyValue("nested", And(
-					be_reflected.AsObject(),
-					be_json.HaveKeyValue("count", be_reflected.AsFloat(), be_math.GreaterThan(2)),
-				))),
-			sampleJSON),
-
-		// array value
-		Entry("array value via HaveKeyValue (equality)",
-			be_json.Matcher(be_json.JsonAsString,
-				be_json.HaveKeyValue("tags", []any{"a", "b", "c"})),
-			sampleJSON),
-
-		Entry("array value reflected as slice with length",
-			be_json.Matcher(be_json.JsonAsString,
-				be_json.HaveKeyValue("tags", And(be_reflected.AsSlice(), HaveLen(3)))),
-			sampleJSON),
-
-		Entry("array of objects via ContainElement",
-			be_json.Matcher(be_json.JsonAsString,
-				be_json.HaveKeyValue("items", ContainElement(be_json.HaveKeyValue("key", "foo")))),
-			sampleJSON),
-
-		// Not(HaveKeyValue) for an absent key
-		Entry("Not for absent key",
-			be_json.Matcher(be_json.JsonAsString, Not(be_json.HaveKeyValue("missing_field"))),
-			sampleJSON),
-
-		// whole-document equality
-		Entry("whole document equality via map",
-			be_json.Matcher(be_json.JsonAsString, map[string]any{"foo": "bar"}),
-			`{"foo":"bar"}`),
-
-		// validity-only (no value args)
-		Entry("string is valid json (validity-only)",
-			be_json.Matcher(be_json.JsonAsString),
-			`{"any":"json"}`),
-	)
-
-	DescribeTable("should negatively match (string-like input)", func(matcher types.BeMatcher, actual any) {
-		// check gomega-compatible matching:
-		success, err := matcher.Match(actual)
-		Expect(err).Should(Succeed())
-		Expect(success).To(BeFalse())
-
-		// check gomock-compatible matching:
-		success = matcher.Matches(actual)
-		Expect(success).To(BeFalse())
-	},
-		Entry("wrong string value",
-			be_json.Matcher(be_json.JsonAsString, be_json.HaveKeyValue("name", "not-gopher")),
-			sampleJSON),
-
-		Entry("wrong numeric value",
-			be_json.Matcher(be_json.JsonAsString, be_json.HaveKeyValue("n", float64(7))),
-			sampleJSON),
-
-		Entry("numeric value not GreaterThan 100",
-			be_json.Matcher(be_json.JsonAsString,
-				be_json.HaveKeyValue("n", be_reflected.AsFloat(), be_math.GreaterThan(100))),
-			sampleJSON),
-
-		Entry("absent key requested with HaveKeyValue",
-			be_json.Matcher(be_json.JsonAsString, be_json.HaveKeyValue("deleted_field")),
-			sampleJSON),
-
-		Entry("Not(HaveKeyValue) on present key fails",
-			be_json.Matcher(be_json.JsonAsString, Not(be_json.HaveKeyValue("name"))),
-			sampleJSON),
-
-		Entry("nested object wrong inner value",
-			be_json.Matcher(be_json.JsonAsString,
-				be_json.HaveKeyValue("nested", be_json.HaveKeyValue("inner", "wrong"))),
-			sampleJSON),
-
-		Entry("array of objects missing element",
-			be_json.Matcher(be_json.JsonAsString,
-				be_json.HaveKeyValue("items", ContainElement(be_json.HaveKeyValue("key", "absent")))),
-			sampleJSON),
+// sample is the Go type sampleJSON decodes into
+type sample struct {
+	Name   string   `json:"name"`
+	N      int      `json:"n"`
+	Email  string   `json:"email"`
+	Tags   []string `json:"tags"`
+	Nested struct {
+		Inner string `json:"inner"`
+		Count int    `json:"count"`
+	} `json:"nested"`
+}
+
+// sampleTemplate is a JSON template with a placeholder of each kind
+func sampleTemplate() types.BeMatcher {
+	return be_json.MatchTemplate(`{"id": "{{id}}", "email": "{{email}}", "items": [{"n": {{n}}}]}`,
+		be_json.V("id", be_string.HavingPrefix("usr_")),
+		be_json.V("email", be_string.ValidEmail()),
+		be_json.V("n", be_math.GreaterThan(0)),
 	)
+}
+
+func TestBeJson(t *testing.T) {
+	t.Run("should positively match (string-like input)", func(t *testing.T) {
+		for _, tc := range []struct {
+			name    string
+			matcher types.BeMatcher
+			actual  any
+		}{
+			// JsonAsString mode -- simple string value
+			{"string value via HaveKeyValue",
+				be_json.Matcher(be_json.JsonAsString, be_json.HaveKeyValue("name", "gopher")),
+				sampleJSON},
+
+			// numeric value (JSON numbers decode to float64)
+			{"numeric value via HaveKeyValue",
+				be_json.Matcher(be_json.JsonAsString, be_json.HaveKeyValue("n", float64(42))),
+				sampleJSON},
+
+			// key presence only (no value assertion)
+			{"key presence only",
+				be_json.Matcher(be_json.JsonAsString, be_json.HaveKeyValue("email")),
+				sampleJSON},
+
+			// numeric value matched by other be matchers as the value
+			{"numeric value matched by AsFloat + GreaterThan",
+				be_json.Matcher(be_json.JsonAsString,
+					be_json.HaveKeyValue("n", be_reflected.AsFloat(), be_math.GreaterThan(10))),
+				sampleJSON},
+
+			// string value matched by be_string matchers
+			{"string value matched by ValidEmail",
+				be_json.Matcher(be_json.JsonAsString,
+					be_json.HaveKeyValue("email", be_string.ValidEmail())),
+				sampleJSON},
+
+			{"string value matched by ValidEmail + ContainingSubstring",
+				be_json.Matcher(be_json.JsonAsString,
+					be_json.HaveKeyValue("email", be_string.ValidEmail(), be_string.ContainingSubstring("@tests.com"))),
+				sampleJSON},
+
+			{"string value matched by NonEmptyString",
+				be_json.Matcher(be_json.JsonAsString,
+					be_json.HaveKeyValue("name", be_string.NonEmptyString())),
+				sampleJSON},
+
+			// nested object value
+			{"nested object value via HaveKeyValue",
+				be_json.Matcher(be_json.JsonAsString,
+					be_json.HaveKeyValue("nested", be_json.HaveKeyValue("inner", "value"))),
+				sampleJSON},
+
+			{"nested object with reflected/math matcher on inner key",
+				be_json.Matcher(be_json.JsonAsString,
+					be_json.HaveKeyValue("nested", be.All(
+						be_reflected.AsObject(),
+						be_json.HaveKeyValue("count", be_reflected.AsFloat(), be_math.GreaterThan(2)),
+					))),
+				sampleJSON},
+
+			// array value
+			{"array value via HaveKeyValue (equality)",
+				be_json.Matcher(be_json.JsonAsString,
+					be_json.HaveKeyValue("tags", []any{"a", "b", "c"})),
+				sampleJSON},
+
+			{"array value reflected as slice with length",
+				be_json.Matcher(be_json.JsonAsString,
+					be_json.HaveKeyValue("tags", be.All(be_reflected.AsSlice(), be.HaveLength(3)))),
+				sampleJSON},
+
+			{"array of objects via ContainElement",
+				be_json.Matcher(be_json.JsonAsString,
+					be_json.HaveKeyValue("items", be.ContainElement(be_json.HaveKeyValue("key", "foo")))),
+				sampleJSON},
+
+			// be.Not(HaveKeyValue) for an absent key
+			{"Not for absent key",
+				be_json.Matcher(be_json.JsonAsString, be.Not(be_json.HaveKeyValue("missing_field"))),
+				sampleJSON},
+
+			// whole-document equality
+			{"whole document equality via map",
+				be_json.Matcher(be_json.JsonAsString, map[string]any{"foo": "bar"}),
+				`{"foo":"bar"}`},
+
+			// validity-only (no value args)
+			{"string is valid json (validity-only)",
+				be_json.Matcher(be_json.JsonAsString),
+				`{"any":"json"}`},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				// check gomega-compatible matching:
+				success, err := tc.matcher.Match(tc.actual)
+				be.Expect(t, err).To(be.Succeed())
+				be.Expect(t, success).To(be.True())
+
+				// check gomock-compatible matching:
+				success = tc.matcher.Matches(tc.actual)
+				be.Expect(t, success).To(be.True())
+			})
+		}
+	})
+
+	t.Run("should negatively match (string-like input)", func(t *testing.T) {
+		for _, tc := range []struct {
+			name    string
+			matcher types.BeMatcher
+			actual  any
+		}{
+			{"wrong string value",
+				be_json.Matcher(be_json.JsonAsString, be_json.HaveKeyValue("name", "not-gopher")),
+				sampleJSON},
+
+			{"wrong numeric value",
+				be_json.Matcher(be_json.JsonAsString, be_json.HaveKeyValue("n", float64(7))),
+				sampleJSON},
+
+			{"numeric value not GreaterThan 100",
+				be_json.Matcher(be_json.JsonAsString,
+					be_json.HaveKeyValue("n", be_reflected.AsFloat(), be_math.GreaterThan(100))),
+				sampleJSON},
+
+			{"absent key requested with HaveKeyValue",
+				be_json.Matcher(be_json.JsonAsString, be_json.HaveKeyValue("deleted_field")),
+				sampleJSON},
+
+			{"be.Not(HaveKeyValue) on present key fails",
+				be_json.Matcher(be_json.JsonAsString, be.Not(be_json.HaveKeyValue("name"))),
+				sampleJSON},
+
+			{"nested object wrong inner value",
+				be_json.Matcher(be_json.JsonAsString,
+					be_json.HaveKeyValue("nested", be_json.HaveKeyValue("inner", "wrong"))),
+				sampleJSON},
+
+			{"array of objects missing element",
+				be_json.Matcher(be_json.JsonAsString,
+					be_json.HaveKeyValue("items", be.ContainElement(be_json.HaveKeyValue("key", "absent")))),
+				sampleJSON},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				// check gomega-compatible matching:
+				success, err := tc.matcher.Match(tc.actual)
+				be.Expect(t, err).To(be.Succeed())
+				be.Expect(t, success).To(be.False())
+
+				// check gomock-compatible matching:
+				success = tc.matcher.Matches(tc.actual)
+				be.Expect(t, success).To(be.False())
+			})
+		}
+	})
 
 	// Reader-mode is tested with a fresh io.Reader factory per call: an io.Reader is
 	// single-use, so it cannot be matched twice (Match then Matches). We only call
 	// Match once here, recreating the reader for each entry via the factory.
-	DescribeTable(
-		"should match (io.Reader input)",
-		func(matcher types.BeMatcher, newReader func() io.Reader, expected bool) {
-			success, err := matcher.Match(newReader())
-			Expect(err).Should(Succeed())
-			Expect(success).To(Equal(expected))
-		},
-		Entry("reader mode -- simple string value matches",
-			be_json.Matcher(be_json.JsonAsReader, be_json.HaveKeyValue("name", "gopher")),
-			func() io.Reader { return strings.NewReader(sampleJSON) }, true),
-		Entry("reader mode -- numeric value matched by AsFloat",
-			be_json.Matcher(be_json.JsonAsReader, be_json.HaveKeyValue("n", be_reflected.AsFloat())),
-			func() io.Reader { return strings.NewReader(sampleJSON) }, true),
-		Entry(
-			"reader mode -- nested object matches",
-			be_json.Matcher(
-				be_json.JsonAsReader,
-				be_json.HaveKeyValue("nested", be_json.HaveKeyValue("inner", "value")),
-			),
-			func() io.Reader { return strings.NewReader(sampleJSON) },
-			true,
-		),
-		Entry("reader mode -- wrong value does not match",
-			be_json.Matcher(be_json.JsonAsReader, be_json.HaveKeyValue("name", "wrong")),
-			func() io.Reader { return strings.NewReader(sampleJSON) }, false),
-	)
+
+	t.Run("should match (io.Reader input)", func(t *testing.T) {
+		for _, tc := range []struct {
+			name      string
+			matcher   types.BeMatcher
+			newReader func() io.Reader
+			expected  bool
+		}{
+			{"reader mode -- simple string value matches",
+				be_json.Matcher(be_json.JsonAsReader, be_json.HaveKeyValue("name", "gopher")),
+				func() io.Reader { return strings.NewReader(sampleJSON) }, true},
+			{"reader mode -- numeric value matched by AsFloat",
+				be_json.Matcher(be_json.JsonAsReader, be_json.HaveKeyValue("n", be_reflected.AsFloat())),
+				func() io.Reader { return strings.NewReader(sampleJSON) }, true},
+			{"reader mode -- nested object matches",
+				be_json.Matcher(
+					be_json.JsonAsReader,
+					be_json.HaveKeyValue("nested", be_json.HaveKeyValue("inner", "value")),
+				),
+				func() io.Reader { return strings.NewReader(sampleJSON) },
+				true},
+			{"reader mode -- wrong value does not match",
+				be_json.Matcher(be_json.JsonAsReader, be_json.HaveKeyValue("name", "wrong")),
+				func() io.Reader { return strings.NewReader(sampleJSON) }, false},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				success, err := tc.matcher.Match(tc.newReader())
+				be.Expect(t, err).To(be.Succeed())
+				be.Expect(t, success).To(be.Eq(tc.expected))
+			})
+		}
+	})
 
 	// On input that can't be parsed as JSON the matcher surfaces the parse error
 	// (v1 contract: un-evaluatable input -> error, not a silent non-match) and must
 	// never panic.
-	DescribeTable("should error (no panic) on invalid json input", func(matcher types.BeMatcher, actual any) {
-		Expect(func() {
-			success, err := matcher.Match(actual)
-			Expect(err).To(HaveOccurred())
-			Expect(success).To(BeFalse())
-		}).NotTo(Panic())
-	},
-		Entry("malformed json string",
-			be_json.Matcher(be_json.JsonAsString, be_json.HaveKeyValue("name", "gopher")),
-			`{"name": `),
-		Entry("non-json string",
-			be_json.Matcher(be_json.JsonAsString, be_json.HaveKeyValue("name", "gopher")),
-			`this is not json`),
-	)
-
-	DescribeTable("should return a valid failure message", func(matcher types.BeMatcher, actual any, substr string) {
-		// FailureMessage is considered to be called after matching:
-		_, _ = matcher.Match(actual)
 
-		failureMessage := matcher.FailureMessage(actual)
-		Expect(failureMessage).To(ContainSubstring(substr))
-	},
-		Entry("wrong string value mentions expected key",
-			be_json.Matcher(be_json.JsonAsString, be_json.HaveKeyValue("name", "not-gopher")),
-			sampleJSON, "name"),
-
-		Entry("absent key failure mentions the key",
-			be_json.Matcher(be_json.JsonAsString, be_json.HaveKeyValue("deleted_field")),
-			sampleJSON, "deleted_field"),
-	)
-})
+	t.Run("should leave a seekable reader open to be read again", func(t *testing.T) {
+		f, err := os.CreateTemp(t.TempDir(), "*.json")
+		be.Expect(t, err).To(be.Nil())
+		_, err = f.WriteString(sampleJSON)
+		be.Expect(t, err).To(be.Nil())
+		_, err = f.Seek(0, io.SeekStart)
+		be.Expect(t, err).To(be.Nil())
+
+		// decoded, encoded and decoded again: each one reads the file from the start
+		be.Expect(t, f).To(be_json.Matcher(be_json.JsonAsReader, be_json.HaveKeyValue("name", "gopher")))
+		be.Expect(t, f).To(be_json.Equivalent([]byte(sampleJSON)))
+		be.Expect(t, be.Check(f, be_json.Matcher(be_json.HaveKeyValue("n", 42.0))).Success).To(be.True())
+
+		// it's up to the caller to close it
+		be.Expect(t, f.Close()).To(be.Nil())
+	})
+
+	t.Run("should error (no panic) on invalid json input", func(t *testing.T) {
+		for _, tc := range []struct {
+			name    string
+			matcher types.BeMatcher
+			actual  any
+		}{
+			{"malformed json string",
+				be_json.Matcher(be_json.JsonAsString, be_json.HaveKeyValue("name", "gopher")),
+				`{"name": `},
+			{"non-json string",
+				be_json.Matcher(be_json.JsonAsString, be_json.HaveKeyValue("name", "gopher")),
+				`this is not json`},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				be.Expect(t, func() {
+					success, err := tc.matcher.Match(tc.actual)
+					be.Expect(t, err).To(be.HaveOccurred())
+					be.Expect(t, success).To(be.False())
+				}).NotTo(be.Panic())
+			})
+		}
+	})
+
+	t.Run("should error on a value that is not an object", func(t *testing.T) {
+		_, err := be_json.HaveKeyValue("name").Match([]string{"gopher"})
+		be.Expect(t, err).To(be.MatchError("HaveKeyValue matcher expects a map or an iter.Seq2.  Got:\n    <[]string>: [\"gopher\"]"))
+	})
+
+	t.Run("should select values by JSON Pointer and JSONPath", func(t *testing.T) {
+		for _, tc := range []struct {
+			name     string
+			matcher  types.BeMatcher
+			actual   any
+			expected bool
+		}{
+			{"At on a string", be_json.At("/items/1/key", "bar"), sampleJSON, true},
+			{"At on bytes", be_json.At("/nested/count", be_reflected.AsFloat()), []byte(sampleJSON), true},
+			{"At on a reader", be_json.At("/tags/0", "a"), strings.NewReader(sampleJSON), true},
+			{"At on a decoded object", be_json.At("/name"), map[string]any{"name": "gopher"}, true},
+			{"At inside Matcher", be_json.Matcher(be_json.JsonAsString, be_json.At("/name", "gopher")), sampleJSON, true},
+			{"At on a mismatching value", be_json.At("/items/1/key", "foo"), sampleJSON, false},
+			{"At on a missing value", be_json.At("/items/2/key"), sampleJSON, false},
+			{"Query on a string", be_json.Query("$.items[*].key", be.ConsistOf("foo", "bar")), sampleJSON, true},
+			{"Query with a filter", be_json.Query("$.items[?(@.key=='bar')]", be.HaveLength(1)), strings.NewReader(sampleJSON), true},
+			{"Query selecting nothing", be_json.Query("$.items[?(@.key=='baz')]"), sampleJSON, false},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				success, err := tc.matcher.Match(tc.actual)
+				be.Expect(t, err).To(be.Succeed())
+				be.Expect(t, success).To(be.Eq(tc.expected))
+			})
+		}
+	})
+
+	t.Run("should validate against a JSON schema", func(t *testing.T) {
+		for _, tc := range []struct {
+			name     string
+			matcher  types.BeMatcher
+			actual   any
+			expected bool
+		}{
+			{"valid string", be_json.MatchSchema(sampleSchema), sampleJSON, true},
+			{"valid reader", be_json.MatchSchema([]byte(sampleSchema)), strings.NewReader(sampleJSON), true},
+			{"valid struct", be_json.MatchSchema(`{"required": ["Name"]}`), struct{ Name string }{"gopher"}, true},
+			{"inside Matcher", be_json.Matcher(be_json.JsonAsString, be_json.MatchSchema(sampleSchema)), sampleJSON, true},
+			{"file", be_json.MatchSchemaFile(fstest.MapFS{"schema.json": {Data: []byte(sampleSchema)}}, "schema.json"), sampleJSON, true},
+			{"invalid", be_json.MatchSchema(sampleSchema), `{"name": "gopher", "n": "42"}`, false},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				success, err := tc.matcher.Match(tc.actual)
+				be.Expect(t, err).To(be.Succeed())
+				be.Expect(t, success).To(be.Eq(tc.expected))
+			})
+		}
+	})
+
+	t.Run("should compare JSON semantically", func(t *testing.T) {
+		for _, tc := range []struct {
+			name     string
+			matcher  types.BeMatcher
+			actual   any
+			expected bool
+		}{
+			{"reordered keys", be_json.Equivalent(`{"b": 1, "a": [1, 2]}`), `{"a": [1, 2], "b": 1}`, true},
+			{"reader", be_json.Equivalent([]byte(`{"b": 1}`)), strings.NewReader(`{"b": 1.0}`), true},
+			{"decoded expected", be_json.Equivalent(map[string]int{"b": 1}), `{"b": 1}`, true},
+			{"different value", be_json.Equivalent(`{"b": 1}`), `{"b": 2}`, false},
+			{"IgnorePaths",
+				be_json.Equivalent(`{"id": 1, "meta": {"createdAt": "x", "v": 1}}`, be_json.IgnorePaths("/id", "/meta/createdAt")),
+				`{"id": 7, "meta": {"createdAt": "y", "v": 1}}`, true},
+			{"UnorderedArrays", be_json.Equivalent(`{"tags": ["a", "b"]}`, be_json.UnorderedArrays("/tags")), `{"tags": ["b", "a"]}`, true},
+			{"UnorderedArrays of every array", be_json.Equivalent(`[[1, 2], [3]]`, be_json.UnorderedArrays()), `[[3], [2, 1]]`, true},
+			{"NumberTolerance", be_json.Equivalent(`{"x": 0.3}`, be_json.NumberTolerance(1e-9)), `{"x": 0.30000000001}`, true},
+			{"AllowExtraKeys", be_json.Equivalent(`{"x": 1}`, be_json.AllowExtraKeys()), `{"x": 1, "y": 2}`, true},
+			{"extra keys", be_json.Equivalent(`{"x": 1}`), `{"x": 1, "y": 2}`, false},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				success, err := tc.matcher.Match(tc.actual)
+				be.Expect(t, err).To(be.Succeed())
+				be.Expect(t, success).To(be.Eq(tc.expected))
+			})
+		}
+	})
+
+	t.Run("should match a JSON template", func(t *testing.T) {
+		for _, tc := range []struct {
+			name     string
+			matcher  types.BeMatcher
+			actual   any
+			expected bool
+		}{
+			{"matching placeholders", sampleTemplate(),
+				`{"id": "usr_42", "email": "user@tests.com", "items": [{"n": 3}]}`, true},
+			{"reader", sampleTemplate(),
+				strings.NewReader(`{"id": "usr_42", "email": "user@tests.com", "items": [{"n": 3}]}`), true},
+			{"a placeholder not matching", sampleTemplate(),
+				`{"id": "usr_42", "email": "user@tests.com", "items": [{"n": 0}]}`, false},
+			{"a literal not matching", be_json.MatchTemplate(`{"name": "gopher", "id": "{{id}}"}`),
+				`{"name": "badger", "id": 1}`, false},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				success, err := tc.matcher.Match(tc.actual)
+				be.Expect(t, err).To(be.Succeed())
+				be.Expect(t, success).To(be.Eq(tc.expected))
+			})
+		}
+	})
+
+	t.Run("should error on an invalid configuration, negated as well", func(t *testing.T) {
+		for _, tc := range []struct {
+			name    string
+			matcher types.BeMatcher
+			substr  string
+		}{
+			{"invalid json", be_json.Matcher(be_json.JsonAsString, `{"x": `), "unexpected end of JSON input"},
+			{"invalid pointer", be_json.At("name"), "invalid JSON pointer"},
+			{"invalid query", be_json.Query("$.items["), "invalid JSONPath query"},
+			{"invalid schema", be_json.MatchSchema(`{"type": 1}`), "invalid JSON schema"},
+			{"missing schema file", be_json.MatchSchemaFile(fstest.MapFS{}, "schema.json"), "schema.json"},
+			{"invalid expected", be_json.Equivalent(`{"x": `), "invalid expected JSON"},
+			{"a value with no placeholder", be_json.MatchTemplate(`{"id": 1}`, be_json.V("id", 1)), "no {{id}} placeholder"},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := tc.matcher.Match(sampleJSON)
+				be.Expect(t, err).To(be.MatchError(be.ContainSubstring(tc.substr)))
+
+				_, err = be.Not(tc.matcher).Match(sampleJSON)
+				be.Expect(t, err).To(be.MatchError(be.ContainSubstring(tc.substr)))
+			})
+		}
+	})
+
+	t.Run("should decode into a Go type", func(t *testing.T) {
+		for _, tc := range []struct {
+			name     string
+			matcher  types.BeMatcher
+			actual   any
+			expected bool
+		}{
+			{"string", be_json.As[sample](be.HaveField("N", 42)), sampleJSON, true},
+			{"bytes", be_json.As[sample](be.HaveField("Tags", be.HaveLength(3))), []byte(sampleJSON), true},
+			{"reader", be_json.As[sample](be.HaveField("Name", "gopher")), strings.NewReader(sampleJSON), true},
+			{"decoded object", be_json.As[sample](be.HaveField("N", 7)), map[string]any{"n": 7}, true},
+			{"struct fields matcher", be_json.As[sample](
+				be_struct.HavingField[sample]("Email", be_string.ValidEmail()),
+				be.HaveField("Nested.Count", be.Gt(2)),
+			), sampleJSON, true},
+			{"no matchers", be_json.As[map[string]int](), `{"a": 1}`, true},
+			{"not matching", be_json.As[sample](be.HaveField("N", 41)), sampleJSON, false},
+			{"inside Matcher", be_json.Matcher(be_json.JsonAsString, be_json.As[sample](be.HaveField("N", 42))), sampleJSON, true},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				success, err := tc.matcher.Match(tc.actual)
+				be.Expect(t, err).To(be.Succeed())
+				be.Expect(t, success).To(be.Eq(tc.expected))
+			})
+		}
+	})
+
+	t.Run("should error on JSON that can't be decoded into the type", func(t *testing.T) {
+		_, err := be_json.As[sample]().Match(`{"n": "42"}`)
+		be.Expect(t, err).To(be.MatchError(be.ContainSubstring("decode as be_json_test.sample: json: cannot unmarshal string")))
+
+		_, err = be_json.As[sample]().Match(`{"n": `)
+		be.Expect(t, err).To(be.MatchError(be.ContainSubstring("unexpected EOF")))
+	})
+
+	t.Run("should disallow unknown fields if asked to", func(t *testing.T) {
+		be.ExpectValues(t)(be_json.As[sample](be.HaveField("N", 42)).Match(`{"n": 42, "extra": 1}`)).To(be.True())
+
+		_, err := be_json.As[sample](be.HaveField("N", 42), be_json.DisallowUnknownFields()).Match(`{"n": 42, "extra": 1}`)
+		be.Expect(t, err).To(be.MatchError(be.ContainSubstring(`json: unknown field "extra"`)))
+	})
+
+	t.Run("should return a valid failure message", func(t *testing.T) {
+		for _, tc := range []struct {
+			name    string
+			matcher types.BeMatcher
+			actual  any
+			substr  string
+		}{
+			{"wrong string value mentions expected key",
+				be_json.Matcher(be_json.JsonAsString, be_json.HaveKeyValue("name", "not-gopher")),
+				sampleJSON, "name"},
+
+			{"absent key failure mentions the key",
+				be_json.Matcher(be_json.JsonAsString, be_json.HaveKeyValue("deleted_field")),
+				sampleJSON, "deleted_field"},
+
+			{"At tells where a missing value's path breaks off",
+				be_json.At("/items/2/key"), sampleJSON, `but ["items"] has 2 elements, there is no [2]`},
+
+			{"At reports a mismatching value at its path",
+				be_json.At("/items/1/key", "foo"), sampleJSON, `at ["items"][1]["key"]`},
+
+			{"Query tells nothing was selected",
+				be_json.Query("$.missing"), sampleJSON, "but nothing was selected"},
+
+			{"MatchSchema lists the violations",
+				be_json.MatchSchema(sampleSchema), `{"name": 1, "tags": ["a", "a"]}`,
+				`["name"]: got number, want string (#/properties/name/type)`},
+
+			{"Equivalent shows the differences by path",
+				be_json.Equivalent(`{"id": 1, "tags": ["a"]}`, be_json.IgnorePaths("/id")), `{"id": 2, "tags": ["b"]}`,
+				"- [\"tags\"][0]: \"a\"\n  + [\"tags\"][0]: \"b\""},
+
+			{"MatchTemplate shows the placeholder not matching by path",
+				sampleTemplate(),
+				`{"id": "usr_42", "email": "not-an-email", "items": [{"n": 3}]}`,
+				`["email"]: "{{email}}": `},
+
+			{"json equality reports only the differing paths",
+				be_json.Matcher(be_json.JsonAsString,
+					strings.Replace(sampleJSON, `"inner": "value"`, `"inner": "other"`, 1)),
+				sampleJSON, "- [\"nested\"][\"inner\"]: \"other\"\n  + [\"nested\"][\"inner\"]: \"value\""},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				// FailureMessage is considered to be called after matching:
+				_, _ = tc.matcher.Match(tc.actual)
+
+				failureMessage := tc.matcher.FailureMessage(tc.actual)
+				be.Expect(t, failureMessage).To(be.ContainSubstring(tc.substr))
+			})
+		}
+	})
+}
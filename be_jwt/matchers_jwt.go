@@ -9,7 +9,6 @@ import (
 
 	"github.com/amberpixels/k1/cast"
 	"github.com/golang-jwt/jwt/v5"
-	"github.com/onsi/gomega"
 
 	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
 	"github.com/expectto/be/internal/psi_matchers"
@@ -70,10 +69,10 @@ func Token(args ...any) types.BeMatcher {
 		// match given string to raw token
 		return psi_matchers.NewJwtTokenMatcher("Raw", func(t *jwt.Token) any {
 			return t.Raw
-		}, gomega.Equal(args[0]))
+		}, NewEqMatcher(args[0]))
 	}
 
-	return Psi(args...)
+	return WithPath("token", Psi(args...))
 }
 
 // Valid succeeds if the actual value is a JWT token and it's valid
@@ -81,7 +80,7 @@ func Valid() types.BeMatcher {
 	return psi_matchers.NewJwtTokenMatcher(
 		"Valid",
 		func(u *jwt.Token) any { return u.Valid },
-		gomega.BeTrue(),
+		psi_matchers.NewBoolMatcher(true),
 	)
 }
 
@@ -132,6 +131,6 @@ func SignedVia(secret string) types.BeMatcher {
 			text := strings.Join(strings.Split(u.Raw, ".")[0:2], ".")
 			return u.Method.Verify(text, u.Signature, []byte(secret))
 		},
-		gomega.BeNil(),
+		psi_matchers.NewNilMatcher(),
 	)
 }
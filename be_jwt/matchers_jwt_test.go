@@ -1,10 +1,11 @@
 package be_jwt_test
 
 import (
+	"testing"
+
 	"github.com/golang-jwt/jwt/v5"
-	. "github.com/onsi/ginkgo/v2"
-	. "github.com/onsi/gomega"
 
+	"github.com/expectto/be"
 	"github.com/expectto/be/be_jwt"
 	"github.com/expectto/be/types"
 )
@@ -60,153 +61,183 @@ func tamper(token string) string {
 	return string(b)
 }
 
-var _ = Describe("BeJwt", func() {
-	DescribeTable("should positively match", func(matcher types.BeMatcher, actual any) {
-		// gomega-compatible matching:
-		success, err := matcher.Match(actual)
-		Expect(err).Should(Succeed())
-		Expect(success).To(BeTrue())
-
-		// gomock-compatible matching:
-		success = matcher.Matches(actual)
-		Expect(success).To(BeTrue())
-	},
-		// Valid: token parsed+verified with the correct secret is valid.
-		Entry("valid signed token is Valid()",
-			be_jwt.Token(be_jwt.TransformSignedJwtFromString(secret), be_jwt.Valid()), validHS256),
-
-		// HavingClaim with the correct value.
-		Entry(
-			"token has claim name=John Doe",
-			be_jwt.Token(
-				be_jwt.TransformSignedJwtFromString(secret),
-				be_jwt.HavingClaim("name", "John Doe"),
-			),
-			validHS256,
-		),
-		Entry("token has claim admin=true",
-			be_jwt.Token(be_jwt.TransformSignedJwtFromString(secret), be_jwt.HavingClaim("admin", true)), validHS256),
-		Entry(
-			"token has claim sub=1234567890",
-			be_jwt.Token(
-				be_jwt.TransformSignedJwtFromString(secret),
-				be_jwt.HavingClaim("sub", "1234567890"),
-			),
-			validHS256,
-		),
-
-		// HavingClaims: match the whole claims map.
-		Entry(
-			"token claims contain name key",
-			be_jwt.Token(
-				be_jwt.TransformSignedJwtFromString(secret),
-				be_jwt.HavingClaims(HaveKeyWithValue("name", "John Doe")),
-			),
-			validHS256,
-		),
-
-		// HavingMethodAlg.
-		Entry("HS256 token has method alg HS256",
-			be_jwt.Token(be_jwt.TransformSignedJwtFromString(secret), be_jwt.HavingMethodAlg("HS256")), validHS256),
-		Entry("HS384 token has method alg HS384",
-			be_jwt.Token(be_jwt.TransformSignedJwtFromString(secret), be_jwt.HavingMethodAlg("HS384")), validHS384),
-
-		// SignedVia on a secret-less parsed token.
-		Entry("token is SignedVia(secret) when parsed without verification",
-			be_jwt.Token(be_jwt.TransformJwtFromString, be_jwt.SignedVia(secret)), validHS256),
-
-		// Combining several matchers on the same token.
-		Entry("valid token with correct alg and claim",
-			be_jwt.Token(be_jwt.TransformSignedJwtFromString(secret),
-				be_jwt.Valid(),
-				be_jwt.HavingMethodAlg("HS256"),
-				be_jwt.HavingClaim("name", "John Doe"),
-			), validHS256),
-	)
-
-	DescribeTable("should negatively match", func(matcher types.BeMatcher, actual any) {
-		// gomega-compatible matching:
-		success, _ := matcher.Match(actual)
-		Expect(success).To(BeFalse())
-
-		// gomock-compatible matching:
-		success = matcher.Matches(actual)
-		Expect(success).To(BeFalse())
-	},
-		// Wrong claim value.
-		Entry(
-			"claim name is not Jane Doe",
-			be_jwt.Token(
-				be_jwt.TransformSignedJwtFromString(secret),
-				be_jwt.HavingClaim("name", "Jane Doe"),
-			),
-			validHS256,
-		),
-		Entry("claim admin is not false",
-			be_jwt.Token(be_jwt.TransformSignedJwtFromString(secret), be_jwt.HavingClaim("admin", false)), validHS256),
-
-		// Wrong algorithm.
-		Entry("HS256 token does not have method alg HS384",
-			be_jwt.Token(be_jwt.TransformSignedJwtFromString(secret), be_jwt.HavingMethodAlg("HS384")), validHS256),
-
-		// SignedVia with the wrong secret on a parsed (unverified) token.
-		Entry("token is not SignedVia(wrongSecret)",
-			be_jwt.Token(be_jwt.TransformJwtFromString, be_jwt.SignedVia(wrongSecret)), validHS256),
+func TestBeJwt(t *testing.T) {
+	t.Run("should positively match", func(t *testing.T) {
+		for _, tc := range []struct {
+			name    string
+			matcher types.BeMatcher
+			actual  any
+		}{
+			// Valid: token parsed+verified with the correct secret is valid.
+			{"valid signed token is Valid()",
+				be_jwt.Token(be_jwt.TransformSignedJwtFromString(secret), be_jwt.Valid()), validHS256},
+
+			// HavingClaim with the correct value.
+			{"token has claim name=John Doe",
+				be_jwt.Token(
+					be_jwt.TransformSignedJwtFromString(secret),
+					be_jwt.HavingClaim("name", "John Doe"),
+				),
+				validHS256},
+			{"token has claim admin=true",
+				be_jwt.Token(be_jwt.TransformSignedJwtFromString(secret), be_jwt.HavingClaim("admin", true)), validHS256},
+			{"token has claim sub=1234567890",
+				be_jwt.Token(
+					be_jwt.TransformSignedJwtFromString(secret),
+					be_jwt.HavingClaim("sub", "1234567890"),
+				),
+				validHS256},
+
+			// HavingClaims: match the whole claims map.
+			{"token claims contain name key",
+				be_jwt.Token(
+					be_jwt.TransformSignedJwtFromString(secret),
+					be_jwt.HavingClaims(be.HaveKeyWithValue("name", "John Doe")),
+				),
+				validHS256},
+
+			// HavingMethodAlg.
+			{"HS256 token has method alg HS256",
+				be_jwt.Token(be_jwt.TransformSignedJwtFromString(secret), be_jwt.HavingMethodAlg("HS256")), validHS256},
+			{"HS384 token has method alg HS384",
+				be_jwt.Token(be_jwt.TransformSignedJwtFromString(secret), be_jwt.HavingMethodAlg("HS384")), validHS384},
+
+			// SignedVia on a secret-less parsed token.
+			{"token is SignedVia(secret) when parsed without verification",
+				be_jwt.Token(be_jwt.TransformJwtFromString, be_jwt.SignedVia(secret)), validHS256},
+
+			// Combining several matchers on the same token.
+			{"valid token with correct alg and claim",
+				be_jwt.Token(be_jwt.TransformSignedJwtFromString(secret),
+					be_jwt.Valid(),
+					be_jwt.HavingMethodAlg("HS256"),
+					be_jwt.HavingClaim("name", "John Doe"),
+				), validHS256},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				// gomega-compatible matching:
+				success, err := tc.matcher.Match(tc.actual)
+				be.Expect(t, err).To(be.Succeed())
+				be.Expect(t, success).To(be.True())
+
+				// gomock-compatible matching:
+				success = tc.matcher.Matches(tc.actual)
+				be.Expect(t, success).To(be.True())
+			})
+		}
+	})
 
-		// Tampered token parsed without verification, then signature-checked.
-		Entry("tampered token is not SignedVia(secret)",
-			be_jwt.Token(be_jwt.TransformJwtFromString, be_jwt.SignedVia(secret)), tamperedHS256),
-	)
+	t.Run("should negatively match", func(t *testing.T) {
+		for _, tc := range []struct {
+			name    string
+			matcher types.BeMatcher
+			actual  any
+		}{
+			// Wrong claim value.
+			{"claim name is not Jane Doe",
+				be_jwt.Token(
+					be_jwt.TransformSignedJwtFromString(secret),
+					be_jwt.HavingClaim("name", "Jane Doe"),
+				),
+				validHS256},
+			{"claim admin is not false",
+				be_jwt.Token(be_jwt.TransformSignedJwtFromString(secret), be_jwt.HavingClaim("admin", false)), validHS256},
+
+			// Wrong algorithm.
+			{"HS256 token does not have method alg HS384",
+				be_jwt.Token(be_jwt.TransformSignedJwtFromString(secret), be_jwt.HavingMethodAlg("HS384")), validHS256},
+
+			// SignedVia with the wrong secret on a parsed (unverified) token.
+			{"token is not SignedVia(wrongSecret)",
+				be_jwt.Token(be_jwt.TransformJwtFromString, be_jwt.SignedVia(wrongSecret)), validHS256},
+
+			// Tampered token parsed without verification, then signature-checked.
+			{"tampered token is not SignedVia(secret)",
+				be_jwt.Token(be_jwt.TransformJwtFromString, be_jwt.SignedVia(secret)), tamperedHS256},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				// gomega-compatible matching:
+				success, _ := tc.matcher.Match(tc.actual)
+				be.Expect(t, success).To(be.False())
+
+				// gomock-compatible matching:
+				success = tc.matcher.Matches(tc.actual)
+				be.Expect(t, success).To(be.False())
+			})
+		}
+	})
 
 	// When the signed transform itself can't verify (wrong/another secret, tampered
 	// signature) there is no token to match, so the matcher surfaces the signature
 	// error (v1 contract: un-evaluatable input -> error) and must not panic.
-	DescribeTable(
-		"should error (no panic) when the signed transform can't verify",
-		func(matcher types.BeMatcher, actual any) {
-			Expect(func() {
-				success, err := matcher.Match(actual)
-				Expect(err).To(HaveOccurred())
-				Expect(success).To(BeFalse())
-			}).NotTo(Panic())
-		},
-		Entry("verifying with the wrong secret",
-			be_jwt.Token(be_jwt.TransformSignedJwtFromString(wrongSecret), be_jwt.Valid()), validHS256),
-		Entry("token signed with another secret",
-			be_jwt.Token(be_jwt.TransformSignedJwtFromString(secret), be_jwt.Valid()), signedWithWrongSecret),
-		Entry("tampered token",
-			be_jwt.Token(be_jwt.TransformSignedJwtFromString(secret), be_jwt.Valid()), tamperedHS256),
-	)
-
-	DescribeTable("should fail gracefully (no panic) on non-token input", func(matcher types.BeMatcher, actual any) {
-		Expect(func() {
-			success, err := matcher.Match(actual)
-			// non-*jwt.Token input is reported as a clean non-match
-			Expect(err).ShouldNot(HaveOccurred())
-			Expect(success).To(BeFalse())
-		}).NotTo(Panic())
-	},
-		Entry("Valid() on a plain string", be_jwt.Valid(), "not-a-token"),
-		Entry("HavingClaim on an int", be_jwt.HavingClaim("name", "John Doe"), 42),
-	)
-
-	DescribeTable("should return a valid failure message", func(matcher types.BeMatcher, actual any, message string) {
-		// FailureMessage is considered to be called after matching:
-		_, _ = matcher.Match(actual)
-		Expect(matcher.FailureMessage(actual)).To(Equal(message))
-	},
-		Entry("wrong claim value failure message",
-			be_jwt.HavingClaim("name", "Jane Doe"),
-			parse(validHS256),
-			"Expected\n    <string>: John Doe\nto equal\n    <string>: Jane Doe",
-		),
-		Entry("wrong method alg failure message",
-			be_jwt.HavingMethodAlg("HS384"),
-			parse(validHS256),
-			"Expected\n    <string>: HS256\nto equal\n    <string>: HS384",
-		),
-	)
-})
+
+	t.Run("should error (no panic) when the signed transform can't verify", func(t *testing.T) {
+		for _, tc := range []struct {
+			name    string
+			matcher types.BeMatcher
+			actual  any
+		}{
+			{"verifying with the wrong secret",
+				be_jwt.Token(be_jwt.TransformSignedJwtFromString(wrongSecret), be_jwt.Valid()), validHS256},
+			{"token signed with another secret",
+				be_jwt.Token(be_jwt.TransformSignedJwtFromString(secret), be_jwt.Valid()), signedWithWrongSecret},
+			{"tampered token",
+				be_jwt.Token(be_jwt.TransformSignedJwtFromString(secret), be_jwt.Valid()), tamperedHS256},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				be.Expect(t, func() {
+					success, err := tc.matcher.Match(tc.actual)
+					be.Expect(t, err).To(be.HaveOccurred())
+					be.Expect(t, success).To(be.False())
+				}).NotTo(be.Panic())
+			})
+		}
+	})
+
+	t.Run("should fail gracefully (no panic) on non-token input", func(t *testing.T) {
+		for _, tc := range []struct {
+			name    string
+			matcher types.BeMatcher
+			actual  any
+		}{
+			{"Valid() on a plain string", be_jwt.Valid(), "not-a-token"},
+			{"HavingClaim on an int", be_jwt.HavingClaim("name", "John Doe"), 42},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				be.Expect(t, func() {
+					success, err := tc.matcher.Match(tc.actual)
+					// non-*jwt.Token input is reported as a clean non-match
+					be.Expect(t, err).NotTo(be.HaveOccurred())
+					be.Expect(t, success).To(be.False())
+				}).NotTo(be.Panic())
+			})
+		}
+	})
+
+	t.Run("should return a valid failure message", func(t *testing.T) {
+		for _, tc := range []struct {
+			name    string
+			matcher types.BeMatcher
+			actual  any
+			message string
+		}{
+			{"wrong claim value failure message",
+				be_jwt.HavingClaim("name", "Jane Doe"),
+				parse(validHS256),
+				"at Claim[name]:\nExpected\n    <string>: John Doe\nto equal\n    <string>: Jane Doe"},
+			{"wrong method alg failure message",
+				be_jwt.HavingMethodAlg("HS384"),
+				parse(validHS256),
+				"at Method.Alg():\nExpected\n    <string>: HS256\nto equal\n    <string>: HS384"},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				// FailureMessage is considered to be called after matching:
+				_, _ = tc.matcher.Match(tc.actual)
+				be.Expect(t, tc.matcher.FailureMessage(tc.actual)).To(be.Eq(tc.message))
+			})
+		}
+	})
+}
 
 // parse is a test helper that parses a signed string into a *jwt.Token (without
 // verification) so matchers can be exercised directly against *jwt.Token.
@@ -6,8 +6,6 @@ import (
 	"math"
 
 	"github.com/amberpixels/k1/cast"
-	"github.com/onsi/gomega"
-	"github.com/onsi/gomega/gcustom"
 
 	"github.com/expectto/be/be_reflected"
 	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
@@ -17,27 +15,27 @@ import (
 
 // GreaterThan succeeds if actual is numerically greater than the passed-in value.
 func GreaterThan(arg any) types.BeMatcher {
-	return Psi(gomega.BeNumerically(">", arg))
+	return psi_matchers.NewNumericMatcher(">", arg)
 }
 
 // GreaterThanEqual succeeds if actual is numerically greater than or equal to the passed-in value.
 func GreaterThanEqual(arg any) types.BeMatcher {
-	return Psi(gomega.BeNumerically(">=", arg))
+	return psi_matchers.NewNumericMatcher(">=", arg)
 }
 
 // LessThan succeeds if actual is numerically less than the passed-in value.
 func LessThan(arg any) types.BeMatcher {
-	return Psi(gomega.BeNumerically("<", arg))
+	return psi_matchers.NewNumericMatcher("<", arg)
 }
 
 // LessThanEqual succeeds if actual is numerically less than or equal to the passed-in value.
 func LessThanEqual(arg any) types.BeMatcher {
-	return Psi(gomega.BeNumerically("<=", arg))
+	return psi_matchers.NewNumericMatcher("<=", arg)
 }
 
 // Approx succeeds if actual is numerically approximately equal to the passed-in value within the specified threshold.
 func Approx(compareTo, threshold any) types.BeMatcher {
-	return Psi(gomega.BeNumerically("~", compareTo, threshold))
+	return psi_matchers.NewNumericMatcher("~", compareTo, threshold)
 }
 
 // InRange succeeds if actual is numerically within the specified range.
@@ -75,7 +73,7 @@ func InRange(from any, fromInclusive bool, until any, untilInclusive bool) types
 func Odd() types.BeMatcher {
 	return Psi(psi_matchers.NewAllMatcher(
 		be_reflected.AsInteger(),
-		gcustom.MakeMatcher(func(actual any) (bool, error) {
+		FromMatchFunc(func(actual any) (bool, error) {
 			return cast.AsInt(actual)%2 != 0, nil
 		}),
 	), "be an odd number")
@@ -85,7 +83,7 @@ func Odd() types.BeMatcher {
 func Even() types.BeMatcher {
 	return Psi(psi_matchers.NewAllMatcher(
 		be_reflected.AsInteger(),
-		gcustom.MakeMatcher(func(actual any) (bool, error) {
+		FromMatchFunc(func(actual any) (bool, error) {
 			return cast.AsInt(actual)%2 == 0, nil
 		}),
 	), "be an even number")
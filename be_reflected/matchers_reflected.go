@@ -11,7 +11,6 @@ import (
 	"strconv"
 
 	"github.com/amberpixels/k1/cast"
-	"github.com/onsi/gomega"
 
 	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
 	"github.com/expectto/be/internal/psi_matchers"
@@ -79,7 +78,7 @@ func AsPointerToSlice() types.BeMatcher {
 func AsSliceOf[T any]() types.BeMatcher {
 	return Psi(psi_matchers.NewAllMatcher(
 		AsKind(reflect.Slice),
-		gomega.HaveEach(AssignableTo[T]()),
+		NewDiveMatcher(AssignableTo[T](), DiveModeEvery),
 	), "be a slice of "+reflect.TypeFor[T]().String())
 }
 
@@ -140,8 +139,8 @@ func AsBytes() types.BeMatcher { return Psi(AssignableTo[[]byte](), "be bytes")
 // and floating-point kinds: reflect.Float32, reflect.Float64
 func AsNumber() types.BeMatcher {
 	return Psi(AsKind(
-		gomega.BeNumerically(">=", reflect.Int),
-		gomega.BeNumerically("<=", reflect.Float64),
+		psi_matchers.NewNumericMatcher(">=", reflect.Int),
+		psi_matchers.NewNumericMatcher("<=", reflect.Float64),
 	), "be a number")
 }
 
@@ -152,15 +151,15 @@ func AsNumericString() types.BeMatcher {
 		WithFallibleTransform(func(actual any) any {
 			_, err := strconv.ParseFloat(cast.AsString(actual), 64)
 			return err == nil
-		}, gomega.BeTrue()),
+		}, psi_matchers.NewBoolMatcher(true)),
 	), "be a numeric string")
 }
 
 // AsInteger succeeds if actual is a numeric value that represents an integer (from reflect.Int up to reflect.Uint64).
 func AsInteger() types.BeMatcher {
 	return Psi(AsKind(
-		gomega.BeNumerically(">=", reflect.Int),
-		gomega.BeNumerically("<=", reflect.Uint64),
+		psi_matchers.NewNumericMatcher(">=", reflect.Int),
+		psi_matchers.NewNumericMatcher("<=", reflect.Uint64),
 	), "be an integer value")
 }
 
@@ -171,15 +170,15 @@ func AsIntegerString() types.BeMatcher {
 		WithFallibleTransform(func(actual any) any {
 			_, err := strconv.ParseInt(cast.AsString(actual), 10, 64)
 			return err == nil
-		}, gomega.BeTrue()),
+		}, psi_matchers.NewBoolMatcher(true)),
 	), "be an integer-ish string")
 }
 
 // AsFloat succeeds if actual is a numeric value that represents a floating-point value.
 func AsFloat() types.BeMatcher {
 	return Psi(AsKind(
-		gomega.BeNumerically(">=", reflect.Float32),
-		gomega.BeNumerically("<=", reflect.Float64),
+		psi_matchers.NewNumericMatcher(">=", reflect.Float32),
+		psi_matchers.NewNumericMatcher("<=", reflect.Float64),
 	), "be a float value")
 }
 
@@ -196,6 +195,6 @@ func AsFloatString() types.BeMatcher {
 		WithFallibleTransform(func(actual any) any {
 			_, err := strconv.ParseFloat(cast.AsString(actual), 64)
 			return err == nil
-		}, gomega.BeTrue()),
+		}, psi_matchers.NewBoolMatcher(true)),
 	), "be a float-ish string")
 }
@@ -3,112 +3,133 @@ package be_reflected_test
 import (
 	"fmt"
 	"reflect"
+	"testing"
 
-	. "github.com/onsi/ginkgo/v2"
-	. "github.com/onsi/gomega"
-	"github.com/onsi/gomega/format"
-
+	"github.com/expectto/be"
 	"github.com/expectto/be/be_reflected"
+	"github.com/expectto/be/internal/beformat"
 	"github.com/expectto/be/types"
 )
 
 // TODO: unify tests. Let's make all tests like in `be_math`
 
-var _ = Describe("MatchersReflected", func() {
-	Context("AsKind", func() {
-		DescribeTable("should match kind", func(actual any, expected reflect.Kind) {
-			Expect(actual).To(be_reflected.AsKind(expected), reflect.TypeOf(actual).Kind().String())
-		},
-			Entry("int", 1, reflect.Int),
-			Entry("int8", int8(1), reflect.Int8),
-			Entry("int16", int16(1), reflect.Int16),
-			Entry("int32", int32(1), reflect.Int32),
-			Entry("int64", int64(1), reflect.Int64),
-			Entry("uint", uint(1), reflect.Uint),
-			Entry("uint8", uint8(1), reflect.Uint8),
-			Entry("uint16", uint16(1), reflect.Uint16),
-			Entry("uint32", uint32(1), reflect.Uint32),
-			Entry("uint64", uint64(1), reflect.Uint64),
-			Entry("float32", float32(1), reflect.Float32),
-			Entry("float64", float64(1), reflect.Float64),
-			Entry("complex64", complex64(1), reflect.Complex64),
-			Entry("complex128", complex128(1), reflect.Complex128),
-			Entry("string", "1", reflect.String),
-			Entry("bool", true, reflect.Bool),
-			Entry("chan", make(chan int), reflect.Chan),
-			Entry("func", func() {}, reflect.Func),
-			Entry("map", map[string]int{}, reflect.Map),
-			Entry("ptr", new(int), reflect.Pointer),
-			Entry("slice", []int{}, reflect.Slice),
-			Entry("struct", struct{}{}, reflect.Struct),
+func TestMatchersReflected(t *testing.T) {
+	t.Run("AsKind", func(t *testing.T) {
+		t.Run("should match kind", func(t *testing.T) {
+			for _, tc := range []struct {
+				name     string
+				actual   any
+				expected reflect.Kind
+			}{
+				{"int", 1, reflect.Int},
+				{"int8", int8(1), reflect.Int8},
+				{"int16", int16(1), reflect.Int16},
+				{"int32", int32(1), reflect.Int32},
+				{"int64", int64(1), reflect.Int64},
+				{"uint", uint(1), reflect.Uint},
+				{"uint8", uint8(1), reflect.Uint8},
+				{"uint16", uint16(1), reflect.Uint16},
+				{"uint32", uint32(1), reflect.Uint32},
+				{"uint64", uint64(1), reflect.Uint64},
+				{"float32", float32(1), reflect.Float32},
+				{"float64", float64(1), reflect.Float64},
+				{"complex64", complex64(1), reflect.Complex64},
+				{"complex128", complex128(1), reflect.Complex128},
+				{"string", "1", reflect.String},
+				{"bool", true, reflect.Bool},
+				{"chan", make(chan int), reflect.Chan},
+				{"func", func() {}, reflect.Func},
+				{"map", map[string]int{}, reflect.Map},
+				{"ptr", new(int), reflect.Pointer},
+				{"slice", []int{}, reflect.Slice},
+				{"struct", struct{}{}, reflect.Struct},
 
-			// todo add test for reflect.Interface
-		)
+				// todo add test for reflect.Interface,
+			} {
+				t.Run(tc.name, func(t *testing.T) {
+					be.Expect(t, tc.actual).To(be_reflected.AsKind(tc.expected), reflect.TypeOf(tc.actual).Kind().String())
+				})
+			}
+		})
 
-		DescribeTable("should properly fail on matching", func(actual any, expected reflect.Kind) {
-			matcher := be_reflected.AsKind(expected)
+		t.Run("should properly fail on matching", func(t *testing.T) {
+			for _, tc := range []struct {
+				name     string
+				actual   any
+				expected reflect.Kind
+			}{
+				{"int", 1, reflect.Uint},
+				{"int8", int8(1), reflect.Uint8},
+				{"int16", int16(1), reflect.Uint16},
 
-			success, err := matcher.Match(actual)
-			Expect(err).To(Succeed())
-			Expect(success).To(BeFalse())
+				{"int32", int32(1), reflect.Uint32},
+				{"int64", int64(1), reflect.Uint64},
+				{"uint", uint(1), reflect.Int},
+				{"uint8", uint8(1), reflect.Int8},
+				{"uint16", uint16(1), reflect.Int16},
+				{"uint32", uint32(1), reflect.Int32},
+				{"uint64", uint64(1), reflect.Int64},
+				{"float32", float32(1), reflect.Float64},
 
-			message := matcher.FailureMessage(actual)
-			Expect(
-				message,
-			).To(Equal(fmt.Sprintf("Expected\n%s\nto be kind of %s", format.Object(actual, 1), expected.String())))
-		},
-			Entry("int", 1, reflect.Uint),
-			Entry("int8", int8(1), reflect.Uint8),
-			Entry("int16", int16(1), reflect.Uint16),
+				{"float64", float64(1), reflect.Float32},
+				{"complex64", complex64(1), reflect.Complex128},
+				{"complex128", complex128(1), reflect.Complex64},
+				{"string as not bool", "1", reflect.Bool},
+				{"bool as not string", true, reflect.String},
+				{"func", func() {}, reflect.Chan},
+				{"chan as not func", make(chan int), reflect.Func},
+				{"map", map[string]int{}, reflect.Pointer},
+				{"ptr", new(int), reflect.Map},
+				// <*int | 0xc...>: de-referenced value,
+			} {
+				t.Run(tc.name, func(t *testing.T) {
+					matcher := be_reflected.AsKind(tc.expected)
 
-			Entry("int32", int32(1), reflect.Uint32),
-			Entry("int64", int64(1), reflect.Uint64),
-			Entry("uint", uint(1), reflect.Int),
-			Entry("uint8", uint8(1), reflect.Int8),
-			Entry("uint16", uint16(1), reflect.Int16),
-			Entry("uint32", uint32(1), reflect.Int32),
-			Entry("uint64", uint64(1), reflect.Int64),
-			Entry("float32", float32(1), reflect.Float64),
+					success, err := matcher.Match(tc.actual)
+					be.Expect(t, err).To(be.Succeed())
+					be.Expect(t, success).To(be.False())
 
-			Entry("float64", float64(1), reflect.Float32),
-			Entry("complex64", complex64(1), reflect.Complex128),
-			Entry("complex128", complex128(1), reflect.Complex64),
-			Entry("string as not bool", "1", reflect.Bool),
-			Entry("bool as not string", true, reflect.String),
-			Entry("func", func() {}, reflect.Chan),
-			Entry("chan as not func", make(chan int), reflect.Func),
-			Entry("map", map[string]int{}, reflect.Pointer),
-			Entry("ptr", new(int), reflect.Map), // <*int | 0xc...>: de-referencad value
-		)
+					message := matcher.FailureMessage(tc.actual)
+					be.Expect(t,
+						message,
+					).To(be.Eq(fmt.Sprintf("Expected\n%s\nto be kind of %s", beformat.Object(tc.actual, 1), tc.expected.String())))
+				})
+			}
+		})
 	})
 
-	Context("AssignableTo", func() {
+	t.Run("AssignableTo", func(t *testing.T) {
 		// Named booleans so table is more readable
 		const WillPass = true
 		const WontPass = false
 
 		type CustomString string
 
-		DescribeTable(
-			"should be assignable to a simple type",
-			func(m types.BeMatcher, actual any, result bool) {
-				matched, err := m.Match(actual)
-				Expect(err).To(Succeed())
-				Expect(matched).To(Equal(result))
-			},
-			Entry("int", be_reflected.AssignableTo[int](), 5, WillPass),
-			Entry("uint", be_reflected.AssignableTo[uint8](), uint8(2), WillPass),
-			Entry("int vs uint", be_reflected.AssignableTo[uint8](), 2, WontPass),
-			Entry("float64", be_reflected.AssignableTo[float64](), 100.0, WillPass),
-			Entry("string", be_reflected.AssignableTo[string](), "hello world", WillPass),
-			Entry("CustomString", be_reflected.AssignableTo[CustomString](), CustomString("hello world"), WillPass),
-			Entry(
-				"CustomString as string: no",
-				be_reflected.AssignableTo[string](),
-				CustomString("hello world"),
-				WontPass,
-			),
-			Entry("string as CustomString: no", be_reflected.AssignableTo[CustomString](), "hello world", WontPass),
-		)
+		t.Run("should be assignable to a simple type", func(t *testing.T) {
+			for _, tc := range []struct {
+				name   string
+				m      types.BeMatcher
+				actual any
+				result bool
+			}{
+				{"int", be_reflected.AssignableTo[int](), 5, WillPass},
+				{"uint", be_reflected.AssignableTo[uint8](), uint8(2), WillPass},
+				{"int vs uint", be_reflected.AssignableTo[uint8](), 2, WontPass},
+				{"float64", be_reflected.AssignableTo[float64](), 100.0, WillPass},
+				{"string", be_reflected.AssignableTo[string](), "hello world", WillPass},
+				{"CustomString", be_reflected.AssignableTo[CustomString](), CustomString("hello world"), WillPass},
+				{"CustomString as string: no",
+					be_reflected.AssignableTo[string](),
+					CustomString("hello world"),
+					WontPass},
+				{"string as CustomString: no", be_reflected.AssignableTo[CustomString](), "hello world", WontPass},
+			} {
+				t.Run(tc.name, func(t *testing.T) {
+					matched, err := tc.m.Match(tc.actual)
+					be.Expect(t, err).To(be.Succeed())
+					be.Expect(t, matched).To(be.Eq(tc.result))
+				})
+			}
+		})
 	})
-})
+}
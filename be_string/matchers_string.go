@@ -10,7 +10,6 @@ import (
 
 	"github.com/IGLOU-EU/go-wildcard" // used specifically for MatchWildcard matcher
 	"github.com/amberpixels/k1/cast"
-	"github.com/onsi/gomega"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 
@@ -98,13 +97,13 @@ func Only(option StringOption) types.BeMatcher {
 // EmptyString succeeds if actual is an empty string.
 // Actual must be a string-like value (can be adjusted via SetStringFormat method).
 func EmptyString() types.BeMatcher {
-	return psiString(gomega.BeEmpty(), "be an empty string")
+	return psiString(psi_matchers.NewEmptyMatcher(), "be an empty string")
 }
 
 // NonEmptyString succeeds if actual is not an empty string.
 // Actual must be a string-like value (can be adjusted via SetStringFormat method).
 func NonEmptyString() types.BeMatcher {
-	return psiString(gomega.Not(gomega.BeEmpty()), "be a non-empty string")
+	return psiString(psi_matchers.NewNotMatcher(psi_matchers.NewEmptyMatcher()), "be a non-empty string")
 }
 
 // Float succeeds if actual is a string representing a valid floating-point number.
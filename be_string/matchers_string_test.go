@@ -2,366 +2,327 @@ package be_string_test
 
 import (
 	"math/rand"
+	"testing"
 
-	. "github.com/onsi/ginkgo/v2"
-	. "github.com/onsi/gomega"
-
+	"github.com/expectto/be"
 	"github.com/expectto/be/be_string"
 	. "github.com/expectto/be/options"
 	"github.com/expectto/be/types"
 )
 
-var _ = Describe("BeStrings (simple matchers)", func() {
-	DescribeTable(
-		"should positively match",
-		func(matcher types.BeMatcher, actual any) {
-			// check gomega-compatible matching:
-			success, err := matcher.Match(actual)
-			Expect(err).Should(Succeed())
-			Expect(success).To(BeTrue())
+func TestBeStringsSimpleMatchers(t *testing.T) {
+	t.Run("should positively match", func(t *testing.T) {
+		for _, tc := range []struct {
+			name    string
+			matcher types.BeMatcher
+			actual  any
+		}{
+			{"NonEmptyString", be_string.NonEmptyString(), "Hello"},
+			{"NonEmptyString: one character", be_string.NonEmptyString(), "a"},
+			{"NonEmptyString: just space", be_string.NonEmptyString(), " "},
+			{"EmptyString", be_string.EmptyString(), ""},
+			{"Only(Alpha) lowercase only", be_string.Only(Alpha), "abcdefg"},
+			{"Only(Alpha) uppercase only", be_string.Only(Alpha), "ABCDEFG"},
+			{"Only(Alpha) mixed case", be_string.Only(Alpha), "AbCdEfG"},
+			{"Only(Alpha|Whitespace) only letters", be_string.Only(Alpha | Whitespace), "HelloWorld"},
+			{"Only(Alpha|Whitespace) only spaces", be_string.Only(Alpha | Whitespace), "    "},
+			{"Only(Alpha|Whitespace) mixed letters and spaces", be_string.Only(Alpha | Whitespace), "Hello World"},
+			{"Only(Alpha|Whitespace) letters with leading/trailing spaces",
+				be_string.Only(Alpha | Whitespace),
+				"  Hello World  "},
+			{"Only(Alpha|Punctuation): only letters", be_string.Only(Alpha | Punctuation), "HelloWorld"},
+			{"Only(Alpha|Punctuation): only punctuation", be_string.Only(Alpha | Punctuation), "!().,"},
+			{"Only(Alpha|Punctuation): mixed letters and punctuation",
+				be_string.Only(Alpha | Punctuation),
+				"HelloWorld!"},
+			{"Only(Alpha|Whitespace|Punctuation) only letters",
+				be_string.Only(Alpha | Whitespace | Punctuation),
+				"HelloWorld"},
+			{"Only(Alpha|Whitespace|Punctuation) only whitespace", be_string.Only(Alpha | Whitespace | Punctuation), "  "},
+			{"Only(Alpha|Whitespace|Punctuation) only punctuation",
+				be_string.Only(Alpha | Whitespace | Punctuation),
+				"!().,"},
+			{"Only(Alpha|Whitespace|Punctuation) mixed letters, whitespace, and punctuation",
+				be_string.Only(Alpha | Whitespace | Punctuation),
+				"Hello, World! How are you?"},
+			{"Only(Whitespace): only whitespace", be_string.Only(Whitespace), "  "},
+			{"Only(Whitespace): space with tab with newline", be_string.Only(Whitespace), "\n\t "},
+			{"Only(Numeric)", be_string.Only(Numeric), "12345"},
+			{"Only(Numeric): one digit", be_string.Only(Numeric), "1"},
+			{"Only(Numeric): big digits", be_string.Only(Numeric), "9999999999999"},
+			{"Only(Numeric|Whitespace): only numbers", be_string.Only(Numeric | Whitespace), "12345"},
+			{"Only(Numeric|Whitespace): numbers with whitespace", be_string.Only(Numeric | Whitespace), "123 45"},
+			{"Only(Numeric|Whitespace): numbers with leading and trailing whitespace",
+				be_string.Only(Numeric | Whitespace),
+				"  12345  "},
+			{"Only(Alpha|Numeric): upper case", be_string.Only(Alpha | Numeric), "ABC123"},
+			{"Only(Alpha|Numeric): lower case", be_string.Only(Alpha | Numeric), "abc123"},
+			{"Only(Alpha|Numeric): mixed case", be_string.Only(Alpha | Numeric), "ABCxyz987"},
+			{"Only(Alpha|Numeric): only nums", be_string.Only(Alpha | Numeric), "123456789"},
+			{"Only(Alpha|Numeric): only alpha", be_string.Only(Alpha | Numeric), "abcdef"},
+			{"Only(Alpha|Numeric|Whitespace): alphanumeric", be_string.Only(Alpha | Numeric | Whitespace), "abc123"},
+			{"Only(Alpha|Numeric|Whitespace): alphanumeric with whitespace",
+				be_string.Only(Alpha | Numeric | Whitespace),
+				"abc123 xyz"},
+			{"Only(Alpha|Numeric|Whitespace): alphanumeric with leading and trailing whitespace",
+				be_string.Only(Alpha | Numeric | Whitespace),
+				"  abc123 xyz  "},
+			{"Only(Alpha|Numeric|Punctuation): alphanumeric with punctuation",
+				be_string.Only(Alpha | Numeric | Punctuation),
+				"abc123,xyz"},
+			{"Only(Alpha|Numeric|Punctuation): alphanumeric with leading and trailing punctuation",
+				be_string.Only(Alpha | Numeric | Punctuation),
+				"(abc123.xyz)"},
+			{"Only(Alpha|Numeric|Whitespace|Punctuation): alphanumeric with whitespace and punctuation",
+				be_string.Only(Alpha | Numeric | Whitespace | Punctuation),
+				"abc 123,xyz"},
+			{"Only(Alpha|Numeric|Whitespace|Punctuation): alphanumeric with leading and trailing punctuation and whitespace",
+				be_string.Only(Alpha | Numeric | Whitespace | Punctuation),
+				"(abc 123.xyz)"},
+			{"Only(Alpha|Numeric|Whitespace|Punctuation): alphanumeric with punctuation and whitespace",
+				be_string.Only(Alpha | Numeric | Whitespace | Punctuation),
+				"abc 123, xyz"},
+			{"Only(Alpha|Numeric|Dots)", be_string.Only(Alpha | Numeric | Dots), "Abc123.5"},
+			{"Only(Alpha|Numeric|Dots): only nums+dots", be_string.Only(Alpha | Numeric | Dots), "3.141592653589793"},
+			{"Only(Alpha|Numeric|Dots): multiple dots", be_string.Only(Alpha | Numeric | Dots), "a.b.c.1.2.3"},
+			{"Only(Alpha|Numeric|Dots): only dot", be_string.Only(Alpha | Numeric | Dots), "."},
+			{"Only(Alpha|Numeric|Dots): only dots", be_string.Only(Alpha | Numeric | Dots), "..."},
+			{"Float", be_string.Float(), "3.14"},
+			{"Float: negative", be_string.Float(), "-3.14"},
+			{"Float: integral", be_string.Float(), "5.00"},
+			{"Float: integral (without dot)", be_string.Float(), "5"},
+			{"Titled", be_string.Titled(), "This Is Titled"},
+			{"Titled:one word", be_string.Titled(), "Yo"},
+			{"LowerCaseOnly", be_string.LowerCaseOnly(), "this is lowercase"},
+			{"LowerCaseOnly: one character", be_string.LowerCaseOnly(), "x"},
+			{"LowerCaseOnly: not trimmed", be_string.LowerCaseOnly(), "   hello    "},
+			{"UpperCaseOnly", be_string.UpperCaseOnly(), "THIS IS CAPS"},
+			{"UpperCaseOnly: one character", be_string.UpperCaseOnly(), "X"},
+			{"UpperCaseOnly: not trimmed", be_string.UpperCaseOnly(), "   HELLO    "},
+			{"ContainingSubstring: contains 'abc'",
+				be_string.ContainingSubstring("lazy"),
+				"The quick brown fox jumps over the lazy"},
+			{"ContainingSubstring: contains '123'", be_string.ContainingSubstring("123"), "The password is 123456"},
+			{"ContainingSubstring: contains 'xyz'",
+				be_string.ContainingSubstring("xyz"),
+				"xyz is the last three characters"},
+			{"HavingPrefix", be_string.HavingPrefix("Hello"), "Hello World"},
+			{"HavingPrefix: full match", be_string.HavingPrefix("Hello"), "Hello"},
+			{"HavingPrefix: empty prefix", be_string.HavingPrefix(""), "anything"},
+			{"HavingSuffix", be_string.HavingSuffix("World"), "Hello World"},
+			{"HavingSuffix: full match", be_string.HavingSuffix("World"), "World"},
+			{"HavingSuffix: empty suffix", be_string.HavingSuffix(""), "anything"},
+			{"ContainingOnlyCharacters: contains only 'abc'", be_string.ContainingOnlyCharacters("abc"), "aaaaab"},
+			{"ContainingOnlyCharacters: contains only '123'", be_string.ContainingOnlyCharacters("123"), "123"},
+			{"ContainingOnlyCharacters: contains only 'xyz'",
+				be_string.ContainingOnlyCharacters("xyz"),
+				"xyzxyzxyzxyz"},
+			{"ContainingCharacters: contains 'abc'", be_string.ContainingCharacters("abc"), "abc"},
+			{"ContainingCharacters: contains 'abc123'", be_string.ContainingCharacters("abc123"), "1a2b3c"},
+			{"ContainingCharacters: contains '123'",
+				be_string.ContainingCharacters("123"),
+				"foo111112222233331112223bar"},
+			{"ContainingCharacters: empty chars list", be_string.ContainingCharacters(""), "anything"},
+			{"ContainingCharacters: empty given & empty actual", be_string.ContainingCharacters(""), ""},
+			{"MatchWildcard: prefix one char", be_string.MatchWildcard("*ello"), "Hello"},
+			{"MatchWildcard: prefix longer", be_string.MatchWildcard("*orld"), "Hello World"},
+			{"MatchWildcard: suffix one char", be_string.MatchWildcard("Hell*"), "Hello"},
+			{"MatchWildcard: suffix longer", be_string.MatchWildcard("Hello W*"), "Hello World"},
+			{"MatchWildcard: in the middle", be_string.MatchWildcard("H*d"), "Hello World"},
+			{"MatchWildcard: all-star", be_string.MatchWildcard("*"), "Hello World"},
+			{"MatchWildcard: all-star for nothing", be_string.MatchWildcard("*"), ""},
+			{"ValidEmail", be_string.ValidEmail(), "test@example.com"},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				// check gomega-compatible matching:
+				success, err := tc.matcher.Match(tc.actual)
+				be.Expect(t, err).To(be.Succeed())
+				be.Expect(t, success).To(be.True())
 
-			// check gomock-compatible matching:
-			success = matcher.Matches(actual)
-			Expect(success).To(BeTrue())
-		},
-		Entry("NonEmptyString", be_string.NonEmptyString(), "Hello"),
-		Entry("NonEmptyString: one character", be_string.NonEmptyString(), "a"),
-		Entry("NonEmptyString: just space", be_string.NonEmptyString(), " "),
-		Entry("EmptyString", be_string.EmptyString(), ""),
-		Entry("Only(Alpha) lowercase only", be_string.Only(Alpha), "abcdefg"),
-		Entry("Only(Alpha) uppercase only", be_string.Only(Alpha), "ABCDEFG"),
-		Entry("Only(Alpha) mixed case", be_string.Only(Alpha), "AbCdEfG"),
-		Entry("Only(Alpha|Whitespace) only letters", be_string.Only(Alpha|Whitespace), "HelloWorld"),
-		Entry("Only(Alpha|Whitespace) only spaces", be_string.Only(Alpha|Whitespace), "    "),
-		Entry("Only(Alpha|Whitespace) mixed letters and spaces", be_string.Only(Alpha|Whitespace), "Hello World"),
-		Entry(
-			"Only(Alpha|Whitespace) letters with leading/trailing spaces",
-			be_string.Only(Alpha|Whitespace),
-			"  Hello World  ",
-		),
-		Entry("Only(Alpha|Punctuation): only letters", be_string.Only(Alpha|Punctuation), "HelloWorld"),
-		Entry("Only(Alpha|Punctuation): only punctuation", be_string.Only(Alpha|Punctuation), "!().,"),
-		Entry(
-			"Only(Alpha|Punctuation): mixed letters and punctuation",
-			be_string.Only(Alpha|Punctuation),
-			"HelloWorld!",
-		),
-		Entry(
-			"Only(Alpha|Whitespace|Punctuation) only letters",
-			be_string.Only(Alpha|Whitespace|Punctuation),
-			"HelloWorld",
-		),
-		Entry("Only(Alpha|Whitespace|Punctuation) only whitespace", be_string.Only(Alpha|Whitespace|Punctuation), "  "),
-		Entry(
-			"Only(Alpha|Whitespace|Punctuation) only punctuation",
-			be_string.Only(Alpha|Whitespace|Punctuation),
-			"!().,",
-		),
-		Entry(
-			"Only(Alpha|Whitespace|Punctuation) mixed letters, whitespace, and punctuation",
-			be_string.Only(Alpha|Whitespace|Punctuation),
-			"Hello, World! How are you?",
-		),
-		Entry("Only(Whitespace): only whitespace", be_string.Only(Whitespace), "  "),
-		Entry("Only(Whitespace): space with tab with newline", be_string.Only(Whitespace), "\n\t "),
-		Entry("Only(Numeric)", be_string.Only(Numeric), "12345"),
-		Entry("Only(Numeric): one digit", be_string.Only(Numeric), "1"),
-		Entry("Only(Numeric): big digits", be_string.Only(Numeric), "9999999999999"),
-		Entry("Only(Numeric|Whitespace): only numbers", be_string.Only(Numeric|Whitespace), "12345"),
-		Entry("Only(Numeric|Whitespace): numbers with whitespace", be_string.Only(Numeric|Whitespace), "123 45"),
-		Entry(
-			"Only(Numeric|Whitespace): numbers with leading and trailing whitespace",
-			be_string.Only(Numeric|Whitespace),
-			"  12345  ",
-		),
-		Entry("Only(Alpha|Numeric): upper case", be_string.Only(Alpha|Numeric), "ABC123"),
-		Entry("Only(Alpha|Numeric): lower case", be_string.Only(Alpha|Numeric), "abc123"),
-		Entry("Only(Alpha|Numeric): mixed case", be_string.Only(Alpha|Numeric), "ABCxyz987"),
-		Entry("Only(Alpha|Numeric): only nums", be_string.Only(Alpha|Numeric), "123456789"),
-		Entry("Only(Alpha|Numeric): only alpha", be_string.Only(Alpha|Numeric), "abcdef"),
-		Entry("Only(Alpha|Numeric|Whitespace): alphanumeric", be_string.Only(Alpha|Numeric|Whitespace), "abc123"),
-		Entry(
-			"Only(Alpha|Numeric|Whitespace): alphanumeric with whitespace",
-			be_string.Only(Alpha|Numeric|Whitespace),
-			"abc123 xyz",
-		),
-		Entry(
-			"Only(Alpha|Numeric|Whitespace): alphanumeric with leading and trailing whitespace",
-			be_string.Only(Alpha|Numeric|Whitespace),
-			"  abc123 xyz  ",
-		),
-		Entry(
-			"Only(Alpha|Numeric|Punctuation): alphanumeric with punctuation",
-			be_string.Only(Alpha|Numeric|Punctuation),
-			"abc123,xyz",
-		),
-		Entry(
-			"Only(Alpha|Numeric|Punctuation): alphanumeric with leading and trailing punctuation",
-			be_string.Only(Alpha|Numeric|Punctuation),
-			"(abc123.xyz)",
-		),
-		Entry(
-			"Only(Alpha|Numeric|Whitespace|Punctuation): alphanumeric with whitespace and punctuation",
-			be_string.Only(Alpha|Numeric|Whitespace|Punctuation),
-			"abc 123,xyz",
-		),
-		Entry(
-			"Only(Alpha|Numeric|Whitespace|Punctuation): alphanumeric with leading and trailing punctuation and whitespace",
-			be_string.Only(Alpha|Numeric|Whitespace|Punctuation),
-			"(abc 123.xyz)",
-		),
-		Entry(
-			"Only(Alpha|Numeric|Whitespace|Punctuation): alphanumeric with punctuation and whitespace",
-			be_string.Only(Alpha|Numeric|Whitespace|Punctuation),
-			"abc 123, xyz",
-		),
-		Entry("Only(Alpha|Numeric|Dots)", be_string.Only(Alpha|Numeric|Dots), "Abc123.5"),
-		Entry("Only(Alpha|Numeric|Dots): only nums+dots", be_string.Only(Alpha|Numeric|Dots), "3.141592653589793"),
-		Entry("Only(Alpha|Numeric|Dots): multiple dots", be_string.Only(Alpha|Numeric|Dots), "a.b.c.1.2.3"),
-		Entry("Only(Alpha|Numeric|Dots): only dot", be_string.Only(Alpha|Numeric|Dots), "."),
-		Entry("Only(Alpha|Numeric|Dots): only dots", be_string.Only(Alpha|Numeric|Dots), "..."),
-		Entry("Float", be_string.Float(), "3.14"),
-		Entry("Float: negative", be_string.Float(), "-3.14"),
-		Entry("Float: integral", be_string.Float(), "5.00"),
-		Entry("Float: integral (without dot)", be_string.Float(), "5"),
-		Entry("Titled", be_string.Titled(), "This Is Titled"),
-		Entry("Titled:one word", be_string.Titled(), "Yo"),
-		Entry("LowerCaseOnly", be_string.LowerCaseOnly(), "this is lowercase"),
-		Entry("LowerCaseOnly: one character", be_string.LowerCaseOnly(), "x"),
-		Entry("LowerCaseOnly: not trimmed", be_string.LowerCaseOnly(), "   hello    "),
-		Entry("UpperCaseOnly", be_string.UpperCaseOnly(), "THIS IS CAPS"),
-		Entry("UpperCaseOnly: one character", be_string.UpperCaseOnly(), "X"),
-		Entry("UpperCaseOnly: not trimmed", be_string.UpperCaseOnly(), "   HELLO    "),
-		Entry(
-			"ContainingSubstring: contains 'abc'",
-			be_string.ContainingSubstring("lazy"),
-			"The quick brown fox jumps over the lazy",
-		),
-		Entry("ContainingSubstring: contains '123'", be_string.ContainingSubstring("123"), "The password is 123456"),
-		Entry(
-			"ContainingSubstring: contains 'xyz'",
-			be_string.ContainingSubstring("xyz"),
-			"xyz is the last three characters",
-		),
-		Entry("HavingPrefix", be_string.HavingPrefix("Hello"), "Hello World"),
-		Entry("HavingPrefix: full match", be_string.HavingPrefix("Hello"), "Hello"),
-		Entry("HavingPrefix: empty prefix", be_string.HavingPrefix(""), "anything"),
-		Entry("HavingSuffix", be_string.HavingSuffix("World"), "Hello World"),
-		Entry("HavingSuffix: full match", be_string.HavingSuffix("World"), "World"),
-		Entry("HavingSuffix: empty suffix", be_string.HavingSuffix(""), "anything"),
-		Entry("ContainingOnlyCharacters: contains only 'abc'", be_string.ContainingOnlyCharacters("abc"), "aaaaab"),
-		Entry("ContainingOnlyCharacters: contains only '123'", be_string.ContainingOnlyCharacters("123"), "123"),
-		Entry(
-			"ContainingOnlyCharacters: contains only 'xyz'",
-			be_string.ContainingOnlyCharacters("xyz"),
-			"xyzxyzxyzxyz",
-		),
-		Entry("ContainingCharacters: contains 'abc'", be_string.ContainingCharacters("abc"), "abc"),
-		Entry("ContainingCharacters: contains 'abc123'", be_string.ContainingCharacters("abc123"), "1a2b3c"),
-		Entry(
-			"ContainingCharacters: contains '123'",
-			be_string.ContainingCharacters("123"),
-			"foo111112222233331112223bar",
-		),
-		Entry("ContainingCharacters: empty chars list", be_string.ContainingCharacters(""), "anything"),
-		Entry("ContainingCharacters: empty given & empty actual", be_string.ContainingCharacters(""), ""),
-		Entry("MatchWildcard: prefix one char", be_string.MatchWildcard("*ello"), "Hello"),
-		Entry("MatchWildcard: prefix longer", be_string.MatchWildcard("*orld"), "Hello World"),
-		Entry("MatchWildcard: suffix one char", be_string.MatchWildcard("Hell*"), "Hello"),
-		Entry("MatchWildcard: suffix longer", be_string.MatchWildcard("Hello W*"), "Hello World"),
-		Entry("MatchWildcard: in the middle", be_string.MatchWildcard("H*d"), "Hello World"),
-		Entry("MatchWildcard: all-star", be_string.MatchWildcard("*"), "Hello World"),
-		Entry("MatchWildcard: all-star for nothing", be_string.MatchWildcard("*"), ""),
-		Entry("ValidEmail", be_string.ValidEmail(), "test@example.com"),
-	)
+				// check gomock-compatible matching:
+				success = tc.matcher.Matches(tc.actual)
+				be.Expect(t, success).To(be.True())
+			})
+		}
+	})
 
-	DescribeTable(
-		"should negatively match",
-		func(matcher types.BeMatcher, actual any) {
-			// Check gomega-compatible matching:
-			success, err := matcher.Match(actual)
-			Expect(err).Should(Succeed())
-			Expect(success).To(BeFalse())
+	t.Run("should negatively match", func(t *testing.T) {
+		for _, tc := range []struct {
+			name    string
+			matcher types.BeMatcher
+			actual  any
+		}{
+			{"NonEmptyString: empty string", be_string.NonEmptyString(), ""},
+			{"EmptyString: non-empty string", be_string.EmptyString(), "Hello"},
+			{"Only(Alpha): alphanumeric string", be_string.Only(Alpha), "abc123"},
+			{"Only(Alpha): string with space", be_string.Only(Alpha), "Hello World"},
+			{"Only(Alpha): string with special characters", be_string.Only(Alpha), "Hello@World"},
+			{"Only(Alpha): empty string", be_string.Only(Alpha), ""},
+			{"Only(Alpha|Whitespace): contains numbers", be_string.Only(Alpha | Whitespace), "abc123"},
+			{"Only(Alpha|Whitespace): contains punctuation", be_string.Only(Alpha | Whitespace), "abc!"},
+			{"Only(Alpha|Whitespace): contains both numbers and punctuation",
+				be_string.Only(Alpha | Whitespace),
+				"abc 123!"},
+			{"Only(Alpha|Whitespace): empty string", be_string.Only(Alpha | Whitespace), ""},
+			{"Only(Alpha|Punctuation): contains whitespace", be_string.Only(Alpha | Punctuation), "abc def"},
+			{"Only(Alpha|Punctuation): contains numbers", be_string.Only(Alpha | Punctuation), "abc123"},
+			{"Only(Alpha|Punctuation): empty string", be_string.Only(Alpha | Punctuation), ""},
+			{"Only(Alpha|Whitespace|Punctuation) contains numbers",
+				be_string.Only(Alpha | Whitespace | Punctuation),
+				"abc123"},
+			{"Only(Alpha|Whitespace|Punctuation) empty string", be_string.Only(Alpha | Whitespace | Punctuation), ""},
+			{"Only(Whitespace): contains letters", be_string.Only(Whitespace), "abc"},
+			{"Only(Whitespace): contains numbers", be_string.Only(Whitespace), "123"},
+			{"Only(Whitespace): empty string", be_string.Only(Whitespace), ""},
+			{"Only(Numeric|Whitespace): contains letters", be_string.Only(Numeric | Whitespace), "abc"},
+			{"Only(Numeric|Whitespace): contains punctuation", be_string.Only(Numeric | Whitespace), "1,2,3"},
+			{"Only(Numeric|Whitespace): contains letters and punctuation",
+				be_string.Only(Numeric | Whitespace),
+				"abc 123!"},
+			{"Only(Numeric|Whitespace): empty string", be_string.Only(Numeric | Whitespace), ""},
+			{"Only(Alpha|Numeric|Whitespace): contains punctuation",
+				be_string.Only(Alpha | Numeric | Whitespace),
+				"abc123!"},
+			{"Only(Alpha|Numeric|Whitespace): contains special characters",
+				be_string.Only(Alpha | Numeric | Whitespace),
+				"abc 123@"},
+			{"Only(Alpha|Numeric|Whitespace): contains letters, numbers, and punctuation",
+				be_string.Only(Alpha | Numeric | Whitespace),
+				"abc 123!"},
+			{"Only(Alpha|Numeric|Whitespace): empty string", be_string.Only(Alpha | Numeric | Whitespace), ""},
+			{"Only(Alpha|Numeric|Punctuation): contains whitespace",
+				be_string.Only(Alpha | Numeric | Punctuation),
+				"abc 123"},
+			{"Only(Alpha|Numeric|Punctuation): contains whitespace and special characters",
+				be_string.Only(Alpha | Numeric | Punctuation),
+				"abc 123@"},
+			{"Only(Alpha|Numeric|Whitespace|Punctuation): contains special characters",
+				be_string.Only(Alpha | Numeric | Whitespace | Punctuation),
+				"abc$% 123@"},
+			{"Only(Alpha|Numeric|Whitespace|Punctuation): empty string",
+				be_string.Only(Alpha | Numeric | Whitespace | Punctuation),
+				""},
+			{"Only(Numeric): alphanumeric string", be_string.Only(Numeric), "abc123"},
+			{"Only(Numeric): string with space", be_string.Only(Numeric), "123 456"},
+			{"Only(Numeric): string with special characters", be_string.Only(Numeric), "123@456"},
+			{"Only(Numeric): empty string", be_string.Only(Numeric), ""},
+			{"Only(Alpha|Numeric): string with space", be_string.Only(Alpha | Numeric), "abc 123"},
+			{"Only(Alpha|Numeric): string with special characters", be_string.Only(Alpha | Numeric), "abc@123"},
+			{"Only(Alpha|Numeric): empty string", be_string.Only(Alpha | Numeric), ""},
+			{"Only(Alpha|Numeric|Dots): string with space", be_string.Only(Alpha | Numeric | Dots), "abc 123"},
+			{"Only(Alpha|Numeric|Dots): string with special characters",
+				be_string.Only(Alpha | Numeric | Dots),
+				"abc@123"},
+			{"Only(Alpha|Numeric|Dots): empty string", be_string.Only(Alpha | Numeric | Dots), ""},
+			{"Float: string with non-numeric characters", be_string.Float(), "3.14abc"},
+			{"Float: string with space", be_string.Float(), "3.14 5"},
+			{"Float: string with special characters", be_string.Float(), "3.14@5"},
+			{"Float: empty string", be_string.Float(), ""},
+			{"Titled: non-titled string", be_string.Titled(), "hello world"},
+			{"LowerCaseOnly: string with upper case", be_string.LowerCaseOnly(), "HelloWorld"},
+			{"ContainingSubstring: does not contain substring", be_string.ContainingSubstring("xyz"), "abc123"},
+			{"ContainingSubstring: empty string", be_string.ContainingSubstring("xyz"), ""},
+			{"HavingPrefix: not a prefix", be_string.HavingPrefix("World"), "Hello World"},
+			{"HavingPrefix: empty string", be_string.HavingPrefix("Hello"), ""},
+			{"HavingSuffix: not a suffix", be_string.HavingSuffix("Hello"), "Hello World"},
+			{"HavingSuffix: empty string", be_string.HavingSuffix("Hello"), ""},
+			{"ContainingOnlyCharacters: contains other characters",
+				be_string.ContainingOnlyCharacters("abc"),
+				"defabc123"},
+			{"ContainingOnlyCharacters: empty string", be_string.ContainingOnlyCharacters("abc"), ""},
+			{"ContainingOnlyCharacters: contains whitespace", be_string.ContainingOnlyCharacters("abc"), "a b c"},
+			{"ContainingCharacters: missing required characters", be_string.ContainingCharacters("abc"), "def123"},
+			{"ContainingCharacters: empty string", be_string.ContainingCharacters("abc"), ""},
+			{"ContainingCharacters: contains extra characters", be_string.ContainingCharacters("abc"), "def123"},
+			{"MatchWildcard: no match", be_string.MatchWildcard("abc*"), "xyz123"},
+			{"ValidEmail: invalid email", be_string.ValidEmail(), "test@example@com"},
+			{"ValidEmail: just letters", be_string.ValidEmail(), "testexample"},
+			{"ValidEmail: numeric string", be_string.ValidEmail(), "1000"},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				// Check gomega-compatible matching:
+				success, err := tc.matcher.Match(tc.actual)
+				be.Expect(t, err).To(be.Succeed())
+				be.Expect(t, success).To(be.False())
 
-			// Check gomock-compatible matching:
-			success = matcher.Matches(actual)
-			Expect(success).To(BeFalse())
-		},
-		Entry("NonEmptyString: empty string", be_string.NonEmptyString(), ""),
-		Entry("EmptyString: non-empty string", be_string.EmptyString(), "Hello"),
-		Entry("Only(Alpha): alphanumeric string", be_string.Only(Alpha), "abc123"),
-		Entry("Only(Alpha): string with space", be_string.Only(Alpha), "Hello World"),
-		Entry("Only(Alpha): string with special characters", be_string.Only(Alpha), "Hello@World"),
-		Entry("Only(Alpha): empty string", be_string.Only(Alpha), ""),
-		Entry("Only(Alpha|Whitespace): contains numbers", be_string.Only(Alpha|Whitespace), "abc123"),
-		Entry("Only(Alpha|Whitespace): contains punctuation", be_string.Only(Alpha|Whitespace), "abc!"),
-		Entry(
-			"Only(Alpha|Whitespace): contains both numbers and punctuation",
-			be_string.Only(Alpha|Whitespace),
-			"abc 123!",
-		),
-		Entry("Only(Alpha|Whitespace): empty string", be_string.Only(Alpha|Whitespace), ""),
-		Entry("Only(Alpha|Punctuation): contains whitespace", be_string.Only(Alpha|Punctuation), "abc def"),
-		Entry("Only(Alpha|Punctuation): contains numbers", be_string.Only(Alpha|Punctuation), "abc123"),
-		Entry("Only(Alpha|Punctuation): empty string", be_string.Only(Alpha|Punctuation), ""),
-		Entry(
-			"Only(Alpha|Whitespace|Punctuation) contains numbers",
-			be_string.Only(Alpha|Whitespace|Punctuation),
-			"abc123",
-		),
-		Entry("Only(Alpha|Whitespace|Punctuation) empty string", be_string.Only(Alpha|Whitespace|Punctuation), ""),
-		Entry("Only(Whitespace): contains letters", be_string.Only(Whitespace), "abc"),
-		Entry("Only(Whitespace): contains numbers", be_string.Only(Whitespace), "123"),
-		Entry("Only(Whitespace): empty string", be_string.Only(Whitespace), ""),
-		Entry("Only(Numeric|Whitespace): contains letters", be_string.Only(Numeric|Whitespace), "abc"),
-		Entry("Only(Numeric|Whitespace): contains punctuation", be_string.Only(Numeric|Whitespace), "1,2,3"),
-		Entry(
-			"Only(Numeric|Whitespace): contains letters and punctuation",
-			be_string.Only(Numeric|Whitespace),
-			"abc 123!",
-		),
-		Entry("Only(Numeric|Whitespace): empty string", be_string.Only(Numeric|Whitespace), ""),
-		Entry(
-			"Only(Alpha|Numeric|Whitespace): contains punctuation",
-			be_string.Only(Alpha|Numeric|Whitespace),
-			"abc123!",
-		),
-		Entry(
-			"Only(Alpha|Numeric|Whitespace): contains special characters",
-			be_string.Only(Alpha|Numeric|Whitespace),
-			"abc 123@",
-		),
-		Entry(
-			"Only(Alpha|Numeric|Whitespace): contains letters, numbers, and punctuation",
-			be_string.Only(Alpha|Numeric|Whitespace),
-			"abc 123!",
-		),
-		Entry("Only(Alpha|Numeric|Whitespace): empty string", be_string.Only(Alpha|Numeric|Whitespace), ""),
-		Entry(
-			"Only(Alpha|Numeric|Punctuation): contains whitespace",
-			be_string.Only(Alpha|Numeric|Punctuation),
-			"abc 123",
-		),
-		Entry(
-			"Only(Alpha|Numeric|Punctuation): contains whitespace and special characters",
-			be_string.Only(Alpha|Numeric|Punctuation),
-			"abc 123@",
-		),
-		Entry(
-			"Only(Alpha|Numeric|Whitespace|Punctuation): contains special characters",
-			be_string.Only(Alpha|Numeric|Whitespace|Punctuation),
-			"abc$% 123@",
-		),
-		Entry(
-			"Only(Alpha|Numeric|Whitespace|Punctuation): empty string",
-			be_string.Only(Alpha|Numeric|Whitespace|Punctuation),
-			"",
-		),
-		Entry("Only(Numeric): alphanumeric string", be_string.Only(Numeric), "abc123"),
-		Entry("Only(Numeric): string with space", be_string.Only(Numeric), "123 456"),
-		Entry("Only(Numeric): string with special characters", be_string.Only(Numeric), "123@456"),
-		Entry("Only(Numeric): empty string", be_string.Only(Numeric), ""),
-		Entry("Only(Alpha|Numeric): string with space", be_string.Only(Alpha|Numeric), "abc 123"),
-		Entry("Only(Alpha|Numeric): string with special characters", be_string.Only(Alpha|Numeric), "abc@123"),
-		Entry("Only(Alpha|Numeric): empty string", be_string.Only(Alpha|Numeric), ""),
-		Entry("Only(Alpha|Numeric|Dots): string with space", be_string.Only(Alpha|Numeric|Dots), "abc 123"),
-		Entry(
-			"Only(Alpha|Numeric|Dots): string with special characters",
-			be_string.Only(Alpha|Numeric|Dots),
-			"abc@123",
-		),
-		Entry("Only(Alpha|Numeric|Dots): empty string", be_string.Only(Alpha|Numeric|Dots), ""),
-		Entry("Float: string with non-numeric characters", be_string.Float(), "3.14abc"),
-		Entry("Float: string with space", be_string.Float(), "3.14 5"),
-		Entry("Float: string with special characters", be_string.Float(), "3.14@5"),
-		Entry("Float: empty string", be_string.Float(), ""),
-		Entry("Titled: non-titled string", be_string.Titled(), "hello world"),
-		Entry("LowerCaseOnly: string with upper case", be_string.LowerCaseOnly(), "HelloWorld"),
-		Entry("ContainingSubstring: does not contain substring", be_string.ContainingSubstring("xyz"), "abc123"),
-		Entry("ContainingSubstring: empty string", be_string.ContainingSubstring("xyz"), ""),
-		Entry("HavingPrefix: not a prefix", be_string.HavingPrefix("World"), "Hello World"),
-		Entry("HavingPrefix: empty string", be_string.HavingPrefix("Hello"), ""),
-		Entry("HavingSuffix: not a suffix", be_string.HavingSuffix("Hello"), "Hello World"),
-		Entry("HavingSuffix: empty string", be_string.HavingSuffix("Hello"), ""),
-		Entry(
-			"ContainingOnlyCharacters: contains other characters",
-			be_string.ContainingOnlyCharacters("abc"),
-			"defabc123",
-		),
-		Entry("ContainingOnlyCharacters: empty string", be_string.ContainingOnlyCharacters("abc"), ""),
-		Entry("ContainingOnlyCharacters: contains whitespace", be_string.ContainingOnlyCharacters("abc"), "a b c"),
-		Entry("ContainingCharacters: missing required characters", be_string.ContainingCharacters("abc"), "def123"),
-		Entry("ContainingCharacters: empty string", be_string.ContainingCharacters("abc"), ""),
-		Entry("ContainingCharacters: contains extra characters", be_string.ContainingCharacters("abc"), "def123"),
-		Entry("MatchWildcard: no match", be_string.MatchWildcard("abc*"), "xyz123"),
-		Entry("ValidEmail: invalid email", be_string.ValidEmail(), "test@example@com"),
-		Entry("ValidEmail: just letters", be_string.ValidEmail(), "testexample"),
-		Entry("ValidEmail: numeric string", be_string.ValidEmail(), "1000"),
-	)
+				// Check gomock-compatible matching:
+				success = tc.matcher.Matches(tc.actual)
+				be.Expect(t, success).To(be.False())
+			})
+		}
+	})
 
 	// All be_string matchers expects input to be a string.
 	// They will not succeed and return a short "to be type of string" failure message
-	DescribeTable("non-string type tests", func(matcher types.BeMatcher) {
-		notStrings := []any{
-			0, false, map[string]any{}, []string{}, func() {}, nil,
-		}
-		actual := notStrings[rand.Intn(len(notStrings))] // not a string
 
-		// Check gomega-compatible matching:
-		success, err := matcher.Match(actual)
-		Expect(err).Should(Succeed())
-		Expect(success).To(BeFalse())
+	t.Run("non-string type tests", func(t *testing.T) {
+		for _, tc := range []struct {
+			name    string
+			matcher types.BeMatcher
+		}{
+			// todo: at some point Entries should be auto-generated
+			{"NonEmptyString", be_string.NonEmptyString()},
+			{"EmptyString", be_string.EmptyString()},
+			{"Only(Alpha)", be_string.Only(Alpha)},
+			{"Float", be_string.Float()},
+			{"Titled", be_string.Titled()},
+			{"LowerCaseOnly", be_string.LowerCaseOnly()},
+			{"ContainingSubstring", be_string.ContainingSubstring("xyz")},
+			{"HavingPrefix", be_string.HavingPrefix("xyz")},
+			{"HavingSuffix", be_string.HavingSuffix("xyz")},
+			{"ContainingOnlyCharacters", be_string.ContainingOnlyCharacters("abc")},
+			{"ContainingCharacters", be_string.ContainingCharacters("abc")},
+			{"MatchWildcard", be_string.MatchWildcard("abc*")},
+			{"ValidEmail", be_string.ValidEmail()},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				notStrings := []any{
+					0, false, map[string]any{}, []string{}, func() {}, nil,
+				}
+				actual := notStrings[rand.Intn(len(notStrings))] // not a string
+
+				// Check gomega-compatible matching:
+				success, err := tc.matcher.Match(actual)
+				be.Expect(t, err).To(be.Succeed())
+				be.Expect(t, success).To(be.False())
 
-		// Check gomock-compatible matching:
-		success = matcher.Matches(actual)
-		Expect(success).To(BeFalse())
+				// Check gomock-compatible matching:
+				success = tc.matcher.Matches(actual)
+				be.Expect(t, success).To(be.False())
 
-		// and the message should be the same
-		Expect(matcher.FailureMessage(actual)).To(HaveSuffix("to be type of string"))
-		// Expect(matcher.NegatedFailureMessage(actual)).To(HaveSuffix("not to be type of string"))
-	},
-		// todo: at some point Entries should be auto-generated
-		Entry("NonEmptyString", be_string.NonEmptyString()),
-		Entry("EmptyString", be_string.EmptyString()),
-		Entry("Only(Alpha)", be_string.Only(Alpha)),
-		Entry("Float", be_string.Float()),
-		Entry("Titled", be_string.Titled()),
-		Entry("LowerCaseOnly", be_string.LowerCaseOnly()),
-		Entry("ContainingSubstring", be_string.ContainingSubstring("xyz")),
-		Entry("HavingPrefix", be_string.HavingPrefix("xyz")),
-		Entry("HavingSuffix", be_string.HavingSuffix("xyz")),
-		Entry("ContainingOnlyCharacters", be_string.ContainingOnlyCharacters("abc")),
-		Entry("ContainingCharacters", be_string.ContainingCharacters("abc")),
-		Entry("MatchWildcard", be_string.MatchWildcard("abc*")),
-		Entry("ValidEmail", be_string.ValidEmail()),
-	)
-})
+				// and the message should be the same
+				be.Expect(t, tc.matcher.FailureMessage(actual)).To(be_string.HavingSuffix("to be type of string"))
+				// be.Expect(t, matcher.NegatedFailureMessage(actual)).To(be_string.HavingSuffix("not to be type of string"))
+			})
+		}
+	})
+}
 
-var _ = Describe("BeStrings (template matching)", func() {
-	It("Should match a template with 2 variables", func() {
+func TestBeStringsTemplateMatching(t *testing.T) {
+	t.Run("Should match a template with 2 variables", func(t *testing.T) {
 		matcher := be_string.MatchTemplate(
 			"Hello {{UserName}}! Given email is {{Email}}",
 			be_string.V("UserName", be_string.Only(Alpha|Numeric)),
 			be_string.V("Email", be_string.ValidEmail()),
 		)
 		input := "Hello Foo123! Given email is hello@gmail.com"
-		Expect(input).To(matcher)
+		be.Expect(t, input).To(matcher)
 	})
 
-	It("treats punctuation as literal and anchors the whole string", func() {
+	t.Run("treats punctuation as literal and anchors the whole string", func(t *testing.T) {
 		// parens and ? are literal here, not regex metacharacters
 		matcher := be_string.MatchTemplate(
 			`("status" = ?) OR ({{col}} > ?)`,
 			be_string.V("col", be_string.NonEmptyString()),
 		)
-		Expect(`("status" = ?) OR ("age" > ?)`).To(matcher)
+		be.Expect(t, `("status" = ?) OR ("age" > ?)`).To(matcher)
 		// anchored: extra prefix/suffix must NOT match
-		Expect(`XX("status" = ?) OR ("age" > ?)YY`).NotTo(matcher)
+		be.Expect(t, `XX("status" = ?) OR ("age" > ?)YY`).NotTo(matcher)
 	})
 
-	It("requires a literal ? rather than treating it as a regex quantifier", func() {
+	t.Run("requires a literal ? rather than treating it as a regex quantifier", func(t *testing.T) {
 		matcher := be_string.MatchTemplate(`{{col}} > ?`, be_string.V("col", be_string.NonEmptyString()))
-		Expect(`"age" > ?`).To(matcher)
-		Expect(`"age" > `).NotTo(matcher) // missing the literal ?
+		be.Expect(t, `"age" > ?`).To(matcher)
+		be.Expect(t, `"age" > `).NotTo(matcher) // missing the literal ?
 	})
-})
+}
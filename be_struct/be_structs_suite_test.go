@@ -1,13 +0,0 @@
-package be_struct_test
-
-import (
-	"testing"
-
-	. "github.com/onsi/ginkgo/v2"
-	. "github.com/onsi/gomega"
-)
-
-func TestBeStructs(t *testing.T) {
-	RegisterFailHandler(Fail)
-	RunSpecs(t, "BeStructs Suite")
-}
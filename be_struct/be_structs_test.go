@@ -1,16 +1,16 @@
 package be_struct_test
 
 import (
-	. "github.com/onsi/ginkgo/v2"
-	. "github.com/onsi/gomega"
+	"testing"
 
+	"github.com/expectto/be"
 	"github.com/expectto/be/be_math"
 	"github.com/expectto/be/be_string"
 	"github.com/expectto/be/be_struct"
 )
 
-var _ = Describe("BeStructs", func() {
-	It("should match a struct field", func() {
+func TestBeStructs(t *testing.T) {
+	t.Run("should match a struct field", func(t *testing.T) {
 		type TestStruct struct {
 			Field1 string
 			Field2 int
@@ -20,33 +20,33 @@ var _ = Describe("BeStructs", func() {
 			Field1: "hello1",
 		}
 
-		Expect(result).To(
-			And(
+		be.Expect(t, result).To(
+			be.All(
 				be_struct.HavingField[TestStruct]("Field1", "hello1"),
 				be_struct.HavingField[TestStruct]("Field2"), // just ensure it exists
 			))
 
-		Expect(result).NotTo(
+		be.Expect(t, result).NotTo(
 			be_struct.HavingField[TestStruct]("Field3"),
 		)
 
 		type WrongStruct struct {
 			Field1 string
 		}
-		Expect(result).NotTo(
+		be.Expect(t, result).NotTo(
 			be_struct.HavingField[WrongStruct]("Field1"),
 		)
 	})
 
-	It("matches a field against a matcher value", func() {
+	t.Run("matches a field against a matcher value", func(t *testing.T) {
 		type S struct {
 			Age  int
 			Name string
 		}
 		s := S{Age: 30, Name: "Alice"}
 
-		Expect(s).To(be_struct.HavingField[S]("Age", be_math.GreaterThan(18)))
-		Expect(s).To(be_struct.HavingField[S]("Name", be_string.NonEmptyString()))
-		Expect(s).NotTo(be_struct.HavingField[S]("Age", be_math.LessThan(0)))
+		be.Expect(t, s).To(be_struct.HavingField[S]("Age", be_math.GreaterThan(18)))
+		be.Expect(t, s).To(be_struct.HavingField[S]("Name", be_string.NonEmptyString()))
+		be.Expect(t, s).NotTo(be_struct.HavingField[S]("Age", be_math.LessThan(0)))
 	})
-})
+}
@@ -6,8 +6,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"github.com/onsi/gomega/gcustom"
-
 	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
 	"github.com/expectto/be/types"
 )
@@ -34,7 +32,14 @@ func HavingField[StructT any](fieldName string, expectedValue ...any) types.BeMa
 
 	structType := reflect.TypeFor[StructT]()
 
-	return Psi(gcustom.MakeMatcher(func(actual any) (bool, error) {
+	// The expected value may itself be a matcher (be/gomega/gomock): it's upgraded once,
+	// so the matcher is safe to share across goroutines
+	var fieldMatcher types.BeMatcher
+	if len(expectedValue) > 0 && IsMatcher(expectedValue[0]) {
+		fieldMatcher = Psi(expectedValue[0])
+	}
+
+	return Psi(FromMatchFunc(func(actual any) (bool, error) {
 		val := reflect.ValueOf(actual)
 
 		// Dereference pointer(s) to a struct
@@ -62,10 +67,9 @@ func HavingField[StructT any](fieldName string, expectedValue ...any) types.BeMa
 			return true, nil
 		}
 
-		// The expected value may itself be a matcher (be/gomega/gomock) — match the
-		// field against it; otherwise compare by deep equality.
-		if IsMatcher(expectedValue[0]) {
-			return Psi(expectedValue[0]).Match(field.Interface())
+		// Match the field against the expected matcher, otherwise compare by deep equality.
+		if fieldMatcher != nil {
+			return fieldMatcher.Match(field.Interface())
 		}
 		return reflect.DeepEqual(field.Interface(), expectedValue[0]), nil
 	}), message)
@@ -7,43 +7,42 @@ import (
 	"time"
 
 	"github.com/amberpixels/k1/cast"
-	"github.com/onsi/gomega"
-	"github.com/onsi/gomega/gcustom"
 
 	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
+	"github.com/expectto/be/internal/psi_matchers"
 	"github.com/expectto/be/types"
 )
 
 // LaterThan succeeds if actual time is later than the specified time `compareTo`.
 func LaterThan(compareTo time.Time) types.BeMatcher {
-	return Psi(gomega.BeTemporally(">", compareTo))
+	return psi_matchers.NewTemporalMatcher(">", compareTo)
 }
 
 // LaterThanEqual succeeds if actual time is later than or equal to the specified time `compareTo`.
 func LaterThanEqual(compareTo time.Time) types.BeMatcher {
-	return Psi(gomega.BeTemporally(">=", compareTo))
+	return psi_matchers.NewTemporalMatcher(">=", compareTo)
 }
 
 // EarlierThan succeeds if actual time is earlier than the specified time `compareTo`.
 func EarlierThan(compareTo time.Time) types.BeMatcher {
-	return Psi(gomega.BeTemporally("<", compareTo))
+	return psi_matchers.NewTemporalMatcher("<", compareTo)
 }
 
 // EarlierThanEqual succeeds if actual time is earlier than or equal to the specified time `compareTo`.
 func EarlierThanEqual(compareTo time.Time) types.BeMatcher {
-	return Psi(gomega.BeTemporally("<=", compareTo))
+	return psi_matchers.NewTemporalMatcher("<=", compareTo)
 }
 
 // Eq succeeds if actual time is equal to the specified time `compareTo`
 // with the precision of one nanosecond.
 func Eq(compareTo time.Time) types.BeMatcher {
-	return Psi(gomega.BeTemporally("==", compareTo))
+	return psi_matchers.NewTemporalMatcher("==", compareTo)
 }
 
 // Approx succeeds if actual time is approximately equal to the specified time `compareTo`
 // within the given time duration threshold.
 func Approx(compareTo time.Time, threshold time.Duration) types.BeMatcher {
-	return Psi(gomega.BeTemporally("~", compareTo, threshold))
+	return psi_matchers.NewTemporalMatcher("~", compareTo, threshold)
 }
 
 //
@@ -150,7 +149,7 @@ func SameExactHour(compareTo time.Time) types.BeMatcher {
 
 // SameExactDay succeeds if the actual time falls within the same day as the specified time `compareTo`.
 func SameExactDay(compareTo time.Time) types.BeMatcher {
-	return Psi(gcustom.MakeMatcher(func(actual any) (bool, error) {
+	return FromMatchFunc(func(actual any) (bool, error) {
 		if !cast.IsTime(actual) {
 			return false, errors.New("invalid time type")
 		}
@@ -158,25 +157,25 @@ func SameExactDay(compareTo time.Time) types.BeMatcher {
 
 		// Different is less than 24 * Hours and they are within the same day
 		return compareTo.Sub(actualTime).Abs() < 24*time.Hour && compareTo.Day() == actualTime.Day(), nil
-	}))
+	})
 }
 
 // SameExactWeekday succeeds if the weekday component of the actual time is equal to the weekday component
 // of the specified time `compareTo`.
 func SameExactWeekday(compareTo time.Time) types.BeMatcher {
-	return Psi(gcustom.MakeMatcher(func(actual any) (bool, error) {
+	return FromMatchFunc(func(actual any) (bool, error) {
 		if !cast.IsTime(actual) {
 			return false, errors.New("invalid time type")
 		}
 		actualTime := cast.AsTime(actual)
 
 		return compareTo.Weekday() == actualTime.Weekday(), nil
-	}))
+	})
 }
 
 // SameExactWeek succeeds if the actual time falls within the same ISO week as the specified time `compareTo`.
 func SameExactWeek(compareTo time.Time) types.BeMatcher {
-	return Psi(gcustom.MakeMatcher(func(actual any) (bool, error) {
+	return FromMatchFunc(func(actual any) (bool, error) {
 		if !cast.IsTime(actual) {
 			return false, errors.New("invalid time type")
 		}
@@ -185,19 +184,19 @@ func SameExactWeek(compareTo time.Time) types.BeMatcher {
 		compareToYear, compareToWeek := compareTo.ISOWeek()
 		actualYear, actualWeek := actualTime.ISOWeek()
 		return compareToYear == actualYear && compareToWeek == actualWeek, nil
-	}))
+	})
 }
 
 // SameExactMonth succeeds if the actual time falls within the same month as the specified time `compareTo`.
 func SameExactMonth(compareTo time.Time) types.BeMatcher {
-	return Psi(gcustom.MakeMatcher(func(actual any) (bool, error) {
+	return FromMatchFunc(func(actual any) (bool, error) {
 		if !cast.IsTime(actual) {
 			return false, errors.New("invalid time type")
 		}
 		actualTime := cast.AsTime(actual)
 
 		return compareTo.Year() == actualTime.Year() && compareTo.Month() == actualTime.Month(), nil
-	}))
+	})
 }
 
 //
@@ -208,70 +207,70 @@ func SameExactMonth(compareTo time.Time) types.BeMatcher {
 // the .Second() component of the specified time `compareTo`.
 // It only verifies the second [0..59], disregarding other components such as minute, hour, day, etc.
 func SameSecond(compareTo time.Time) types.BeMatcher {
-	return Psi(gcustom.MakeMatcher(func(actual any) (bool, error) {
+	return FromMatchFunc(func(actual any) (bool, error) {
 		if !cast.IsTime(actual) {
 			return false, errors.New("invalid time type")
 		}
 		actualTime := cast.AsTime(actual)
 
 		return compareTo.Second() == actualTime.Second(), nil
-	}))
+	})
 }
 
 // SameMinute checks if the .Minute() component of the actual time matches
 // the .Minute() component of the specified time `compareTo`.
 // It only verifies the minute [0..59], disregarding other components such as second, hour, day, etc.
 func SameMinute(compareTo time.Time) types.BeMatcher {
-	return Psi(gcustom.MakeMatcher(func(actual any) (bool, error) {
+	return FromMatchFunc(func(actual any) (bool, error) {
 		if !cast.IsTime(actual) {
 			return false, errors.New("invalid time type")
 		}
 		actualTime := cast.AsTime(actual)
 
 		return compareTo.Minute() == actualTime.Minute(), nil
-	}))
+	})
 }
 
 // SameHour checks if the .Hour() component of the actual time matches
 // the .Hour() component of the specified time `compareTo`.
 // It only verifies the hour [0..59], disregarding other components such as second, minute, day, etc.
 func SameHour(compareTo time.Time) types.BeMatcher {
-	return Psi(gcustom.MakeMatcher(func(actual any) (bool, error) {
+	return FromMatchFunc(func(actual any) (bool, error) {
 		if !cast.IsTime(actual) {
 			return false, errors.New("invalid time type")
 		}
 		actualTime := cast.AsTime(actual)
 
 		return compareTo.Hour() == actualTime.Hour(), nil
-	}))
+	})
 }
 
 // SameDay checks if the .Day() component of the actual time matches
 // the .Day() component of the specified time `compareTo`.
 // It only verifies the day [1..30(31)], disregarding the month, year, etc.
 func SameDay(compareTo time.Time) types.BeMatcher {
-	return Psi(gcustom.MakeMatcher(func(actual any) (bool, error) {
+	return FromMatchFunc(func(actual any) (bool, error) {
 		if !cast.IsTime(actual) {
 			return false, errors.New("invalid time type")
 		}
 		actualTime := cast.AsTime(actual)
 
 		return compareTo.Day() == actualTime.Day(), nil
-	}))
+	})
 }
 
 // SameYearDay checks if the .YearDay() component of the actual time matches
 // the .YearDay() component of the specified time `compareTo`.
 // It only verifies the day [1..365], disregarding the year.
 func SameYearDay(compareTo time.Time) types.BeMatcher {
-	return Psi(gcustom.MakeMatcher(func(actual any) (bool, error) {
+	return FromMatchFunc(func(actual any) (bool, error) {
 		if !cast.IsTime(actual) {
 			return false, errors.New("invalid time type")
 		}
 		actualTime := cast.AsTime(actual)
 
 		return compareTo.YearDay() == actualTime.YearDay(), nil
-	}))
+	})
 }
 
 // SameWeek succeeds if the ISO week of the actual time
@@ -279,7 +278,7 @@ func SameYearDay(compareTo time.Time) types.BeMatcher {
 // It only verifies the week [1..53], disregarding of year.
 // Note: use SameExactWeek to respect exact week of exact year
 func SameWeek(compareTo time.Time) types.BeMatcher {
-	return Psi(gcustom.MakeMatcher(func(actual any) (bool, error) {
+	return FromMatchFunc(func(actual any) (bool, error) {
 		if !cast.IsTime(actual) {
 			return false, errors.New("invalid time type")
 		}
@@ -288,52 +287,52 @@ func SameWeek(compareTo time.Time) types.BeMatcher {
 		_, compareToWeek := compareTo.ISOWeek()
 		_, actualWeek := actualTime.ISOWeek()
 		return compareToWeek == actualWeek, nil
-	}))
+	})
 }
 
 // SameMonth checks if the .Month() component of the actual time matches
 // the .Month() component of the specified time `compareTo`.
 // It only verifies the month [1..12], disregarding the year.
 func SameMonth(compareTo time.Time) types.BeMatcher {
-	return Psi(gcustom.MakeMatcher(func(actual any) (bool, error) {
+	return FromMatchFunc(func(actual any) (bool, error) {
 		if !cast.IsTime(actual) {
 			return false, errors.New("invalid time type")
 		}
 		actualTime := cast.AsTime(actual)
 
 		return compareTo.Month() == actualTime.Month(), nil
-	}))
+	})
 }
 
 // SameYear succeeds if the year component of the actual time is equal to the year component
 // of the specified time `compareTo`.
 func SameYear(compareTo time.Time) types.BeMatcher {
-	return Psi(gcustom.MakeMatcher(func(actual any) (bool, error) {
+	return FromMatchFunc(func(actual any) (bool, error) {
 		if !cast.IsTime(actual) {
 			return false, errors.New("invalid time type")
 		}
 		actualTime := cast.AsTime(actual)
 
 		return compareTo.Year() == actualTime.Year(), nil
-	}))
+	})
 }
 
 // SameTimezone checks if actual time is the same timezone as specified time `compareTo`
 func SameTimezone(compareTo time.Time) types.BeMatcher {
-	return Psi(gcustom.MakeMatcher(func(actual any) (bool, error) {
+	return FromMatchFunc(func(actual any) (bool, error) {
 		if !cast.IsTime(actual) {
 			return false, errors.New("invalid time type")
 		}
 		actualTime := cast.AsTime(actual)
 
 		return compareTo.Location().String() == actualTime.Location().String(), nil
-	}))
+	})
 }
 
 // SameOffset checks if actual time is the same timezone offset as specified time `compareTo`
 // Note: times can have different timezone names, but same offset, e.g. America/New_York and Canada/Toronto
 func SameOffset(compareTo time.Time) types.BeMatcher {
-	return Psi(gcustom.MakeMatcher(func(actual any) (bool, error) {
+	return FromMatchFunc(func(actual any) (bool, error) {
 		if !cast.IsTime(actual) {
 			return false, errors.New("invalid time type")
 		}
@@ -342,16 +341,16 @@ func SameOffset(compareTo time.Time) types.BeMatcher {
 		_, offsetGiven := compareTo.Zone()
 		_, offsetActual := actualTime.Zone()
 		return offsetGiven == offsetActual, nil
-	}))
+	})
 }
 
 // IsDST checks if actual time is DST
 func IsDST(compareTo time.Time) types.BeMatcher {
-	return Psi(gcustom.MakeMatcher(func(actual any) (bool, error) {
+	return FromMatchFunc(func(actual any) (bool, error) {
 		if !cast.IsTime(actual) {
 			return false, errors.New("invalid time type")
 		}
 		actualTime := cast.AsTime(actual)
 		return actualTime.IsDST(), nil
-	}))
+	})
 }
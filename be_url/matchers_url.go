@@ -5,7 +5,6 @@ import (
 	"net/url"
 
 	"github.com/amberpixels/k1/cast"
-	"github.com/onsi/gomega"
 
 	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
 	"github.com/expectto/be/internal/psi_matchers"
@@ -48,10 +47,10 @@ func URL(args ...any) types.BeMatcher {
 		// match given string to whole url
 		return psi_matchers.NewUrlFieldMatcher("Url", "", func(u *url.URL) any {
 			return u.String()
-		}, gomega.Equal(args[0]))
+		}, NewEqMatcher(args[0]))
 	}
 
-	return Psi(args...)
+	return WithPath("url", Psi(args...))
 }
 
 // HavingHost succeeds if the actual value is a *url.URL and its Host matches the provided one (via direct value or matchers)
@@ -84,7 +83,7 @@ func HavingScheme(args ...any) types.BeMatcher {
 // NotHavingScheme succeeds if the actual value is a *url.URL and its Scheme negatively matches given value
 // Example:  `Expect(u).To(NotHavingScheme())` matches url without a scheme
 func NotHavingScheme(args ...any) types.BeMatcher {
-	return Psi(gomega.Not(HavingScheme(args...)))
+	return psi_matchers.NewNotMatcher(HavingScheme(args...))
 }
 
 // WithHttps succeeds if the actual value is a *url.URL and its scheme is "https".
@@ -109,7 +108,7 @@ func HavingPort(args ...any) types.BeMatcher {
 // NotHavingPort succeeds if the actual value is a *url.URL and its Port does not match the given one.
 // Example:  `Expect(u).To(NotHavingPort())` matches port-less url
 func NotHavingPort(args ...any) types.BeMatcher {
-	return Psi(gomega.Not(HavingPort(args...)))
+	return psi_matchers.NewNotMatcher(HavingPort(args...))
 }
 
 // HavingPath succeeds if the actual value is a *url.URL and its Path matches the given one.
@@ -146,7 +145,7 @@ func NotHavingSearchParam(searchParamName string) types.BeMatcher {
 	return psi_matchers.NewUrlFieldMatcher(
 		"NotHavingSearchParam", "searchParam",
 		func(u *url.URL) any { return u.Query().Has(searchParamName) },
-		gomega.BeFalse(),
+		psi_matchers.NewBoolMatcher(false),
 	)
 }
 
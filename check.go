@@ -0,0 +1,99 @@
+package be
+
+// check.go evaluates a matcher without a TestingT. It's the building block for
+// tools on top of `be` (contract checkers, custom reporters, mock diagnostics):
+// instead of a single failure string, it returns the whole evaluation tree.
+
+import (
+	"github.com/expectto/be/internal/psi"
+	"github.com/expectto/be/types"
+)
+
+// Result is the outcome of evaluating a matcher against a value (see Check).
+type Result struct {
+	// Success is true if the matcher matched the actual value.
+	Success bool
+	// Err is the evaluation error: the matcher could not evaluate actual at all
+	// (e.g. a non-slice given to Dive). A non-nil Err always means !Success.
+	Err error
+	// Message is the compacted failure message, the same text Expect would
+	// report. Empty on success.
+	Message string
+	// Path is the path from the root actual to the value this result is about,
+	// e.g. `request.body.json["details"][1]`. Empty for the root.
+	Path string
+	// Actual is the value this (sub)matcher was applied to.
+	Actual any
+	// Children are the results of the sub-matchers of a composite matcher
+	// (All, Any, Dive, request properties, JSON keys, ...). Children are all
+	// evaluated, even the ones a short-circuiting composite would skip.
+	Children []Result
+}
+
+// Check evaluates the matcher against actual without failing anything and
+// returns the result tree. The matcher may be anything Expect accepts:
+//
+//	res := be.Check(req, be_http.Request(be_http.POST(), be_http.HavingBody(...)))
+//	if !res.Success {
+//		log.Print(res.Message)
+//	}
+//
+// Use Failures to get a flat list of the innermost failing sub-results.
+func Check(actual, matcher any) Result {
+	m := psi.Psi(matcher)
+	return result(m, actual, "", psi.Explain(m, actual))
+}
+
+// check builds the result of a sub-matcher: it was evaluated by its parent already,
+// so a matcher with side effects (e.g. receiving from a channel) explains that evaluation
+// instead of evaluating again (see types.RememberingMatcher)
+func check(m types.BeMatcher, actual any, path string) Result {
+	return result(m, actual, path, psi.Recall(m, actual))
+}
+
+func result(m types.BeMatcher, actual any, path string, o types.Outcome) Result {
+	res := Result{Path: path, Actual: actual}
+
+	res.Success, res.Err = o.Success, o.Err
+	switch {
+	case res.Err != nil:
+		res.Message = res.Err.Error()
+	case !res.Success:
+		res.Message = describeFailure(o.Message)
+	}
+
+	cm, ok := m.(types.CompositeMatcher)
+	if !ok {
+		return res
+	}
+
+	children := cm.Children(actual)
+	for _, child := range children {
+		res.Children = append(res.Children, check(child.Matcher, child.Actual, psi.JoinPath(path, child.Segment)))
+	}
+
+	// Collapse transparent wrappers (e.g. be.All over a single group): a lone
+	// child at the same path with the same outcome adds nothing to the tree
+	if len(children) == 1 && children[0].Segment == "" && res.Children[0].Success == res.Success {
+		res.Children = res.Children[0].Children
+	}
+
+	return res
+}
+
+// Failures returns the innermost failing results of the tree: the failing
+// results that have no failing children. For a passing result it's empty.
+func (r Result) Failures() []Result {
+	if r.Success {
+		return nil
+	}
+
+	var failures []Result
+	for _, child := range r.Children {
+		failures = append(failures, child.Failures()...)
+	}
+	if len(failures) == 0 {
+		return []Result{r}
+	}
+	return failures
+}
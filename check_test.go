@@ -0,0 +1,114 @@
+package be_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/expectto/be"
+	"github.com/expectto/be/be_http"
+	"github.com/expectto/be/be_json"
+)
+
+func TestCheckSuccess(t *testing.T) {
+	res := be.Check(5, be.All(be.Gt(3), be.Lt(10)))
+	if !res.Success || res.Err != nil || res.Message != "" {
+		t.Fatalf("expected a clean success, got %+v", res)
+	}
+	if len(res.Children) != 2 || !res.Children[0].Success || !res.Children[1].Success {
+		t.Fatalf("expected two passing children, got %+v", res.Children)
+	}
+	if len(res.Failures()) != 0 {
+		t.Fatalf("a passing result has no failures, got %+v", res.Failures())
+	}
+}
+
+func TestCheckReportsEveryChild(t *testing.T) {
+	// All short-circuits on the first failure, Check still evaluates every child
+	res := be.Check(5, be.All(be.Gt(10), be.Lt(10), be.Lt(3)))
+	if res.Success {
+		t.Fatalf("expected failure")
+	}
+	if res.Message != "Expected 5 to be > 10" {
+		t.Fatalf("unexpected compact message: %q", res.Message)
+	}
+
+	var passed []bool
+	for _, child := range res.Children {
+		passed = append(passed, child.Success)
+	}
+	if len(passed) != 3 || passed[0] || !passed[1] || passed[2] {
+		t.Fatalf("expected children fail/pass/fail, got %v", passed)
+	}
+	if got := len(res.Failures()); got != 2 {
+		t.Fatalf("expected two innermost failures, got %d", got)
+	}
+}
+
+func TestCheckTreeFollowsPath(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodPost, "https://example.com", strings.NewReader(
+		`{"details": [{"key": "a"}, {"key": "b"}]}`,
+	))
+
+	res := be.Check(req, be_http.Request(
+		be_http.HavingBody(be.JSON(
+			be_json.HaveKeyValue("details", be.Dive(be_json.HaveKeyValue("key", "a"))),
+		)),
+	))
+	if res.Success {
+		t.Fatalf("expected failure")
+	}
+
+	failures := res.Failures()
+	if len(failures) != 1 {
+		t.Fatalf("expected a single innermost failure, got %+v", failures)
+	}
+	if failures[0].Path != `request.body.json["details"][1]["key"]` {
+		t.Fatalf("unexpected failure path: %q", failures[0].Path)
+	}
+	if failures[0].Actual != "b" {
+		t.Fatalf("failure should carry the actual value it saw, got %#v", failures[0].Actual)
+	}
+}
+
+func TestCheckEvaluationError(t *testing.T) {
+	res := be.Check(42, be.Dive(be.Gt(0)))
+	if res.Success || res.Err == nil {
+		t.Fatalf("expected an evaluation error, got %+v", res)
+	}
+	if res.Message != res.Err.Error() {
+		t.Fatalf("message should carry the error, got %q", res.Message)
+	}
+
+	res = be.Check(errors.New("boom"), be.Succeed())
+	if res.Success || res.Err != nil {
+		t.Fatalf("a mismatch is not an evaluation error, got %+v", res)
+	}
+}
+
+// TestCheckReadsReaderOnce guards that the tree over a reader that can't be rewound
+// is built from the single evaluation that read it
+func TestCheckReadsReaderOnce(t *testing.T) {
+	body := io.MultiReader(strings.NewReader(`{"n": 1}`)) // not an io.Seeker
+
+	m := be.JSON(be_json.HaveKeyValue("n", 2.0))
+	res := be.Check(body, m)
+	if res.Success || res.Err != nil {
+		t.Fatalf("expected a failure, got %+v", res)
+	}
+	failures := res.Failures()
+	if len(failures) != 1 || failures[0].Path != `json["n"]` || failures[0].Actual != 1.0 {
+		t.Fatalf("expected the failure at json[\"n\"], got %+v", failures)
+	}
+
+	// gomega style: FailureMessage explains the Match it follows
+	body = io.MultiReader(strings.NewReader(`{"n": 1}`))
+	if ok, err := m.Match(body); ok || err != nil {
+		t.Fatalf("expected a mismatch, got (%v, %v)", ok, err)
+	}
+	if msg := m.FailureMessage(body); strings.Contains(msg, "EOF") || !strings.Contains(msg, `["n"]`) {
+		t.Fatalf("unexpected failure message: %q", msg)
+	}
+}
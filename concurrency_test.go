@@ -0,0 +1,158 @@
+package be_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/expectto/be"
+	"github.com/expectto/be/be_ctx"
+	"github.com/expectto/be/be_http"
+	"github.com/expectto/be/be_json"
+	"github.com/expectto/be/be_string"
+	"github.com/expectto/be/typed"
+	"github.com/expectto/be/types"
+)
+
+type ctxKey string
+
+// boundsMatcher is a plain gomega-style matcher (it has no Explain),
+// and it's stateful: FailureMessage reports the bound the last Match violated.
+type boundsMatcher struct {
+	lo, hi int
+	failed string
+}
+
+func (m *boundsMatcher) Match(actual any) (bool, error) {
+	n, ok := actual.(int)
+	if !ok {
+		return false, fmt.Errorf("expected an int, got %T", actual)
+	}
+	switch {
+	case n <= m.lo:
+		m.failed = fmt.Sprintf("to be > %d", m.lo)
+	case n >= m.hi:
+		m.failed = fmt.Sprintf("to be < %d", m.hi)
+	default:
+		m.failed = ""
+		return true, nil
+	}
+	return false, nil
+}
+
+func (m *boundsMatcher) FailureMessage(actual any) string {
+	return fmt.Sprintf("Expected %v %s", actual, m.failed)
+}
+
+func (m *boundsMatcher) NegatedFailureMessage(actual any) string {
+	return fmt.Sprintf("Expected %v not to be within (%d, %d)", actual, m.lo, m.hi)
+}
+
+// sharedMatchers are matcher instances shared by all goroutines of a test,
+// along with values they are evaluated against (both matching and failing ones).
+// Values are made per evaluation: requests bodies are consumed by matching.
+func sharedMatchers() []struct {
+	name    string
+	matcher types.BeMatcher
+	values  func() []any
+} {
+	request := func(body string) *http.Request {
+		req, _ := http.NewRequest(http.MethodPost, "https://example.com", strings.NewReader(body))
+		return req
+	}
+	// contexts are read-only, so they are shared as well (their addresses show up in messages)
+	ctxA := context.WithValue(context.Background(), ctxKey("id"), "a")
+	ctxB := context.WithValue(context.Background(), ctxKey("id"), "b")
+
+	return []struct {
+		name    string
+		matcher types.BeMatcher
+		values  func() []any
+	}{
+		{"All", be.All(be.Gt(1), be.Lt(10)), func() []any { return []any{5, 0, 20} }},
+		{"Any", be.Any(be.Eq(1), be.Eq(2)), func() []any { return []any{1, 2, 3} }},
+		{"Not", be.Not(be.Eq("a")), func() []any { return []any{"a", "b"} }},
+		{"Eq", be.Eq("a"), func() []any { return []any{"a", "b", "c"} }},
+		{"Dive", be.Dive(be.Gt(0)), func() []any { return []any{[]int{1, 2}, []int{1, 0}, []int{-1, 1}} }},
+		{"stateful gomega-style", be.All(&boundsMatcher{lo: 1, hi: 10}), func() []any { return []any{5, 0, 20} }},
+		{"StringAsTemplate", be.StringAsTemplate("Hi {{Name}}!", be_string.V("Name", be.Eq("Bob"))), func() []any {
+			return []any{"Hi Bob!", "Hi Alice!", "Bye"}
+		}},
+		{"Ctx", be_ctx.CtxWithValue(ctxKey("id"), "a"), func() []any { return []any{ctxA, ctxB} }},
+		{"HttpRequest", be.HttpRequest(be_http.POST(), be_http.HavingBody(be.JSON(be_json.HaveKeyValue("n", 1.0)))), func() []any {
+			return []any{request(`{"n": 1}`), request(`{"n": 2}`), request(`{"m": 1}`)}
+		}},
+		{"JSON reader", be.JSON(be_json.HaveKeyValue("n", 1.0)), func() []any {
+			return []any{strings.NewReader(`{"n": 1}`), strings.NewReader(`{"n": 2}`), strings.NewReader(`{"m": 1}`)}
+		}},
+		{"typed", typed.All(typed.Gt(1), typed.Lt(10)), func() []any { return []any{5, 0, 20} }},
+	}
+}
+
+// TestSharedMatchersAcrossGoroutines evaluates shared matcher instances from many goroutines:
+// every evaluation must report exactly what a lone evaluation reports (run with -race)
+func TestSharedMatchersAcrossGoroutines(t *testing.T) {
+	for _, tc := range sharedMatchers() {
+		t.Run(tc.name, func(t *testing.T) {
+			var want []be.Result
+			for _, v := range tc.values() {
+				want = append(want, be.Check(v, tc.matcher))
+			}
+
+			var wg sync.WaitGroup
+			errs := make(chan string, 100)
+			for range 8 {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for range 20 {
+						for i, v := range tc.values() {
+							got := be.Check(v, tc.matcher)
+							if got.Success != want[i].Success || got.Message != want[i].Message {
+								errs <- fmt.Sprintf("value #%d: got (%v, %q), want (%v, %q)",
+									i, got.Success, got.Message, want[i].Success, want[i].Message)
+								return
+							}
+							if tc.matcher.Matches(v) != want[i].Success {
+								errs <- fmt.Sprintf("value #%d: gomock Matches disagrees with Match", i)
+								return
+							}
+						}
+					}
+				}()
+			}
+			wg.Wait()
+			close(errs)
+			for err := range errs {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+// TestSharedMatcherInParallelSubtests shares a matcher between parallel subtests,
+// each of them must get the failure message of its own actual value
+func TestSharedMatcherInParallelSubtests(t *testing.T) {
+	shared := be.All(be.Gt(0), be.Not(be.Eq(13)), be.Lt(100))
+	want := map[int]string{
+		-1:  "Expected -1 to be > 0",
+		13:  "Expected 13 not to equal 13",
+		100: "Expected 100 to be < 100",
+	}
+
+	for actual, msg := range want {
+		t.Run(fmt.Sprint(actual), func(t *testing.T) {
+			t.Parallel()
+			for range 100 {
+				rt := &recT{}
+				be.Expect(rt, actual).To(shared)
+				if len(rt.errs) != 1 || rt.errs[0] != msg {
+					t.Fatalf("got %v, want %q", rt.errs, msg)
+				}
+			}
+		})
+	}
+}
@@ -11,13 +11,21 @@
 //	be.Require(t, actual).To(matcher)          // hard: Fatalf, test stops
 //	be.AssertThat(t, actual, matcher)          // flat soft spelling
 //	be.RequireThat(t, actual, matcher)         // flat hard spelling
+//	be.ExpectValues(t)(f()).To(matcher)        // (v, err) / (v, ok): err must be nil, ok true
 //	be.NoError(t, err)                         // hard error shortcuts:
 //	be.Error(t, err)                           //   the testify require trio
 //	be.ErrorIs(t, err, target)
 //	be.Eventually(t, poll, matcher)            // async: poll until it matches
+//	be.Group(t, func(g *be.G) { ... })         // collect failures, report once
+//
+// Failures are reported via t.Errorf / t.Fatalf by default. To emit them
+// elsewhere (JSON lines for CI, Ginkgo report entries, colours), plug in a
+// be.Reporter globally (be.SetReporter) or per assertion
+// (be.Expect(t, x).WithReporter(r)): it receives the structured be.Failure.
 //
 // All matchers also work inside gomega (Expect(x).To(be.Eq(y))) and as gomock
-// argument matchers.
+// argument matchers. To evaluate a matcher without a TestingT — e.g. in tools
+// built on be — use be.Check, which returns the whole result tree.
 //
 // # Say it with a matcher, not with be.True
 //
@@ -55,6 +63,7 @@
 //   - be_reflected: kind/type assertions (AsNumericString, AsKind, ...)
 //   - be_http, be_url, be_json, be_jwt, be_ctx: HTTP requests, URLs, JSON,
 //     JWT tokens and contexts
+//   - typed: compile-time typed assertions (typed.Expect, typed.Eq[T], ...)
 //
 // Temporal matchers are never aliased at root (their Eq, Approx, Day would
 // collide) — always reach for be_time explicitly.
@@ -2,32 +2,32 @@ package examples
 
 import (
 	"context"
+	"testing"
 
-	. "github.com/onsi/ginkgo/v2"
-	. "github.com/onsi/gomega"
-
+	"github.com/expectto/be"
 	"github.com/expectto/be/be_ctx"
+	"github.com/expectto/be/be_string"
 )
 
-var _ = Describe("MatchersCtx", func() {
+func TestMatchersCtx(t *testing.T) {
 	ctx := context.Background()
 
-	It("should match a ctx", func() {
-		Expect(ctx).To(be_ctx.Ctx())
+	t.Run("should match a ctx", func(t *testing.T) {
+		be.Expect(t, ctx).To(be_ctx.Ctx())
 	})
 
-	It("should match a ctx with a value", func() {
+	t.Run("should match a ctx with a value", func(t *testing.T) {
 		type CtxKey string
 		ctx := context.WithValue(ctx, CtxKey("foo"), "bar")
 		// just by key
-		Expect(ctx).To(be_ctx.CtxWithValue(CtxKey("foo")))
+		be.Expect(t, ctx).To(be_ctx.CtxWithValue(CtxKey("foo")))
 		// key + value directly
-		Expect(ctx).To(be_ctx.CtxWithValue(CtxKey("foo"), "bar"))
+		be.Expect(t, ctx).To(be_ctx.CtxWithValue(CtxKey("foo"), "bar"))
 		// key + value via matcher
-		Expect(ctx).To(be_ctx.CtxWithValue(CtxKey("foo"), HavePrefix("ba")))
+		be.Expect(t, ctx).To(be_ctx.CtxWithValue(CtxKey("foo"), be_string.HavingPrefix("ba")))
 	})
 
-	It("should not match when a string given instead of ctx", func() {
-		Expect("not a ctx but a string").NotTo(be_ctx.Ctx())
+	t.Run("should not match when a string given instead of ctx", func(t *testing.T) {
+		be.Expect(t, "not a ctx but a string").NotTo(be_ctx.Ctx())
 	})
-})
+}
@@ -1,8 +1,7 @@
 package examples_test
 
 import (
-	. "github.com/onsi/ginkgo/v2"
-	. "github.com/onsi/gomega"
+	"testing"
 
 	"github.com/expectto/be"
 	"github.com/expectto/be/be_json"
@@ -13,23 +12,23 @@ type Foobar struct {
 	Foo string `json:"foo"`
 }
 
-var _ = Describe("Examples on matching JSON", func() {
-	Context("Valid JSON string", func() {
-		It("should match against valid JSON string", func() {
-			Expect(`{"foo":"bar"}`).To(be.JSON(
+func TestExamplesOnMatchingJSON(t *testing.T) {
+	t.Run("Valid JSON string", func(t *testing.T) {
+		t.Run("should match against valid JSON string", func(t *testing.T) {
+			be.Expect(t, `{"foo":"bar"}`).To(be.JSON(
 				be_json.JsonAsString,
 				be_reflected.AsObject(),
 				be_json.HaveKeyValue("foo", "bar"),
 			))
 		})
 
-		It("should match against valid JSON struct", func() {
+		t.Run("should match against valid JSON struct", func(t *testing.T) {
 			f := Foobar{Foo: "bar"}
-			Expect(f).To(be.JSON(
+			be.Expect(t, f).To(be.JSON(
 				be_json.JsonAsStruct,
 				be_reflected.AsObject(),
 				be_json.HaveKeyValue("foo", "bar"),
 			))
 		})
 	})
-})
+}
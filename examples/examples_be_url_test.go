@@ -2,54 +2,55 @@ package examples_test
 
 import (
 	"net/url"
+	"testing"
 
-	. "github.com/onsi/ginkgo/v2"
-	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
 
+	"github.com/expectto/be"
 	"github.com/expectto/be/be_json"
 	"github.com/expectto/be/be_reflected"
+	"github.com/expectto/be/be_string"
 	"github.com/expectto/be/be_url"
 	"github.com/expectto/be/internal/testing/mocks"
 )
 
-var _ = Describe("Examples on matching URL", func() {
-	Context("*url.URL as a result of function being tested", func() {
-		It("should match against all parts of *url.URL", func() {
+func TestExamplesOnMatchingURL(t *testing.T) {
+	t.Run("*url.URL as a result of function being tested", func(t *testing.T) {
+		t.Run("should match against all parts of *url.URL", func(t *testing.T) {
 			u, err := url.Parse(`https://example.com/path/to/?foo=bar_123&v=1&payload={"hello":"world"}`)
-			Expect(err).Should(Succeed())
+			be.Expect(t, err).To(be.Succeed())
 
-			Expect(u).To(be_url.URL(
+			be.Expect(t, u).To(be_url.URL(
 				be_url.WithHttps(),
 				be_url.NotHavingPort(),
 				be_url.HavingHostname("example.com"),
 				be_url.HavingPath("/path/to/"),
-				be_url.HavingRawQuery(HavePrefix("foo=bar_123&v=1")),
-				be_url.HavingSearchParam("foo", ContainSubstring("bar_")),
+				be_url.HavingRawQuery(be_string.HavingPrefix("foo=bar_123&v=1")),
+				be_url.HavingSearchParam("foo", be.ContainSubstring("bar_")),
 				be_url.HavingSearchParam("v", be_reflected.AsNumericString()),
 				be_url.HavingSearchParam("payload", be_json.Matcher()),
 			))
 		})
 
-		It("should simply match separate url args from *url.URL", func() {
+		t.Run("should simply match separate url args from *url.URL", func(t *testing.T) {
 			u, err := url.Parse("https://example.com/?foo=bar&v=1")
-			Expect(err).Should(Succeed())
+			be.Expect(t, err).To(be.Succeed())
 
-			Expect(u).To(be_url.HavingSearchParam("foo"))
+			be.Expect(t, u).To(be_url.HavingSearchParam("foo"))
 		})
 
-		It("should ensure a given url is a valid *urlURL", func() {
-			Expect("http://example.com/foo/bar").To(be_url.URL(
+		t.Run("should ensure a given url is a valid *urlURL", func(t *testing.T) {
+			be.Expect(t, "http://example.com/foo/bar").To(be_url.URL(
 				be_url.TransformUrlFromString,
 				be_url.HavingHostname("example.com"),
 				be_url.NotHavingPort(),
 				be_url.HavingPath("/foo/bar"),
-				Not(be_url.HavingRawQuery()),
+				be.Not(be_url.HavingRawQuery()),
 			))
 		})
 
-		It("should match url parts even without a scheme given", func() {
-			Expect("example.com/foo/bar?v=1").To(be_url.URL(
+		t.Run("should match url parts even without a scheme given", func(t *testing.T) {
+			be.Expect(t, "example.com/foo/bar?v=1").To(be_url.URL(
 				be_url.TransformSchemelessUrlFromString,
 				be_url.HavingHostname("example.com"),
 				be_url.NotHavingPort(),
@@ -60,16 +61,10 @@ var _ = Describe("Examples on matching URL", func() {
 		})
 	})
 
-	Context("*url.URL being used as an argument: via gomock", func() {
-		var ctrl *gomock.Controller
-		BeforeEach(func() {
-			ctrl = gomock.NewController(GinkgoT())
-		})
-		AfterEach(func() {
-			ctrl.Finish()
-		})
+	t.Run("*url.URL being used as an argument: via gomock", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
 
-		It("should match given url to the urler", func() {
+		t.Run("should match given url to the urler", func(t *testing.T) {
 			urler := mocks.NewMockUrler(ctrl)
 			urler.EXPECT().SetUrl(
 				be_url.URL(
@@ -83,4 +78,4 @@ var _ = Describe("Examples on matching URL", func() {
 			urler.SetUrl(theUrl)
 		})
 	})
-})
+}
@@ -1,13 +0,0 @@
-package examples_test
-
-import (
-	"testing"
-
-	. "github.com/onsi/ginkgo/v2"
-	. "github.com/onsi/gomega"
-)
-
-func TestExamples(t *testing.T) {
-	RegisterFailHandler(Fail)
-	RunSpecs(t, "Examples Suite")
-}
@@ -6,14 +6,16 @@ package be
 //
 //	be.Expect(t, actual).To(be.GreaterThan(3))   // "expect ... to be greater than 3"
 //
-// The matcher core stays gomega-backed internally; only the failure message is
-// reshaped to read natively (see internal/beformat).
+// Failure messages keep gomega's layout (so wrapped gomega matchers read the same)
+// and are reshaped to read natively (see internal/beformat).
 
 import (
 	"fmt"
+	"reflect"
 
 	"github.com/expectto/be/internal/beformat"
 	"github.com/expectto/be/internal/psi"
+	"github.com/expectto/be/types"
 )
 
 // TestingT is the minimal subset of *testing.T the native driver needs.
@@ -27,9 +29,11 @@ type TestingT interface {
 
 // Expectation is a TestingT-bound assertion produced by Expect or Require.
 type Expectation struct {
-	t      TestingT
-	actual any
-	fatal  bool
+	t        TestingT
+	actual   any
+	extra    []any // trailing return values given to ExpectValues / RequireValues
+	fatal    bool
+	reporter Reporter
 }
 
 // Expect begins a soft assertion: a failure is reported via Errorf and the test
@@ -44,6 +48,33 @@ func Require(t TestingT, actual any) *Expectation {
 	return &Expectation{t: t, actual: actual, fatal: true}
 }
 
+// ExpectValues begins a soft assertion on the values returned by a call, the way
+// gomega's Expect(f()) does: the first one is actual, the trailing ones must be ok —
+// a nil error, a true bool (comma-ok), any other value zero. Go doesn't allow t
+// next to a multi-value call, hence the second call taking the values:
+//
+//	be.ExpectValues(t)(strconv.Atoi("42")).To(be.Eq(42))
+//	be.ExpectValues(t)(cache.Get("alice")).To(be.HaveField("Age", 30))
+//
+// It replaces `v, err := f()` + be.NoError(t, err) + be.Expect(t, v).
+// A trailing value that's not ok fails the assertion (showing the error text)
+// and the matcher is not applied.
+func ExpectValues(t TestingT) func(actual any, extra ...any) *Expectation {
+	return func(actual any, extra ...any) *Expectation {
+		return &Expectation{t: t, actual: actual, extra: extra}
+	}
+}
+
+// RequireValues is the hard (require-style) ExpectValues: a trailing value that's
+// not ok, or the failure of the matcher, stops the test via Fatalf:
+//
+//	be.RequireValues(t)(os.ReadFile(path)).To(be.NotEmpty())
+func RequireValues(t TestingT) func(actual any, extra ...any) *Expectation {
+	return func(actual any, extra ...any) *Expectation {
+		return &Expectation{t: t, actual: actual, extra: extra, fatal: true}
+	}
+}
+
 // AssertThat is the flat, testify-style spelling of Expect(t, actual).To(matcher):
 // a soft assertion that reports via Errorf and lets the test continue. It is the
 // drop-in for testify's assert when you want a be matcher:
@@ -67,19 +98,27 @@ func RequireThat(t TestingT, actual, matcher any, msgAndArgs ...any) bool {
 	return Require(t, actual).To(matcher, msgAndArgs...)
 }
 
+// WithReporter makes the expectation report its failure via the given reporter
+// instead of the global one (see SetReporter):
+//
+//	be.Expect(t, got).WithReporter(colorReporter).To(be.Eq(want))
+func (e *Expectation) WithReporter(r Reporter) *Expectation {
+	e.reporter = r
+	return e
+}
+
 // To asserts that actual satisfies the matcher. The matcher may be a be/gomega/
 // gomock matcher or a raw value (wrapped via Psi, like the rest of be). An
 // optional message — a format string plus args, or plain values — is prepended to
 // the failure output for context. Returns true on success.
 func (e *Expectation) To(matcher any, msgAndArgs ...any) bool {
 	e.t.Helper()
-	m := psi.Psi(matcher)
-	ok, err := m.Match(e.actual)
-	if err != nil {
-		return e.fail(err.Error(), msgAndArgs...)
+	if f, ok := e.extraFailure(); ok {
+		return e.fail(f, msgAndArgs...)
 	}
-	if !ok {
-		return e.fail(beformat.Compact(m.FailureMessage(e.actual)), msgAndArgs...)
+	m := psi.Psi(matcher)
+	if o := psi.Explain(m, e.actual); !o.Success {
+		return e.fail(matcherFailure(m, o, false), msgAndArgs...)
 	}
 	return true
 }
@@ -88,13 +127,12 @@ func (e *Expectation) To(matcher any, msgAndArgs ...any) bool {
 // provides failure context (see To).
 func (e *Expectation) NotTo(matcher any, msgAndArgs ...any) bool {
 	e.t.Helper()
-	m := psi.Psi(matcher)
-	ok, err := m.Match(e.actual)
-	if err != nil {
-		return e.fail(err.Error(), msgAndArgs...)
+	if f, ok := e.extraFailure(); ok {
+		return e.fail(f, msgAndArgs...)
 	}
-	if ok {
-		return e.fail(beformat.Compact(m.NegatedFailureMessage(e.actual)), msgAndArgs...)
+	m := psi.Psi(matcher)
+	if o := psi.Explain(m, e.actual); o.Success || o.Err != nil {
+		return e.fail(matcherFailure(m, o, true), msgAndArgs...)
 	}
 	return true
 }
@@ -105,19 +143,83 @@ func (e *Expectation) ToNot(matcher any, msgAndArgs ...any) bool {
 	return e.NotTo(matcher, msgAndArgs...)
 }
 
-func (e *Expectation) fail(msg string, msgAndArgs ...any) bool {
+// extraFailure checks the trailing return values (see ExpectValues):
+// the first one that's not ok is the failure
+func (e *Expectation) extraFailure() (Failure, bool) {
+	for i, v := range e.extra {
+		rv := reflect.ValueOf(v)
+		if !rv.IsValid() || (isNillable(rv.Type()) && rv.IsNil()) {
+			continue
+		}
+		switch x := v.(type) {
+		case error:
+			return Failure{Message: "Expected no error, got: " + x.Error(), Err: x}, true
+		case bool:
+			if !x {
+				return Failure{Message: fmt.Sprintf("Expected the returned bool (value [%d]) to be true, got false", i+1)}, true
+			}
+		default:
+			if !rv.IsZero() {
+				return Failure{Message: fmt.Sprintf("Expected the returned value [%d] to be zero, got %s", i+1, beformat.Value(v))}, true
+			}
+		}
+	}
+	return Failure{}, false
+}
+
+// fail reports the failure via the expectation's reporter (or the global one),
+// filling in what the expectation knows: actual, context, caller, fatality.
+func (e *Expectation) fail(f Failure, msgAndArgs ...any) bool {
 	e.t.Helper()
-	if ctx := formatMsgAndArgs(msgAndArgs...); ctx != "" {
-		msg = ctx + ": " + msg
+	if f.Actual == nil {
+		f.Actual = e.actual
 	}
-	if e.fatal {
-		e.t.Fatalf("%s", msg)
-	} else {
-		e.t.Errorf("%s", msg)
+	f.Context = formatMsgAndArgs(msgAndArgs...)
+	f.Fatal = e.fatal
+	f.File, f.Line = caller()
+
+	r := e.reporter
+	if r == nil {
+		r = currentReporter()
 	}
+	r.Report(e.t, f)
 	return false
 }
 
+// matcherFailure describes the failed outcome of a matcher: the message is
+// rendered natively (compacted, see beformat.Compact) and the failure path of
+// a nested matcher (if any) is split off the message.
+func matcherFailure(m types.BeMatcher, o types.Outcome, negated bool) Failure {
+	f := Failure{Matcher: describeMatcher(m), Negated: negated, Err: o.Err}
+	if o.Err != nil {
+		f.Message = o.Err.Error()
+		return f
+	}
+	path, rest := psi.SplitPath(o.Message)
+	f.Path, f.Message = path, beformat.Compact(rest)
+	return f
+}
+
+// describeMatcher returns the gomock description of the matcher. Descriptions
+// of some (e.g. wrapped gomega) matchers are derived from their failure
+// messages, a matcher that can't describe itself is described by its type.
+func describeMatcher(m types.BeMatcher) (description string) {
+	defer func() {
+		if recover() != nil {
+			description = fmt.Sprintf("%T", m)
+		}
+	}()
+	return m.String()
+}
+
+// describeFailure renders a matcher failure message natively: compacted (see
+// beformat.Compact), with the failure path of a nested matcher (if any) put in
+// front, e.g. `at request.body.json["details"][1]: Expected 3 to be > 5`.
+func describeFailure(msg string) string {
+	path, rest := psi.SplitPath(msg)
+	return Failure{Path: path, Message: beformat.Compact(rest)}.String()
+}
+
 // formatMsgAndArgs renders an optional assertion message: a leading format string
 // is applied to the remaining args (testify-style), otherwise the values are
 // concatenated.
@@ -3,7 +3,7 @@ package be
 // expect_async.go provides native async assertions: Eventually and Consistently.
 // They are a be-native poll loop against TestingT — NOT a wrapper around
 // gomega's Eventually — so failures go through the same path as Expectation.To
-// and keep the compact, framework-native message format (see internal/beformat).
+// and keep the compact, framework-native message format (see describeFailure).
 
 import (
 	"context"
@@ -11,7 +11,6 @@ import (
 	"reflect"
 	"time"
 
-	"github.com/expectto/be/internal/beformat"
 	"github.com/expectto/be/internal/psi"
 )
 
@@ -55,6 +54,10 @@ func WithContext(ctx context.Context) EventuallyOption {
 //   - func() T — polled each interval,
 //   - func() (T, error) — a returned error means "not ready yet"; polling continues.
 //
+// A channel is polled by the channel matchers (be.Receive, be.ReceiveAll,
+// be.ReceiveInOrder) without losing values between polls: what was received
+// by the previous polls is kept (also when they are nested in be.All, be.Any or be.Not).
+//
 // Example:
 //
 //	be.Eventually(t, queue.Len, be.Gte(3))
@@ -69,34 +72,35 @@ func Eventually(t TestingT, actual, matcher any, opts ...EventuallyOption) bool
 
 	poll, err := asPoller(actual)
 	if err != nil {
-		return e.fail(err.Error())
+		return e.fail(Failure{Message: err.Error()})
 	}
 
-	m := psi.Psi(matcher)
+	m := psi.PollSession(psi.Psi(matcher))
 	deadline := time.After(cfg.timeout)
 	var ctxDone <-chan struct{}
 	if cfg.ctx != nil {
 		ctxDone = cfg.ctx.Done()
 	}
 
-	var lastMsg string
+	var last Failure // the last mismatch
 	for {
 		v, pollErr := poll()
 		if pollErr != nil {
-			lastMsg = fmt.Sprintf("polled function returned error: %v", pollErr)
-		} else if ok, merr := m.Match(v); merr != nil {
-			lastMsg = merr.Error()
-		} else if ok {
+			last = Failure{Message: fmt.Sprintf("polled function returned error: %v", pollErr)}
+		} else if o := psi.Explain(m, v); o.Success {
 			return true
 		} else {
-			lastMsg = beformat.Compact(m.FailureMessage(v))
+			last = matcherFailure(m, o, false)
+			last.Actual = v
 		}
 
 		select {
 		case <-ctxDone:
-			return e.fail("context done while waiting: " + lastMsg)
+			last.Message = "context done while waiting: " + last.Message
+			return e.fail(last)
 		case <-deadline:
-			return e.fail(fmt.Sprintf("timed out after %s: %s", cfg.timeout, lastMsg))
+			last.Message = fmt.Sprintf("timed out after %s: %s", cfg.timeout, last.Message)
+			return e.fail(last)
 		case <-time.After(cfg.polling):
 		}
 	}
@@ -115,10 +119,10 @@ func Consistently(t TestingT, actual, matcher any, opts ...EventuallyOption) boo
 
 	poll, err := asPoller(actual)
 	if err != nil {
-		return e.fail(err.Error())
+		return e.fail(Failure{Message: err.Error()})
 	}
 
-	m := psi.Psi(matcher)
+	m := psi.PollSession(psi.Psi(matcher))
 	deadline := time.After(cfg.timeout)
 	var ctxDone <-chan struct{}
 	if cfg.ctx != nil {
@@ -128,19 +132,17 @@ func Consistently(t TestingT, actual, matcher any, opts ...EventuallyOption) boo
 	for {
 		v, pollErr := poll()
 		if pollErr != nil {
-			return e.fail(fmt.Sprintf("polled function returned error: %v", pollErr))
-		}
-		ok, merr := m.Match(v)
-		if merr != nil {
-			return e.fail(merr.Error())
+			return e.fail(Failure{Message: fmt.Sprintf("polled function returned error: %v", pollErr)})
 		}
-		if !ok {
-			return e.fail(beformat.Compact(m.FailureMessage(v)))
+		if o := psi.Explain(m, v); !o.Success {
+			f := matcherFailure(m, o, false)
+			f.Actual = v
+			return e.fail(f)
 		}
 
 		select {
 		case <-ctxDone:
-			return e.fail("context done before the consistency window elapsed")
+			return e.fail(Failure{Message: "context done before the consistency window elapsed"})
 		case <-deadline:
 			return true
 		case <-time.After(cfg.polling):
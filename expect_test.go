@@ -2,24 +2,45 @@ package be_test
 
 import (
 	"fmt"
+	"net/http"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/expectto/be"
+	"github.com/expectto/be/be_http"
+	"github.com/expectto/be/be_json"
 	"github.com/expectto/be/be_math"
 )
 
 // recT is a fake be.TestingT that records calls instead of failing a real test,
 // so we can assert how Expect/Require react to passing and failing matches.
+// Like *testing.T, it may be called from several goroutines.
 type recT struct {
+	mu      sync.Mutex
 	helpers int
 	errs    []string
 	fatals  []string
 }
 
-func (r *recT) Helper()                   { r.helpers++ }
-func (r *recT) Errorf(f string, a ...any) { r.errs = append(r.errs, fmt.Sprintf(f, a...)) }
-func (r *recT) Fatalf(f string, a ...any) { r.fatals = append(r.fatals, fmt.Sprintf(f, a...)) }
+func (r *recT) Helper() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.helpers++
+}
+
+func (r *recT) Errorf(f string, a ...any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.errs = append(r.errs, fmt.Sprintf(f, a...))
+}
+
+func (r *recT) Fatalf(f string, a ...any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.fatals = append(r.fatals, fmt.Sprintf(f, a...))
+}
 
 // The whole point of the native driver: the stdlib *testing.T satisfies it.
 var _ be.TestingT = (*testing.T)(nil)
@@ -103,6 +124,50 @@ func TestExpectMarksHelper(t *testing.T) {
 	}
 }
 
+func TestExpectValues(t *testing.T) {
+	lookup := func(key string) (int, bool) {
+		m := map[string]int{"a": 1}
+		v, ok := m[key]
+		return v, ok
+	}
+	var noErr error
+
+	be.ExpectValues(t)(strconv.Atoi("42")).To(be.Eq(42))
+	be.ExpectValues(t)(lookup("a")).To(be.Eq(1))
+	be.ExpectValues(t)(5, noErr, 0, nil).To(be.Eq(5))
+	be.RequireValues(t)(strconv.Atoi("7")).NotTo(be.Eq(42))
+
+	// a non-nil error fails with its text, the matcher isn't applied
+	rt := &recT{}
+	ok := be.ExpectValues(rt)(strconv.Atoi("x")).To(be.Eq(0))
+	if ok || len(rt.errs) != 1 || len(rt.fatals) != 0 {
+		t.Fatalf("expected a soft failure, got errs=%v fatals=%v", rt.errs, rt.fatals)
+	}
+	if !strings.Contains(rt.errs[0], `Expected no error, got: strconv.Atoi: parsing "x": invalid syntax`) {
+		t.Fatalf("unexpected failure message: %q", rt.errs[0])
+	}
+
+	// so does a false comma-ok, even for NotTo
+	rt = &recT{}
+	be.ExpectValues(rt)(lookup("b")).NotTo(be.Eq(1))
+	if len(rt.errs) != 1 || !strings.Contains(rt.errs[0], "Expected the returned bool (value [1]) to be true, got false") {
+		t.Fatalf("unexpected failure: %v", rt.errs)
+	}
+
+	// RequireValues stops the test
+	rt = &recT{}
+	be.RequireValues(rt)(strconv.Atoi("x")).To(be.Eq(0))
+	if len(rt.fatals) != 1 || len(rt.errs) != 0 {
+		t.Fatalf("expected a fatal failure, got errs=%v fatals=%v", rt.errs, rt.fatals)
+	}
+
+	rt = &recT{}
+	be.ExpectValues(rt)(1, "leftover").To(be.Eq(1))
+	if len(rt.errs) != 1 || !strings.Contains(rt.errs[0], `Expected the returned value [1] to be zero, got "leftover"`) {
+		t.Fatalf("unexpected failure: %v", rt.errs)
+	}
+}
+
 func TestAssertThatSoft(t *testing.T) {
 	// Passing match reports nothing.
 	rt := &recT{}
@@ -141,3 +206,23 @@ func TestAssertThatMessageContext(t *testing.T) {
 		t.Fatalf("message context should be prepended, got: %v", rt.errs)
 	}
 }
+
+func TestExpectReportsFailurePath(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodPost, "https://example.com", strings.NewReader(
+		`{"details": [{"key": "a"}, {"key": "b"}]}`,
+	))
+
+	rt := &recT{}
+	be.Expect(rt, req).To(be_http.Request(
+		be_http.HavingBody(be.JSON(
+			be_json.HaveKeyValue("details", be.Dive(be_json.HaveKeyValue("key", "a"))),
+		)),
+	))
+	if len(rt.errs) != 1 {
+		t.Fatalf("expected one failure, got %v", rt.errs)
+	}
+	want := `at request.body.json["details"][1]["key"]: Expected b to equal a`
+	if rt.errs[0] != want {
+		t.Fatalf("failure should carry the path to the mismatch\nwant: %q\n got: %q", want, rt.errs[0])
+	}
+}
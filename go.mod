@@ -6,26 +6,7 @@ require (
 	github.com/IGLOU-EU/go-wildcard v1.0.3 // latest
 	github.com/amberpixels/k1 v0.2.3 // latest
 	github.com/golang-jwt/jwt/v5 v5.3.1 // latest
-	github.com/onsi/ginkgo/v2 v2.32.0 // latest
-	github.com/onsi/gomega v1.42.1 // latest
+	github.com/santhosh-tekuri/jsonschema/v6 v6.0.2 // latest
 	go.uber.org/mock v0.6.0 // latest
 	golang.org/x/text v0.40.0 // latest
 )
-
-require (
-	github.com/Masterminds/semver/v3 v3.5.0 // indirect
-	github.com/go-logr/logr v1.4.3 // indirect
-	github.com/go-task/slim-sprig/v3 v3.0.0 // indirect
-	github.com/google/go-cmp v0.7.0 // indirect
-	github.com/google/pprof v0.0.0-20260402051712-545e8a4df936 // indirect
-	github.com/rogpeppe/go-internal v1.14.1 // indirect
-	github.com/stretchr/testify v1.11.1 // indirect
-	go.yaml.in/yaml/v3 v3.0.4 // indirect
-	golang.org/x/mod v0.38.0 // indirect
-	golang.org/x/net v0.57.0 // indirect
-	golang.org/x/sync v0.22.0 // indirect
-	golang.org/x/sys v0.47.0 // indirect
-	golang.org/x/tools v0.48.0 // indirect
-	google.golang.org/protobuf v1.36.10 // indirect
-	gopkg.in/check.v1 v1.0.0-20201130134442-10cb98267c6c // indirect
-)
@@ -0,0 +1,131 @@
+package be
+
+// group.go provides soft assertion groups: every assertion made within a group
+// is collected instead of being reported on its own, and the group reports all
+// of them at once as a single numbered failure. Handy when a test checks many
+// fields of one response: you see every mismatch in one run, not one per run.
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// G collects the failures of the assertions made within a Group. It satisfies
+// TestingT, so any assertion (be.Expect, be.AssertThat, be.NoError,
+// be.Eventually, ...) can report into it:
+//
+//	be.Group(t, func(g *be.G) {
+//		g.Expect(resp.Status).To(be.Eq("active"))
+//		be.AssertThat(g, resp.Items, be.NotEmpty())
+//	})
+//
+// Assertions may be made through g from several goroutines (e.g. within
+// be.Eventually or a worker pool) as long as they finish before the group does.
+type G struct {
+	t TestingT
+
+	mu       sync.Mutex
+	failures []string
+}
+
+// groupAborted is the panic value used to stop a group after a hard failure
+type groupAborted struct{}
+
+// Expect begins a soft assertion within the group (see be.Expect).
+func (g *G) Expect(actual any) *Expectation {
+	return Expect(g, actual)
+}
+
+// Require begins a hard assertion within the group: the failure is collected
+// and the rest of the group function is skipped.
+func (g *G) Require(actual any) *Expectation {
+	return Require(g, actual)
+}
+
+// Helper marks the calling function as a test helper of the underlying TestingT.
+func (g *G) Helper() { g.t.Helper() }
+
+// Errorf collects a failure, the group continues.
+func (g *G) Errorf(format string, args ...any) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.failures = append(g.failures, fmt.Sprintf(format, args...))
+}
+
+// Fatalf collects a failure and stops the group function.
+// The collected failures are still reported by the group.
+func (g *G) Fatalf(format string, args ...any) {
+	g.Errorf(format, args...)
+	panic(groupAborted{})
+}
+
+// Group runs fn, collecting the failures of every assertion made through the
+// given *G, and reports them all at once as a single soft failure (Errorf):
+//
+//	be.Group(t, func(g *be.G) {
+//		g.Expect(user.Name).To(be.Eq("Alice"))
+//		g.Expect(user.Age).To(be.Gte(18))
+//	})
+//
+// reports
+//
+//	2 assertions failed:
+//	  1) Expected Bob to equal Alice
+//	  2) Expected 16 to be >= 18
+//
+// Returns true if no assertion failed.
+func Group(t TestingT, fn func(g *G), msgAndArgs ...any) bool {
+	t.Helper()
+	return runGroup(&Expectation{t: t}, fn, msgAndArgs...)
+}
+
+// RequireGroup is the hard spelling of Group: all failures are still collected
+// and reported together, but the test is stopped (Fatalf) once, at the end.
+func RequireGroup(t TestingT, fn func(g *G), msgAndArgs ...any) bool {
+	t.Helper()
+	return runGroup(&Expectation{t: t, fatal: true}, fn, msgAndArgs...)
+}
+
+func runGroup(e *Expectation, fn func(g *G), msgAndArgs ...any) bool {
+	e.t.Helper()
+
+	g := &G{t: e.t}
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				if _, ok := r.(groupAborted); !ok {
+					panic(r)
+				}
+			}
+		}()
+		fn(g)
+	}()
+
+	g.mu.Lock()
+	failures := g.failures
+	g.mu.Unlock()
+
+	if len(failures) == 0 {
+		return true
+	}
+	return e.fail(Failure{Message: groupReport(failures)}, msgAndArgs...)
+}
+
+// groupReport renders the collected failures as a numbered list.
+// Multi-line failures are indented under their number.
+func groupReport(failures []string) string {
+	var sb strings.Builder
+	if len(failures) == 1 {
+		sb.WriteString("1 assertion failed:")
+	} else {
+		fmt.Fprintf(&sb, "%d assertions failed:", len(failures))
+	}
+
+	for i, failure := range failures {
+		prefix := fmt.Sprintf("  %d) ", i+1)
+		indent := strings.Repeat(" ", len(prefix))
+		sb.WriteString("\n" + prefix + strings.ReplaceAll(failure, "\n", "\n"+indent))
+	}
+	return sb.String()
+}
@@ -0,0 +1,98 @@
+package be_test
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/expectto/be"
+)
+
+func TestGroupPasses(t *testing.T) {
+	rt := &recT{}
+	ok := be.Group(rt, func(g *be.G) {
+		g.Expect(5).To(be.Gt(3))
+		be.AssertThat(g, "hello", be.ContainSubstring("ell"))
+	})
+	if !ok || len(rt.errs) != 0 || len(rt.fatals) != 0 {
+		t.Fatalf("a passing group must not report: ok=%v errs=%v fatals=%v", ok, rt.errs, rt.fatals)
+	}
+}
+
+func TestGroupReportsAllFailuresAtOnce(t *testing.T) {
+	rt := &recT{}
+	ok := be.Group(rt, func(g *be.G) {
+		g.Expect(3).To(be.Gt(5))
+		g.Expect(5).To(be.Gt(3))
+		g.Expect("Bob").To(be.Eq("Alice"))
+	})
+	if ok {
+		t.Fatalf("expected failure")
+	}
+	if len(rt.fatals) != 0 {
+		t.Fatalf("Group must be soft (Errorf); got fatals=%v", rt.fatals)
+	}
+	if len(rt.errs) != 1 {
+		t.Fatalf("expected exactly one consolidated Errorf, got %v", rt.errs)
+	}
+	want := "2 assertions failed:\n" +
+		"  1) Expected 3 to be > 5\n" +
+		"  2) Expected Bob to equal Alice"
+	if rt.errs[0] != want {
+		t.Fatalf("unexpected report\nwant: %q\n got: %q", want, rt.errs[0])
+	}
+}
+
+func TestRequireGroupFailsHardOnceAtTheEnd(t *testing.T) {
+	rt := &recT{}
+	be.RequireGroup(rt, func(g *be.G) {
+		g.Expect(3).To(be.Gt(5))
+		g.Expect(3).To(be.Gt(4))
+	}, "user %d", 7)
+	if len(rt.errs) != 0 || len(rt.fatals) != 1 {
+		t.Fatalf("RequireGroup must fail via a single Fatalf; got fatals=%v errs=%v", rt.fatals, rt.errs)
+	}
+	if !strings.HasPrefix(rt.fatals[0], "user 7: 2 assertions failed:") {
+		t.Fatalf("message context should be prepended, got: %q", rt.fatals[0])
+	}
+}
+
+func TestGroupRequireStopsTheGroup(t *testing.T) {
+	rt := &recT{}
+	reached := false
+	be.Group(rt, func(g *be.G) {
+		be.NoError(g, errors.New("boom"))
+		reached = true
+	})
+	if reached {
+		t.Fatalf("a hard assertion inside the group must stop the group function")
+	}
+	if len(rt.errs) != 1 || !strings.HasPrefix(rt.errs[0], "1 assertion failed:") {
+		t.Fatalf("the hard failure must still be reported by the group, got %v", rt.errs)
+	}
+}
+
+func TestGroupIndentsMultilineFailures(t *testing.T) {
+	rt := &recT{}
+	be.Group(rt, func(g *be.G) {
+		g.Errorf("first line\nsecond line")
+	})
+	if len(rt.errs) != 1 || !strings.Contains(rt.errs[0], "  1) first line\n     second line") {
+		t.Fatalf("multi-line failures should be indented under their number, got %q", rt.errs)
+	}
+}
+
+func TestGroupCollectsFailuresFromGoroutines(t *testing.T) {
+	rt := &recT{}
+	be.Group(rt, func(g *be.G) {
+		var wg sync.WaitGroup
+		for i := range 10 {
+			wg.Go(func() { g.Expect(i).To(be.Gt(100)) })
+		}
+		wg.Wait()
+	})
+	if len(rt.errs) != 1 || !strings.HasPrefix(rt.errs[0], "10 assertions failed:") {
+		t.Fatalf("every failure should be collected, got %q", rt.errs)
+	}
+}
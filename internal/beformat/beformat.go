@@ -18,6 +18,9 @@ var (
 	// vertical matches a line break plus its surrounding indentation, which gomega
 	// uses to lay failure messages out vertically.
 	vertical = regexp.MustCompile(`[ \t]*\n[ \t]*`)
+	// listing matches a line indented by two spaces: an item of a listing
+	// (e.g. per-element failures) or of a field diff
+	listing = regexp.MustCompile(`\n  [^ \t]`)
 )
 
 // maxCompactLen bounds how long a collapsed one-liner may be. Beyond it the
@@ -36,8 +39,12 @@ const maxCompactLen = 120
 //
 // It only collapses scalar-ish messages: if the result would be long or contains
 // composite values (maps/structs render with braces), the original multi-line,
-// diff-friendly gomega formatting is preserved instead.
+// diff-friendly gomega formatting is preserved instead. Field diffs (see DiffMessage)
+// and listings (lines indented by two spaces) are kept as they are.
 func Compact(msg string) string {
+	if strings.Contains(msg, diffLegend) || listing.MatchString(msg) {
+		return strings.TrimRight(msg, "\n")
+	}
 	oneLine := strings.TrimSpace(vertical.ReplaceAllString(typeTag.ReplaceAllString(msg, ""), " "))
 	if len(oneLine) <= maxCompactLen && !strings.ContainsAny(oneLine, "{}") {
 		return oneLine
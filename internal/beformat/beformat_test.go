@@ -1,6 +1,8 @@
 package beformat
 
 import (
+	"fmt"
+	"slices"
 	"strings"
 	"testing"
 )
@@ -43,3 +45,194 @@ func TestCompactPreservesComposite(t *testing.T) {
 		t.Errorf("composite message should stay multi-line, got: %q", got)
 	}
 }
+
+func TestUnifiedDiff(t *testing.T) {
+	from := "{\n  \"name\": \"Alice\",\n  \"age\": 30\n}\n"
+	to := "{\n  \"name\": \"Bob\",\n  \"age\": 30\n}\n"
+
+	want := "--- snapshot\n+++ actual\n" +
+		"@@ -1,4 +1,4 @@\n" +
+		" {\n" +
+		"-  \"name\": \"Alice\",\n" +
+		"+  \"name\": \"Bob\",\n" +
+		"   \"age\": 30\n" +
+		" }"
+	if got := UnifiedDiff("snapshot", "actual", from, to); got != want {
+		t.Errorf("unexpected diff\nwant:\n%s\n got:\n%s", want, got)
+	}
+
+	if got := UnifiedDiff("snapshot", "actual", from, from); got != "" {
+		t.Errorf("equal texts must produce no diff, got %q", got)
+	}
+}
+
+func TestUnifiedDiffSplitsDistantChanges(t *testing.T) {
+	var from, to []string
+	for i := range 20 {
+		from = append(from, fmt.Sprintf("line %d", i))
+		to = append(to, fmt.Sprintf("line %d", i))
+	}
+	to[1], to[18] = "changed 1", "changed 18"
+
+	got := UnifiedDiff("a", "b", strings.Join(from, "\n"), strings.Join(to, "\n"))
+	if n := strings.Count(got, "@@ -"); n != 2 {
+		t.Errorf("distant changes should produce 2 hunks, got %d:\n%s", n, got)
+	}
+	if !strings.Contains(got, "@@ -1,5 +1,5 @@") || !strings.Contains(got, "@@ -16,5 +16,5 @@") {
+		t.Errorf("unexpected hunk headers:\n%s", got)
+	}
+}
+
+func TestFieldDiff(t *testing.T) {
+	type address struct{ City string }
+	type user struct {
+		Name    string
+		Address *address
+		Roles   map[string]int
+		Tags    []string
+	}
+	expected := user{Name: "Alice", Address: &address{City: "Berlin"}, Roles: map[string]int{"admin": 1, "dev": 2}, Tags: []string{"a", "b"}}
+	actual := user{Name: "Alice", Address: &address{City: "Bonn"}, Roles: map[string]int{"dev": 3, "qa": 1}, Tags: []string{"a"}}
+
+	want := []string{
+		`- .Address.City: "Berlin"`,
+		`+ .Address.City: "Bonn"`,
+		`- .Roles["admin"]: 1`,
+		`- .Roles["dev"]: 2`,
+		`+ .Roles["dev"]: 3`,
+		`+ .Roles["qa"]: 1`,
+		`- .Tags[1]: "b"`,
+	}
+	if got := FieldDiff(expected, actual, ""); !slices.Equal(got, want) {
+		t.Errorf("unexpected diff\nwant:\n%s\n got:\n%s", strings.Join(want, "\n"), strings.Join(got, "\n"))
+	}
+
+	if got := FieldDiff(expected, expected, ""); len(got) != 0 {
+		t.Errorf("equal values must produce no diff, got %q", got)
+	}
+}
+
+func TestFieldDiffCyclic(t *testing.T) {
+	type node struct {
+		Name string
+		Next *node
+	}
+	ring := func(names ...string) *node {
+		first := &node{Name: names[0]}
+		last := first
+		for _, name := range names[1:] {
+			last.Next = &node{Name: name}
+			last = last.Next
+		}
+		last.Next = first
+		return first
+	}
+
+	got := FieldDiff(ring("a", "b"), ring("a", "c"), "")
+	want := []string{`- .Next.Name: "b"`, `+ .Next.Name: "c"`}
+	if !slices.Equal(got, want) {
+		t.Errorf("want %q, got %q", want, got)
+	}
+
+	if got := FieldDiff(ring("a", "b"), ring("a", "b"), ""); len(got) != 0 {
+		t.Errorf("equal cyclic values must produce no diff, got %q", got)
+	}
+}
+
+func TestFieldDiffDepthLimit(t *testing.T) {
+	type node struct {
+		Name string
+		Next *node
+	}
+	chain := func(last string) *node {
+		n := &node{Name: last}
+		for range 2 * maxDiffDepth {
+			n = &node{Name: "n", Next: n}
+		}
+		return n
+	}
+
+	got := FieldDiff(chain("a"), chain("b"), "")
+	if len(got) != 2 || !strings.HasPrefix(got[0], "- "+strings.Repeat(".Next", maxDiffDepth/2)) {
+		t.Errorf("want the values below the depth limit changed as a whole, got %q", got)
+	}
+}
+
+func TestFieldDiffAnnotatesTypes(t *testing.T) {
+	// JSON-ish values that render the same but differ in type
+	got := FieldDiff(map[string]any{"n": 1}, map[string]any{"n": 1.0}, "")
+	want := []string{`- ["n"]: 1 (int)`, `+ ["n"]: 1 (float64)`}
+	if !slices.Equal(got, want) {
+		t.Errorf("want %q, got %q", want, got)
+	}
+}
+
+func TestDiffable(t *testing.T) {
+	type T struct{ A int }
+	for _, tc := range []struct {
+		expected, actual any
+		want             bool
+	}{
+		{T{1}, T{2}, true},
+		{&T{1}, &T{2}, true},
+		{map[string]int{}, map[string]int{}, true},
+		{[]int{1}, []int{2}, true},
+		{1, 2, false},
+		{"a", "b", false},
+		{[]byte("a"), []byte("b"), false},
+		{T{1}, &T{1}, false},
+		{(*T)(nil), &T{1}, false},
+	} {
+		if got := Diffable(tc.expected, tc.actual); got != tc.want {
+			t.Errorf("Diffable(%#v, %#v) = %v, want %v", tc.expected, tc.actual, got, tc.want)
+		}
+	}
+}
+
+func TestCompactKeepsFieldDiff(t *testing.T) {
+	msg := DiffMessage(struct{}{}, "to equal", []string{"- .A: 1", "+ .A: 2"})
+	if got := Compact(msg); got != msg {
+		t.Errorf("field diff must not be collapsed, got %q", got)
+	}
+}
+
+func TestObject(t *testing.T) {
+	type user struct {
+		Name string
+		Tags []string
+	}
+	cases := []struct {
+		in   any
+		want string
+	}{
+		{3, "    <int>: 3"},
+		{"hi", "    <string>: hi"},
+		{nil, "    <nil>: nil"},
+		{user{Name: "Alice", Tags: []string{"a"}}, `    <beformat.user>: {Name: "Alice", Tags: ["a"]}`},
+		{map[string]int{"b": 2, "a": 1}, `    <map[string]int>: {"a": 1, "b": 2}`},
+		{[]Entry{{"b", 2}, {"a", 1}, {"b", 3}}, `    <iter.Seq2>: {"b": 2, "a": 1, "b": 3}`},
+	}
+	for _, c := range cases {
+		if got := Object(c.in, 1); got != c.want {
+			t.Errorf("Object(%#v) = %q, want %q", c.in, got, c.want)
+		}
+	}
+
+	n := 1
+	if got := Object(&n, 0); !strings.HasPrefix(got, "<*int | 0x") || !strings.HasSuffix(got, ">: 1") {
+		t.Errorf("pointer should be tagged with its address and de-referenced, got %q", got)
+	}
+}
+
+func TestMessageIsCompactable(t *testing.T) {
+	if got := Compact(Message(3, "to be >", 5)); got != "Expected 3 to be > 5" {
+		t.Errorf("Message should keep the layout Compact expects, got %q", got)
+	}
+}
+
+func TestCompactKeepsListings(t *testing.T) {
+	in := "Expected\n    <[]int>: [1, 2]\nto have an element match: be > 5, but none did:\n  [0]: Expected 1 to be > 5"
+	if got := Compact(in); got != in {
+		t.Errorf("listing should be kept as is, got %q", got)
+	}
+}
@@ -0,0 +1,148 @@
+package beformat
+
+import (
+	"fmt"
+	"strings"
+)
+
+// diffContext is the number of unchanged lines kept around each change
+const diffContext = 3
+
+// UnifiedDiff renders a line-based unified diff turning `from` into `to`,
+// e.g. for a snapshot mismatch:
+//
+//	--- snapshot
+//	+++ actual
+//	@@ -1,3 +1,3 @@
+//	 {
+//	-  "name": "Alice"
+//	+  "name": "Bob"
+//	 }
+//
+// It returns an empty string if both texts are equal.
+func UnifiedDiff(fromName, toName, from, to string) string {
+	if from == to {
+		return ""
+	}
+
+	ops := diffLines(splitLines(from), splitLines(to))
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- %s\n+++ %s", fromName, toName)
+	for _, h := range hunks(ops) {
+		sb.WriteString("\n" + h)
+	}
+	return sb.String()
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// diffOp is a single line of the diff: ' ' (kept), '-' (removed) or '+' (added)
+type diffOp struct {
+	kind   byte
+	line   string
+	fromNo int // 1-based line number in `from` (for ' ' and '-')
+	toNo   int // 1-based line number in `to` (for ' ' and '+')
+}
+
+// diffLines computes a line diff via the longest common subsequence.
+// Quadratic, which is fine for failure messages.
+func diffLines(a, b []string) []diffOp {
+	// lcs[i][j] is the LCS length of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	ops := make([]diffOp, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			ops = append(ops, diffOp{kind: ' ', line: a[i], fromNo: i + 1, toNo: j + 1})
+			i++
+			j++
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			ops = append(ops, diffOp{kind: '-', line: a[i], fromNo: i + 1, toNo: j})
+			i++
+		default:
+			ops = append(ops, diffOp{kind: '+', line: b[j], fromNo: i, toNo: j + 1})
+			j++
+		}
+	}
+	return ops
+}
+
+// hunks groups diff ops into unified-diff hunks with diffContext lines of context
+func hunks(ops []diffOp) []string {
+	var result []string
+	for start := 0; start < len(ops); {
+		// find the next change
+		first := start
+		for first < len(ops) && ops[first].kind == ' ' {
+			first++
+		}
+		if first == len(ops) {
+			break
+		}
+
+		// extend the hunk while changes are close enough to be joined
+		last := first
+		for k := first; k < len(ops); k++ {
+			if ops[k].kind != ' ' {
+				last = k
+			} else if k-last > 2*diffContext {
+				break
+			}
+		}
+
+		from := max(first-diffContext, start)
+		to := min(last+diffContext+1, len(ops))
+		result = append(result, renderHunk(ops[from:to]))
+		start = to
+	}
+	return result
+}
+
+func renderHunk(ops []diffOp) string {
+	var fromStart, fromLen, toStart, toLen int
+	var body strings.Builder
+	for _, op := range ops {
+		if op.kind != '+' {
+			if fromLen == 0 {
+				fromStart = op.fromNo
+			}
+			fromLen++
+		}
+		if op.kind != '-' {
+			if toLen == 0 {
+				toStart = op.toNo
+			}
+			toLen++
+		}
+		body.WriteString("\n" + string(op.kind) + op.line)
+	}
+
+	// an empty side is reported at the line before the hunk (unified diff convention)
+	if fromLen == 0 {
+		fromStart = ops[0].fromNo
+	}
+	if toLen == 0 {
+		toStart = ops[0].toNo
+	}
+	return fmt.Sprintf("@@ -%d,%d +%d,%d @@", fromStart, fromLen, toStart, toLen) + body.String()
+}
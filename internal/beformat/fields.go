@@ -0,0 +1,274 @@
+package beformat
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// diffLegend tells apart the two sides of a field diff.
+// Compact never collapses messages carrying it.
+const diffLegend = "(- expected, + actual):"
+
+// maxValueLen bounds a single rendered value in a field diff
+const maxValueLen = 200
+
+// maxDiffDepth bounds how deep a field diff walks into nested values:
+// deeper values are compared and rendered as a whole
+const maxDiffDepth = 64
+
+var bytesType = reflect.TypeFor[[]byte]()
+
+// Diffable reports whether expected and actual can be compared field by field:
+// both are non-nil values of the same struct, map, slice or array type
+// (or pointers to such). Scalars, strings and byte slices are not diffable.
+func Diffable(expected, actual any) bool {
+	ev, av := reflect.ValueOf(expected), reflect.ValueOf(actual)
+	if !ev.IsValid() || !av.IsValid() || ev.Type() != av.Type() || ev.Type() == bytesType {
+		return false
+	}
+	for ev.Kind() == reflect.Pointer {
+		if ev.IsNil() || av.IsNil() {
+			return false
+		}
+		ev, av = ev.Elem(), av.Elem()
+	}
+	switch ev.Kind() {
+	case reflect.Struct, reflect.Map, reflect.Slice, reflect.Array:
+		return true
+	}
+	return false
+}
+
+// FieldDiff lists the paths where actual differs from expected, each path rooted
+// at the given one. A changed value renders as a pair of lines
+// (`- .Address.City: "Berlin"` and `+ .Address.City: "Bonn"`),
+// a value present on one side only renders as a single line (`+ .Tags[2]: "new"`).
+// Structs are walked by field, maps by sorted key and slices by index.
+// Equal values produce no lines.
+func FieldDiff(expected, actual any, path string) []string {
+	var d differ
+	d.walk(path, reflect.ValueOf(expected), reflect.ValueOf(actual))
+	return d.lines
+}
+
+// DiffMessage builds a failure message out of FieldDiff lines:
+//
+//	Expected main.User to equal, differences (- expected, + actual):
+//	  - .Address.City: "Berlin"
+//	  + .Address.City: "Bonn"
+func DiffMessage(actual any, to string, lines []string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Expected %T %s, differences %s", actual, to, diffLegend)
+	for _, line := range lines {
+		b.WriteString("\n  ")
+		b.WriteString(strings.ReplaceAll(line, "\n", "\n    "))
+	}
+	return b.String()
+}
+
+type differ struct {
+	lines []string
+	depth int
+
+	// visited holds the references being walked already,
+	// so cyclic values are walked once (as reflect.DeepEqual does)
+	visited map[visit]bool
+}
+
+// visit is a pair of references (pointers, maps or slices) compared to each other
+type visit struct {
+	exp, act uintptr
+	typ      reflect.Type
+}
+
+func (d *differ) walk(path string, exp, act reflect.Value) {
+	if !exp.IsValid() || !act.IsValid() || exp.Type() != act.Type() {
+		if exp.IsValid() || act.IsValid() {
+			d.changed(path, exp, act)
+		}
+		return
+	}
+
+	if d.depth >= maxDiffDepth {
+		if !equal(exp, act) {
+			d.changed(path, exp, act)
+		}
+		return
+	}
+	if d.seen(exp, act) {
+		return
+	}
+	d.depth++
+	defer func() { d.depth-- }()
+
+	switch exp.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if exp.IsNil() || act.IsNil() {
+			if exp.IsNil() != act.IsNil() {
+				d.changed(path, exp, act)
+			}
+			return
+		}
+		if exp.Kind() == reflect.Pointer && exp.Pointer() == act.Pointer() {
+			return
+		}
+		d.walk(path, exp.Elem(), act.Elem())
+
+	case reflect.Struct:
+		t := exp.Type()
+		if opaque(t) {
+			if !equal(exp, act) {
+				d.changed(path, exp, act)
+			}
+			return
+		}
+		for i := range t.NumField() {
+			d.walk(path+"."+t.Field(i).Name, exp.Field(i), act.Field(i))
+		}
+
+	case reflect.Map:
+		if exp.IsNil() != act.IsNil() {
+			d.changed(path, exp, act)
+			return
+		}
+		for _, k := range mapKeys(exp, act) {
+			ev, av := exp.MapIndex(k), act.MapIndex(k)
+			segment := path + keySegment(k)
+			switch {
+			case !av.IsValid():
+				d.removed(segment, ev)
+			case !ev.IsValid():
+				d.added(segment, av)
+			default:
+				d.walk(segment, ev, av)
+			}
+		}
+
+	case reflect.Slice, reflect.Array:
+		if exp.Kind() == reflect.Slice && exp.IsNil() != act.IsNil() || exp.Type() == bytesType {
+			if !equal(exp, act) {
+				d.changed(path, exp, act)
+			}
+			return
+		}
+		for i := range max(exp.Len(), act.Len()) {
+			segment := path + "[" + strconv.Itoa(i) + "]"
+			switch {
+			case i >= act.Len():
+				d.removed(segment, exp.Index(i))
+			case i >= exp.Len():
+				d.added(segment, act.Index(i))
+			default:
+				d.walk(segment, exp.Index(i), act.Index(i))
+			}
+		}
+
+	default:
+		if !equal(exp, act) {
+			d.changed(path, exp, act)
+		}
+	}
+}
+
+// seen reports whether the given references were walked already, marking them otherwise
+func (d *differ) seen(exp, act reflect.Value) bool {
+	switch exp.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice:
+	default:
+		return false
+	}
+	if exp.IsNil() || act.IsNil() {
+		return false
+	}
+	v := visit{exp: exp.Pointer(), act: act.Pointer(), typ: exp.Type()}
+	if d.visited[v] {
+		return true
+	}
+	if d.visited == nil {
+		d.visited = make(map[visit]bool)
+	}
+	d.visited[v] = true
+	return false
+}
+
+func (d *differ) changed(path string, exp, act reflect.Value) {
+	e, a := render(exp), render(act)
+	if e == a && exp.IsValid() && act.IsValid() {
+		// same rendering of different types, e.g. int(1) vs float64(1)
+		e, a = fmt.Sprintf("%s (%s)", e, exp.Type()), fmt.Sprintf("%s (%s)", a, act.Type())
+	}
+	d.lines = append(d.lines, "- "+displayPath(path)+": "+e, "+ "+displayPath(path)+": "+a)
+}
+
+func (d *differ) removed(path string, exp reflect.Value) {
+	d.lines = append(d.lines, "- "+displayPath(path)+": "+render(exp))
+}
+
+func (d *differ) added(path string, act reflect.Value) {
+	d.lines = append(d.lines, "+ "+displayPath(path)+": "+render(act))
+}
+
+func displayPath(path string) string {
+	if path == "" {
+		return "."
+	}
+	return path
+}
+
+// opaque reports whether a struct type has no exported fields (e.g. time.Time),
+// such structs are compared and rendered as a whole
+func opaque(t reflect.Type) bool {
+	for i := range t.NumField() {
+		if t.Field(i).IsExported() {
+			return false
+		}
+	}
+	return true
+}
+
+// equal compares two values of the same type.
+// Unexported values can't be turned into interfaces, so scalars are compared by kind.
+func equal(a, b reflect.Value) bool {
+	switch a.Kind() {
+	case reflect.Bool:
+		return a.Bool() == b.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() == b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return a.Uint() == b.Uint()
+	case reflect.Float32, reflect.Float64:
+		return a.Float() == b.Float()
+	case reflect.Complex64, reflect.Complex128:
+		return a.Complex() == b.Complex()
+	case reflect.String:
+		return a.String() == b.String()
+	case reflect.Func:
+		// same as reflect.DeepEqual: funcs are equal only if both are nil
+		return a.IsNil() && b.IsNil()
+	case reflect.Chan, reflect.UnsafePointer:
+		return a.Pointer() == b.Pointer()
+	}
+	if a.CanInterface() && b.CanInterface() {
+		return reflect.DeepEqual(a.Interface(), b.Interface())
+	}
+	return render(a) == render(b)
+}
+
+// mapKeys returns the union of keys of both maps, sorted by their rendering
+func mapKeys(a, b reflect.Value) []reflect.Value {
+	keys := a.MapKeys()
+	for _, k := range b.MapKeys() {
+		if !a.MapIndex(k).IsValid() {
+			keys = append(keys, k)
+		}
+	}
+	slices.SortFunc(keys, func(x, y reflect.Value) int { return strings.Compare(render(x), render(y)) })
+	return keys
+}
+
+func keySegment(k reflect.Value) string {
+	return "[" + render(k) + "]"
+}
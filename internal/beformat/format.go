@@ -0,0 +1,214 @@
+package beformat
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Indent is a single level of indentation used by Object
+const Indent = "    "
+
+// MaxLength bounds the rendered value in Object, longer values are truncated
+const MaxLength = 4000
+
+// Message builds a failure message the way matchers report it:
+//
+//	Expected
+//	    <int>: 3
+//	to be >
+//	    <int>: 5
+//
+// The expected value is optional. The layout is the one of gomega's format.Message,
+// so Compact handles messages from both be and gomega matchers alike.
+func Message(actual any, message string, expected ...any) string {
+	if len(expected) == 0 {
+		return fmt.Sprintf("Expected\n%s\n%s", Object(actual, 1), message)
+	}
+	return fmt.Sprintf("Expected\n%s\n%s\n%s", Object(actual, 1), message, Object(expected[0], 1))
+}
+
+// Object formats a value with its type tag, indented by the given number of levels,
+// e.g. `    <main.User>: {Name: "Alice", Age: 30}`.
+// Top-level strings and errors are shown as they are, nested ones are quoted.
+// Pointers are tagged with their address and shown de-referenced, e.g. `<*int | 0xc000012345>: 1`.
+func Object(v any, indentation uint) string {
+	indent := strings.Repeat(Indent, int(indentation))
+	return fmt.Sprintf("%s<%s>: %s", indent, typeLabel(v), strings.ReplaceAll(value(v), "\n", "\n"+indent))
+}
+
+// Value formats a value on a single line without its type tag, strings quoted,
+// e.g. `{Name: "Alice", Age: 30}`
+func Value(v any) string {
+	return render(reflect.ValueOf(v))
+}
+
+// Entry is a key-value pair of an ordered collection whose keys may repeat,
+// e.g. what an iter.Seq2 yields. Entries are shown like a map, in their order:
+// `<iter.Seq2>: {"a": 1, "b": 2}`.
+type Entry struct {
+	Key, Value any
+}
+
+var entriesType = reflect.TypeFor[[]Entry]()
+
+func typeLabel(v any) string {
+	if v == nil {
+		return "nil"
+	}
+	if reflect.TypeOf(v) == entriesType {
+		return "iter.Seq2"
+	}
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		return fmt.Sprintf("%s | %#x", rv.Type(), rv.Pointer())
+	}
+	return reflect.TypeOf(v).String()
+}
+
+func value(v any) string {
+	switch x := v.(type) {
+	case nil:
+		return "nil"
+	case string:
+		return truncate(x, MaxLength)
+	case error:
+		if rv := reflect.ValueOf(x); rv.Kind() == reflect.Pointer && rv.IsNil() {
+			return "nil"
+		}
+		return truncate(x.Error(), MaxLength)
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	return renderLimited(rv, MaxLength)
+}
+
+func truncate(s string, limit int) string {
+	if len(s) > limit {
+		return s[:limit] + "..."
+	}
+	return s
+}
+
+// render formats a value on a single line, e.g. `{Name: "Alice", Tags: ["a"]}`
+func render(v reflect.Value) string {
+	return renderLimited(v, maxValueLen)
+}
+
+func renderLimited(v reflect.Value, limit int) string {
+	r := renderer{limit: limit}
+	r.render(v)
+	return truncate(r.String(), limit)
+}
+
+// renderer writes values until the limit is reached
+type renderer struct {
+	strings.Builder
+	limit int
+}
+
+func (r *renderer) render(v reflect.Value) {
+	if r.Len() > r.limit {
+		return
+	}
+	if !v.IsValid() {
+		r.WriteString("nil")
+		return
+	}
+
+	if v.CanInterface() && v.Kind() != reflect.Pointer && v.Kind() != reflect.Interface {
+		switch x := v.Interface().(type) {
+		case error:
+			r.WriteString(strconv.Quote(x.Error()))
+			return
+		case fmt.Stringer:
+			if v.Kind() == reflect.Struct {
+				r.WriteString(x.String())
+				return
+			}
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		r.WriteString(strconv.Quote(v.String()))
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			r.WriteString("nil")
+			return
+		}
+		if v.Kind() == reflect.Pointer {
+			r.WriteString("&")
+		}
+		r.render(v.Elem())
+	case reflect.Struct:
+		r.WriteString("{")
+		for i := range v.NumField() {
+			if i > 0 {
+				r.WriteString(", ")
+			}
+			r.WriteString(v.Type().Field(i).Name + ": ")
+			r.render(v.Field(i))
+		}
+		r.WriteString("}")
+	case reflect.Map:
+		if v.IsNil() {
+			r.WriteString("nil")
+			return
+		}
+		r.WriteString("{")
+		for i, k := range mapKeys(v, v) {
+			if i > 0 {
+				r.WriteString(", ")
+			}
+			r.render(k)
+			r.WriteString(": ")
+			r.render(v.MapIndex(k))
+		}
+		r.WriteString("}")
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			r.WriteString("nil")
+			return
+		}
+		if v.Type() == entriesType {
+			r.renderEntries(v)
+			return
+		}
+		r.WriteString("[")
+		for i := range v.Len() {
+			if i > 0 {
+				r.WriteString(", ")
+			}
+			r.render(v.Index(i))
+		}
+		r.WriteString("]")
+	case reflect.Bool:
+		r.WriteString(strconv.FormatBool(v.Bool()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		r.WriteString(strconv.FormatInt(v.Int(), 10))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		r.WriteString(strconv.FormatUint(v.Uint(), 10))
+	case reflect.Float32, reflect.Float64:
+		r.WriteString(strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits()))
+	default:
+		fmt.Fprintf(r, "%v", v)
+	}
+}
+
+// renderEntries renders a slice of entries like a map, keeping their order
+func (r *renderer) renderEntries(v reflect.Value) {
+	r.WriteString("{")
+	for i := range v.Len() {
+		if i > 0 {
+			r.WriteString(", ")
+		}
+		entry := v.Index(i)
+		r.render(entry.Field(0))
+		r.WriteString(": ")
+		r.render(entry.Field(1))
+	}
+	r.WriteString("}")
+}
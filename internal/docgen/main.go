@@ -36,6 +36,7 @@ var packages = []string{
 	"be_json",
 	"be_jwt",
 	"be_ctx",
+	"typed",
 }
 
 // insteadOf is the anti-pattern table: raw idioms each matcher supersedes.
@@ -55,14 +56,26 @@ var insteadOf = map[string]string{
 	"be.Lte":                  "`be.True(x <= n)`",
 	"be.HaveLength":           "`be.True(len(xs) >= n)`",
 	"be.ContainElement":       "`be.True(slices.Contains(xs, v))`",
+	"be.Sorted":               "`be.True(slices.IsSorted(xs))`",
+	"be.SortedBy":             "`be.True(slices.IsSortedFunc(xs, cmp))`",
+	"be.Receive":              "a `select` on the channel and `time.After` in the test",
+	"be.PanicWithMessage":     "`defer func() { r := recover(); … }()` in the test",
 	"be.ContainSubstring":     "`be.True(strings.Contains(s, q))`",
 	"be.HaveKey":              "`_, ok := m[k]` + `be.True(ok)`",
+	"be.MapContaining":        "a `be.HaveKeyWithValue` per key",
 	"be.MatchError":           "`be.True(errors.Is(err, X))`",
-	"be.MatchErrorAs":         "`var v E` + `be.True(errors.As(err, &v))` — only when `v` is unused afterward (the matcher does not bind it)",
+	"be.MatchErrorAs":         "`var v E` + `be.True(errors.As(err, &v))` — when `v` is unused afterward",
+	"be.MatchErrorInto":       "`be.True(errors.As(err, &v))` — when `v` is used afterward",
 	"be.HaveField":            "`be.Eq(x.Field)` on a projected value",
+	"be.ExpectValues":         "`v, err := f()` + `be.NoError(t, err)` + `be.Expect(t, v)`",
 	"be.NoError":              "`if err != nil { t.Fatal(err) }`",
 	"be_string.HavingPrefix":  "`be.True(strings.HasPrefix(s, p))`",
 	"be_string.HavingSuffix":  "`be.True(strings.HasSuffix(s, x))`",
+	"be_json.At":              "nested `be_json.HaveKeyValue` per level of the path",
+	"be_json.MatchSchema":     "a `be_json.HaveKeyValue` chain re-expressing the schema",
+	"be_json.Equivalent":      "deleting volatile fields by hand before `be.JSON(expected)`",
+	"be_json.MatchTemplate":   "decoding the body and asserting each dynamic field separately",
+	"be_json.As":              "`be_reflected.AsFloat()` on the numbers of a decoded `map[string]any`",
 	"be_time.SameExactSecond": "`be.True(t1.Equal(t2))`",
 	"be_time.Approx":          "`be.True(d < time.Second)` on a time diff",
 }
@@ -80,8 +93,9 @@ var sections = []section{
 	{
 		Title: "Assertions & shortcuts",
 		Names: []string{
-			"be.Expect", "be.Require", "be.AssertThat", "be.RequireThat",
+			"be.Expect", "be.Require", "be.AssertThat", "be.RequireThat", "be.ExpectValues", "be.RequireValues",
 			"be.NoError", "be.Error", "be.ErrorIs",
+			"be.Group", "be.RequireGroup",
 		},
 	},
 	{
@@ -95,22 +109,34 @@ var sections = []section{
 			"be.Zero", "be.NonZero",
 		},
 	},
+	{
+		Title: "Snapshots",
+		Names: []string{"be.MatchSnapshot"},
+	},
 	{
 		Title: "Errors",
-		Names: []string{"be.Succeed", "be.HaveOccurred", "be.MatchError", "be.MatchErrorAs"},
+		Names: []string{"be.Succeed", "be.HaveOccurred", "be.MatchError", "be.MatchErrorAs", "be.MatchErrorInto"},
 	},
 	{
 		Title: "Booleans, nil & panics",
-		Names: []string{"be.True", "be.False", "be.Nil", "be.NotNil", "be.Panic", "be.NotPanic"},
+		Names: []string{"be.True", "be.False", "be.Nil", "be.NotNil", "be.Panic", "be.NotPanic", "be.PanicWith", "be.PanicWithError", "be.PanicWithMessage"},
 	},
 	{
 		Title: "Collections & length",
 		Names: []string{
-			"be.ContainElement", "be.ContainElements", "be.HaveKey", "be.HaveKeyWithValue",
+			"be.ContainElement", "be.ContainElements", "be.ConsistOf", "be.HaveExactElements",
+			"be.HaveSubsequence", "be.StartWith", "be.EndWith",
+			"be.Sorted", "be.SortedBy", "be.SortedByField", "be.Unique", "be.UniqueBy",
+			"be.HaveKey", "be.HaveKeyWithValue", "be.MapContaining", "be.MapExactly", "be.HaveOnlyKeys",
 			"be.Empty", "be.NotEmpty", "be.HaveLength",
-			"be.Dive", "be.DiveAny", "be.DiveFirst", "be.DiveNth",
+			"be.Dive", "be.DiveAny", "be.DiveNone", "be.DiveFirst", "be.DiveLast", "be.DiveNth",
+			"be.DiveCount", "be.DiveKeys", "be.DiveEntries",
 		},
 	},
+	{
+		Title: "Channels",
+		Names: []string{"be.Receive", "be.ReceiveAll", "be.ReceiveInOrder", "be.BeClosed", "be.BeSent"},
+	},
 	{
 		Title: "Structs",
 		Names: []string{"be.HaveField", "be.HaveFields"},
@@ -136,7 +162,7 @@ var sections = []section{
 	},
 	{
 		Title: "Composition & control",
-		Names: []string{"be.All", "be.Any", "be.Not", "be.Always", "be.Never", "be.Via"},
+		Names: []string{"be.All", "be.Any", "be.Not", "be.AtLeast", "be.AtMost", "be.ExactlyOneOf", "be.When", "be.Lazy", "be.Always", "be.Never", "be.Via", "be.Capture", "be.MatcherFunc"},
 	},
 	{
 		Title: "Types & kinds",
@@ -147,6 +173,10 @@ var sections = []section{
 		Names: []string{"be.HttpRequest", "be.URL", "be.JSON", "be.JwtToken", "be.Ctx"},
 		Pkgs:  []string{"be_http", "be_url", "be_json", "be_jwt", "be_ctx"},
 	},
+	{
+		Title: "Typed (generics)",
+		Pkgs:  []string{"typed"},
+	},
 }
 
 type entry struct {
@@ -249,7 +279,13 @@ func collect() (map[string]entry, []string, error) {
 			return nil, nil, fmt.Errorf("%s: %w", dir, err)
 		}
 
-		for _, f := range d.Funcs {
+		// go/doc files constructors (e.g. Expect returning *Expectation) under their type
+		funcs := d.Funcs
+		for _, t := range d.Types {
+			funcs = append(funcs, t.Funcs...)
+		}
+
+		for _, f := range funcs {
 			if !ast.IsExported(f.Name) || !isCatalogFunc(f.Decl.Type, fset) {
 				continue
 			}
@@ -302,10 +338,11 @@ func parsePackageFiles(fset *token.FileSet, dir string) ([]*ast.File, error) {
 }
 
 // isCatalogFunc reports whether a function belongs in the catalog: it returns a
-// matcher, or it is an assertion helper driven by TestingT.
+// matcher (typed.Matcher[T] included), or it is an assertion helper driven by TestingT.
 func isCatalogFunc(ft *ast.FuncType, fset *token.FileSet) bool {
 	if ft.Results != nil && len(ft.Results.List) > 0 {
-		if strings.Contains(typeString(ft.Results.List[len(ft.Results.List)-1].Type, fset), "BeMatcher") {
+		result := typeString(ft.Results.List[len(ft.Results.List)-1].Type, fset)
+		if strings.Contains(result, "BeMatcher") || strings.HasPrefix(result, "Matcher[") {
 			return true
 		}
 	}
@@ -1,11 +1,16 @@
 package psi
 
 import (
-	"errors"
+	"cmp"
 	"fmt"
 	"reflect"
+	"slices"
+	"strings"
 
 	"github.com/amberpixels/k1/cast"
+
+	"github.com/expectto/be/internal/beformat"
+	"github.com/expectto/be/types"
 )
 
 type DiveMode string
@@ -13,24 +18,48 @@ type DiveMode string
 const (
 	DiveModeEvery DiveMode = "every"
 	DiveModeAny   DiveMode = "any"
+	DiveModeNone  DiveMode = "none"
 	DiveModeFirst DiveMode = "first"
+	DiveModeLast  DiveMode = "last"
 	DiveModeNth   DiveMode = "nth"
+	DiveModeCount DiveMode = "count"
+)
+
+// diveTarget is the part of each element the matcher is applied to
+type diveTarget int
+
+const (
+	diveValues  diveTarget = iota // slice/array elements, map values
+	diveKeys                      // map keys
+	diveEntries                   // map keys and values (each with its own matcher)
 )
 
+// maxListedItems bounds the number of per-item failures listed in a message
+const maxListedItems = 10
+
 type DiveMatcher struct {
 	*MixinMatcherGomock
 
-	matcher any
+	matcher types.BeMatcher
 	mode    DiveMode
+	target  diveTarget
 
-	// when mode is DiveModeNth, then we keep nth element
+	// when diving into map entries, keys are matched by keyMatcher
+	keyMatcher types.BeMatcher
+
+	// when mode is DiveModeNth, then we keep nth element (negative n counts from the end)
 	n int
+
+	// when mode is DiveModeCount, the number of matching elements is matched against count
+	count types.BeMatcher
 }
 
 func NewDiveMatcher(matcher any, mode DiveMode, args ...any) *DiveMatcher {
-	dm := &DiveMatcher{matcher: matcher, mode: mode}
+	dm := &DiveMatcher{matcher: Psi(matcher), mode: mode}
+	dm.MixinMatcherGomock = NewMixinMatcherGomock(dm, "Dive")
 
-	if mode == DiveModeNth {
+	switch mode {
+	case DiveModeNth:
 		if len(args) == 0 {
 			panic("DiveNth expects value of `n` as an argument")
 		}
@@ -39,98 +68,358 @@ func NewDiveMatcher(matcher any, mode DiveMode, args ...any) *DiveMatcher {
 		}
 
 		dm.n = cast.AsInt(args[0])
+	case DiveModeCount:
+		if len(args) == 0 {
+			panic("DiveCount expects the count (a number or a matcher) as an argument")
+		}
+		dm.count = Psi(args[0])
 	}
 
 	return dm
 }
 
-func (dm *DiveMatcher) Match(actual any) (bool, error) {
-	matcher := Psi(dm.matcher)
+// NewDiveKeysMatcher creates a DiveMatcher applying the matcher to every key of a map
+func NewDiveKeysMatcher(matcher any) *DiveMatcher {
+	dm := NewDiveMatcher(matcher, DiveModeEvery)
+	dm.target = diveKeys
+	return dm
+}
+
+// NewDiveEntriesMatcher creates a DiveMatcher requiring every entry of a map
+// to have its key matching keyMatcher and its value matching valueMatcher
+func NewDiveEntriesMatcher(keyMatcher, valueMatcher any) *DiveMatcher {
+	dm := NewDiveMatcher(valueMatcher, DiveModeEvery)
+	dm.target = diveEntries
+	dm.keyMatcher = Psi(keyMatcher)
+	return dm
+}
+
+// diveItem is a single element of the list we dive into,
+// along with its path segment (`[1]` for slices, `["key"]` for maps)
+type diveItem struct {
+	segment string
+	key     any
+	value   any
+}
+
+// items collects the elements to dive over via reflection. Slices, arrays and iter.Seq
+// dive over their elements; maps and iter.Seq2 dive over their values (or keys, or entries).
+// Anything else fails gracefully instead of panicking (cast.AsSliceOfAny would panic).
+// shown is what messages show as actual: an iterator is shown as what it yielded.
+func (dm *DiveMatcher) items(actual any) (items []diveItem, shown any, err error) {
+	if entries, ok, err := SeqEntries(actual); ok {
+		if err != nil {
+			return nil, nil, err
+		}
+		// unlike maps, iter.Seq2 is ordered, so positional modes are fine
+		items = make([]diveItem, len(entries))
+		for i, e := range entries {
+			items[i] = diveItem{segment: IndexSegment(e.Key), key: e.Key, value: e.Value}
+		}
+		return items, entries, nil
+	}
+	if shown, err = Collect(actual); err != nil {
+		return nil, nil, err
+	}
 
-	// Collect the elements to dive over via reflection. Slices and arrays dive
-	// over their elements; maps dive over their values. Anything else fails
-	// gracefully instead of panicking (cast.AsSliceOfAny would panic).
-	rv := reflect.ValueOf(actual)
+	rv := reflect.ValueOf(shown)
 	for rv.Kind() == reflect.Pointer {
 		rv = rv.Elem()
 	}
 
-	var slice []any
 	switch rv.Kind() {
 	case reflect.Slice, reflect.Array:
-		slice = make([]any, rv.Len())
-		for i := range slice {
-			slice[i] = rv.Index(i).Interface()
+		if dm.target != diveValues {
+			return nil, nil, fmt.Errorf("%s expects a map or an iter.Seq2, got %T", dm.name(), actual)
+		}
+		items = make([]diveItem, rv.Len())
+		for i := range items {
+			items[i] = diveItem{segment: IndexSegment(i), key: i, value: rv.Index(i).Interface()}
 		}
+		return items, shown, nil
 	case reflect.Map:
 		// Maps are unordered, so positional modes are not meaningful.
-		if dm.mode == DiveModeFirst || dm.mode == DiveModeNth {
-			return false, fmt.Errorf("dive[%s] is not supported on a map (maps are unordered)", dm.mode)
+		if dm.positional() {
+			return nil, nil, fmt.Errorf("%s is not supported on a map (maps are unordered)", dm.name())
 		}
-		values := make([]any, 0, rv.Len())
+		items = make([]diveItem, 0, rv.Len())
 		for _, k := range rv.MapKeys() {
-			values = append(values, rv.MapIndex(k).Interface())
+			items = append(items, diveItem{
+				segment: IndexSegment(k.Interface()),
+				key:     k.Interface(),
+				value:   rv.MapIndex(k).Interface(),
+			})
 		}
-		slice = values
+		// keys are sorted so the reported failing value is deterministic
+		slices.SortFunc(items, compareDiveKeys)
+		return items, shown, nil
 	default:
-		return false, fmt.Errorf("dive[%s] expects a slice, array or map, got %T", dm.mode, actual)
+		if dm.target != diveValues {
+			return nil, nil, fmt.Errorf("%s expects a map or an iter.Seq2, got %T", dm.name(), actual)
+		}
+		return nil, nil, fmt.Errorf("%s expects a slice, array, map or iterator, got %T", dm.name(), actual)
+	}
+}
+
+// compareDiveKeys orders map items by their keys: numbers and strings by value
+// (so [2] goes before [10]), any other keys by their rendering
+func compareDiveKeys(a, b diveItem) int {
+	ka, kb := reflect.ValueOf(a.key), reflect.ValueOf(b.key)
+	if ka.Kind() == kb.Kind() {
+		switch ka.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return cmp.Compare(ka.Int(), kb.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return cmp.Compare(ka.Uint(), kb.Uint())
+		case reflect.Float32, reflect.Float64:
+			return cmp.Compare(ka.Float(), kb.Float())
+		case reflect.String:
+			return cmp.Compare(ka.String(), kb.String())
+		}
+	}
+	return strings.Compare(a.segment, b.segment)
+}
+
+func (dm *DiveMatcher) positional() bool {
+	return dm.mode == DiveModeFirst || dm.mode == DiveModeLast || dm.mode == DiveModeNth
+}
+
+// name is the way the matcher is referred to in errors, e.g. "dive[every]"
+func (dm *DiveMatcher) name() string {
+	switch dm.target {
+	case diveKeys:
+		return "dive[keys]"
+	case diveEntries:
+		return "dive[entries]"
+	}
+	return fmt.Sprintf("dive[%s]", dm.mode)
+}
+
+// explainItem applies the matcher(s) to a single item.
+// The outcome is not pointed at the item yet (see pointAt).
+func (dm *DiveMatcher) explainItem(item diveItem) types.Outcome {
+	switch dm.target {
+	case diveKeys:
+		return Explain(dm.matcher, item.key)
+	case diveEntries:
+		if o := Explain(dm.keyMatcher, item.key); !o.Success {
+			return o
+		}
+	}
+	return Explain(dm.matcher, item.value)
+}
+
+func (dm *DiveMatcher) Explain(actual any) types.Outcome {
+	items, shown, err := dm.items(actual)
+	if err != nil {
+		return Errored(err)
 	}
 
 	switch dm.mode {
 	case DiveModeEvery:
-		if len(slice) == 0 {
-			return false, nil
+		if len(items) == 0 {
+			return Failed(dm.message(shown, "to have every element match", " (but there are no elements)"))
+		}
+		for _, item := range items {
+			if o := dm.explainItem(item); !o.Success {
+				return dm.pointAt(item, o)
+			}
 		}
+		return Succeeded(dm.message(shown, "not to have every element match", ""))
 
-		for _, item := range slice {
-			success, err := matcher.Match(item)
-			if err != nil {
-				return false, err
+	case DiveModeAny:
+		if len(items) == 0 {
+			return Succeeded(dm.message(shown, "not to have an element match", " (but there are no elements)"))
+		}
+		failures := make([]string, 0, len(items))
+		for _, item := range items {
+			o := dm.explainItem(item)
+			if o.Err != nil {
+				return dm.pointAt(item, o)
 			}
-			if !success {
-				return false, nil
+			if o.Success {
+				// the matching item explains why the negated dive fails
+				return dm.pointAt(item, o)
 			}
+			failures = append(failures, listItem(item, o))
 		}
-		return true, nil
+		return Failed(dm.message(shown, "to have an element match", listed(failures)))
 
-	case DiveModeAny:
-		if len(slice) == 0 {
-			return true, nil
+	case DiveModeNone:
+		for _, item := range items {
+			if o := dm.explainItem(item); o.Err != nil || o.Success {
+				return Negated(dm.pointAt(item, o))
+			}
 		}
+		return Succeeded(dm.message(shown, "to have an element match", ""))
 
-		for _, item := range slice {
-			success, err := matcher.Match(item)
-			if err != nil {
-				return false, err
+	case DiveModeCount:
+		var matched []string
+		for _, item := range items {
+			o := dm.explainItem(item)
+			if o.Err != nil {
+				return dm.pointAt(item, o)
 			}
-			if success {
-				return true, nil
+			if o.Success {
+				matched = append(matched, item.segment)
 			}
 		}
+		count := Explain(dm.count, len(matched))
+		if count.Err != nil {
+			return count
+		}
+		if len(matched) == 0 {
+			matched = append(matched, "none")
+		}
+		return Outcome(count.Success, nil, func() string {
+			to := "to"
+			if count.Success {
+				to = "not to"
+			}
+			return dm.message(shown, to+" have the number of elements matching",
+				fmt.Sprintf("\n  %s\n  matched: %s", beformat.Compact(count.Message), strings.Join(matched, ", ")))
+		})
 
-		return false, nil
-	case DiveModeFirst:
-		if len(slice) == 0 {
-			return false, errors.New("dive[first] expects non-empty slice")
+	case DiveModeFirst, DiveModeLast, DiveModeNth:
+		if len(items) == 0 {
+			return Errored(fmt.Errorf("%s expects non-empty slice", dm.name()))
 		}
-		return matcher.Match(slice[0])
-	case DiveModeNth:
-		if len(slice) == 0 {
-			return false, errors.New("dive[nth] expects non-empty slice")
+		i := dm.position(len(items))
+		if i < 0 || i >= len(items) {
+			return Errored(fmt.Errorf("%s expects `n` (%d) to be within the length of slice (%d)", dm.name(), dm.n, len(items)))
 		}
-		if dm.n >= len(slice) {
-			return false, errors.New("dive[nth] expects `n` to be less than length of slice")
+		return dm.pointAt(items[i], dm.explainItem(items[i]))
+	}
+
+	panic("invalid DiveMatcher mode")
+}
+
+// position returns the index of the single item a positional dive looks at
+func (dm *DiveMatcher) position(length int) int {
+	switch dm.mode {
+	case DiveModeFirst:
+		return 0
+	case DiveModeLast:
+		return length - 1
+	}
+	if dm.n < 0 {
+		return length + dm.n
+	}
+	return dm.n
+}
+
+// pointAt makes the outcome of a single item point at that item:
+// failures of the item are reported along with its index or key
+func (dm *DiveMatcher) pointAt(item diveItem, o types.Outcome) types.Outcome {
+	if o.Err != nil {
+		return Errored(fmt.Errorf("%s: %w", item.segment, o.Err))
+	}
+	return AtPath(item.segment, o)
+}
+
+// message describes the dive as a whole: the list, the expectation and its details
+func (dm *DiveMatcher) message(actual any, to, details string) string {
+	return beformat.Message(actual, to+": "+dm.describe()+details)
+}
+
+// describe tells what each element is expected to match
+func (dm *DiveMatcher) describe() string {
+	desc := dm.matcher.String()
+	if desc == "" {
+		desc = "the given matcher"
+	}
+	switch dm.target {
+	case diveKeys:
+		return "key " + desc
+	case diveEntries:
+		return "key " + dm.keyMatcher.String() + ", value " + desc
+	}
+	return desc
+}
+
+// listItem renders the failure of a single item for a listing, e.g. `[1]: Expected -2 to be > 0`
+func listItem(item diveItem, o types.Outcome) string {
+	_, msg := SplitPath(o.Message)
+	return item.segment + ": " + beformat.Compact(msg)
+}
+
+func listed(lines []string) string {
+	var b strings.Builder
+	b.WriteString(", but none did:")
+	for i, line := range lines {
+		if i == maxListedItems {
+			fmt.Fprintf(&b, "\n  ... and %d more", len(lines)-maxListedItems)
+			break
 		}
-		return matcher.Match(slice[dm.n])
+		b.WriteString("\n  ")
+		b.WriteString(strings.ReplaceAll(line, "\n", "\n    "))
 	}
+	return b.String()
+}
 
-	panic("invalid DeepMatcher mode")
+func (dm *DiveMatcher) Match(actual any) (bool, error) {
+	o := dm.Explain(actual)
+	return o.Success, o.Err
 }
 
+// FailureMessage points at the item that made the dive fail (if there is a single item to blame)
 func (dm *DiveMatcher) FailureMessage(actual any) string {
-	return fmt.Sprintf("to %s on %s of given list", Psi(dm.matcher).FailureMessage(actual), dm.mode)
+	return dm.Explain(actual).Message
 }
 
 func (dm *DiveMatcher) NegatedFailureMessage(actual any) string {
-	return "not " + dm.FailureMessage(actual)
+	return dm.Explain(actual).Message
+}
+
+// String describes the dive for gomock, e.g. "every element: be > 0"
+func (dm *DiveMatcher) String() string {
+	switch {
+	case dm.target == diveKeys:
+		return "every " + dm.describe()
+	case dm.target == diveEntries:
+		return "every entry: " + dm.describe()
+	case dm.mode == DiveModeEvery:
+		return "every element: " + dm.describe()
+	case dm.mode == DiveModeAny:
+		return "any element: " + dm.describe()
+	case dm.mode == DiveModeNone:
+		return "no element: " + dm.describe()
+	case dm.mode == DiveModeCount:
+		return "count of elements matching " + dm.describe() + ": " + dm.count.String()
+	case dm.mode == DiveModeNth:
+		return fmt.Sprintf("element [%d]: %s", dm.n, dm.describe())
+	}
+	return string(dm.mode) + " element: " + dm.describe()
+}
+
+// Children exposes the dived matcher applied to each of the items
+// (or only to the single item DiveFirst/DiveLast/DiveNth looks at)
+func (dm *DiveMatcher) Children(actual any) []types.Child {
+	items, _, err := dm.items(actual)
+	if err != nil {
+		return nil
+	}
+	if dm.positional() {
+		i := dm.position(len(items))
+		if i < 0 || i >= len(items) {
+			return nil
+		}
+		items = items[i : i+1]
+	}
+
+	children := make([]types.Child, 0, len(items))
+	for _, item := range items {
+		switch dm.target {
+		case diveKeys:
+			children = append(children, types.Child{Segment: item.segment, Matcher: dm.matcher, Actual: item.key})
+		case diveEntries:
+			children = append(children,
+				types.Child{Segment: item.segment, Matcher: dm.keyMatcher, Actual: item.key},
+				types.Child{Segment: item.segment, Matcher: dm.matcher, Actual: item.value},
+			)
+		default:
+			children = append(children, types.Child{Segment: item.segment, Matcher: dm.matcher, Actual: item.value})
+		}
+	}
+	return children
 }
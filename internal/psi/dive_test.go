@@ -3,68 +3,155 @@ package psi_test
 import (
 	"testing"
 
-	"github.com/onsi/gomega"
-
+	"github.com/expectto/be"
+	"github.com/expectto/be/be_string"
 	"github.com/expectto/be/internal/psi"
+	"github.com/expectto/be/types"
 )
 
 // gt0 is a real matcher (actual > 0). A raw func(any)(bool,error) cannot be used
 // here: be treats a one-value+error func as a transform, not a predicate.
-func gt0() gomega.OmegaMatcher { return gomega.BeNumerically(">", 0) }
+func gt0() types.BeMatcher { return be.Gt(0) }
 
 func TestDiveModes(t *testing.T) {
-	g := gomega.NewWithT(t)
-
 	every := psi.NewDiveMatcher(gt0(), psi.DiveModeEvery)
-	g.Expect(every.Match([]int{1, 2, 3})).To(gomega.BeTrue())
-	g.Expect(every.Match([]int{1, -2, 3})).To(gomega.BeFalse())
+	be.ExpectValues(t)(every.Match([]int{1, 2, 3})).To(be.True())
+	be.ExpectValues(t)(every.Match([]int{1, -2, 3})).To(be.False())
 
 	anyOf := psi.NewDiveMatcher(gt0(), psi.DiveModeAny)
-	g.Expect(anyOf.Match([]int{-1, -2, 3})).To(gomega.BeTrue())
-	g.Expect(anyOf.Match([]int{-1, -2, -3})).To(gomega.BeFalse())
+	be.ExpectValues(t)(anyOf.Match([]int{-1, -2, 3})).To(be.True())
+	be.ExpectValues(t)(anyOf.Match([]int{-1, -2, -3})).To(be.False())
 
 	first := psi.NewDiveMatcher(gt0(), psi.DiveModeFirst)
-	g.Expect(first.Match([]int{5, -1})).To(gomega.BeTrue())
+	be.ExpectValues(t)(first.Match([]int{5, -1})).To(be.True())
 
 	nth := psi.NewDiveMatcher(gt0(), psi.DiveModeNth, 1)
-	g.Expect(nth.Match([]int{-1, 5})).To(gomega.BeTrue())
+	be.ExpectValues(t)(nth.Match([]int{-1, 5})).To(be.True())
 }
 
 // TestDiveOverMapValues guards diving over a map's values, and that positional
 // modes are rejected on (unordered) maps.
 func TestDiveOverMapValues(t *testing.T) {
-	g := gomega.NewWithT(t)
-
 	every := psi.NewDiveMatcher(gt0(), psi.DiveModeEvery)
-	g.Expect(every.Match(map[string]int{"a": 1, "b": 2})).To(gomega.BeTrue())
-	g.Expect(every.Match(map[string]int{"a": 1, "b": -2})).To(gomega.BeFalse())
+	be.ExpectValues(t)(every.Match(map[string]int{"a": 1, "b": 2})).To(be.True())
+	be.ExpectValues(t)(every.Match(map[string]int{"a": 1, "b": -2})).To(be.False())
 
 	anyOf := psi.NewDiveMatcher(gt0(), psi.DiveModeAny)
-	g.Expect(anyOf.Match(map[string]int{"a": -1, "b": 3})).To(gomega.BeTrue())
+	be.ExpectValues(t)(anyOf.Match(map[string]int{"a": -1, "b": 3})).To(be.True())
 
 	first := psi.NewDiveMatcher(gt0(), psi.DiveModeFirst)
 	_, err := first.Match(map[string]int{"a": 1})
-	g.Expect(err).To(gomega.HaveOccurred())
+	be.Expect(t, err).To(be.HaveOccurred())
 }
 
 // TestDiveSupportsArrays guards the reflect-based conversion that lets Dive accept
 // fixed-size arrays, not just slices.
 func TestDiveSupportsArrays(t *testing.T) {
-	g := gomega.NewWithT(t)
 	m := psi.NewDiveMatcher(gt0(), psi.DiveModeEvery)
-	g.Expect(m.Match([2]int{1, 2})).To(gomega.BeTrue())
+	be.ExpectValues(t)(m.Match([2]int{1, 2})).To(be.True())
 }
 
 // TestDiveFailsGracefullyOnNonSlice ensures a non-slice actual yields an error
 // rather than panicking and crashing the whole test run.
 func TestDiveFailsGracefullyOnNonSlice(t *testing.T) {
-	g := gomega.NewWithT(t)
 	for _, actual := range []any{42, "hello", nil, struct{}{}} {
 		m := psi.NewDiveMatcher(gt0(), psi.DiveModeEvery)
-		g.Expect(func() {
+		be.Expect(t, func() {
 			ok, err := m.Match(actual)
-			g.Expect(err).To(gomega.HaveOccurred())
-			g.Expect(ok).To(gomega.BeFalse())
-		}).NotTo(gomega.Panic())
+			be.Expect(t, err).To(be.HaveOccurred())
+			be.Expect(t, ok).To(be.False())
+		}).NotTo(be.Panic())
 	}
 }
+
+// TestDiveFailurePointsAtElement guards that a failing dive reports the path of
+// the offending element (index for slices, key for maps), not the whole list.
+func TestDiveFailurePointsAtElement(t *testing.T) {
+	every := psi.NewDiveMatcher(gt0(), psi.DiveModeEvery)
+	path, rest := psi.SplitPath(every.FailureMessage([]int{1, -2, 3}))
+	be.Expect(t, path).To(be.Eq("[1]"))
+	be.Expect(t, rest).To(be.ContainSubstring("-2"))
+
+	path, _ = psi.SplitPath(every.FailureMessage(map[string]int{"a": 1, "b": -2}))
+	be.Expect(t, path).To(be.Eq(`["b"]`))
+}
+
+func TestDiveLastAndNegativeNth(t *testing.T) {
+	last := psi.NewDiveMatcher(gt0(), psi.DiveModeLast)
+	be.ExpectValues(t)(last.Match([]int{-1, 5})).To(be.True())
+	be.ExpectValues(t)(last.Match([]int{5, -1})).To(be.False())
+
+	nth := psi.NewDiveMatcher(gt0(), psi.DiveModeNth, -2)
+	be.ExpectValues(t)(nth.Match([]int{5, -1, -1})).To(be.False())
+	be.ExpectValues(t)(nth.Match([]int{-1, 5, -1})).To(be.True())
+
+	path, _ := psi.SplitPath(nth.FailureMessage([]int{5, -1, -1}))
+	be.Expect(t, path).To(be.Eq("[1]"))
+
+	_, err := nth.Match([]int{1})
+	be.Expect(t, err).To(be.MatchError(be.ContainSubstring("within the length")))
+}
+
+func TestDiveNoneAndCount(t *testing.T) {
+	none := psi.NewDiveMatcher(gt0(), psi.DiveModeNone)
+	be.ExpectValues(t)(none.Match([]int{-1, -2})).To(be.True())
+	be.ExpectValues(t)(none.Match([]int{-1, 2})).To(be.False())
+	be.ExpectValues(t)(none.Match([]int{})).To(be.True())
+
+	path, rest := psi.SplitPath(none.FailureMessage([]int{-1, 2}))
+	be.Expect(t, path).To(be.Eq("[1]"))
+	be.Expect(t, rest).To(be.ContainSubstring("not to be >"))
+
+	count := psi.NewDiveMatcher(gt0(), psi.DiveModeCount, 2)
+	be.ExpectValues(t)(count.Match([]int{1, -1, 2})).To(be.True())
+	be.ExpectValues(t)(count.Match([]int{1, -1, -2})).To(be.False())
+	be.Expect(t, count.FailureMessage([]int{1, -1, -2})).To(be.ContainSubstring("matched: [0]"))
+
+	atLeast := psi.NewDiveMatcher(gt0(), psi.DiveModeCount, be.Gte(2))
+	be.ExpectValues(t)(atLeast.Match(map[string]int{"a": 1, "b": 2, "c": -3})).To(be.True())
+}
+
+func TestDiveKeysAndEntries(t *testing.T) {
+	keys := psi.NewDiveKeysMatcher(be_string.HavingPrefix("x-"))
+	be.ExpectValues(t)(keys.Match(map[string]int{"x-a": 1, "x-b": 2})).To(be.True())
+	be.ExpectValues(t)(keys.Match(map[string]int{"x-a": 1, "b": 2})).To(be.False())
+
+	path, _ := psi.SplitPath(keys.FailureMessage(map[string]int{"x-a": 1, "b": 2}))
+	be.Expect(t, path).To(be.Eq(`["b"]`))
+
+	entries := psi.NewDiveEntriesMatcher(be_string.HavingPrefix("x-"), gt0())
+	be.ExpectValues(t)(entries.Match(map[string]int{"x-a": 1, "x-b": 2})).To(be.True())
+	be.ExpectValues(t)(entries.Match(map[string]int{"x-a": 1, "x-b": -2})).To(be.False())
+	be.ExpectValues(t)(entries.Match(map[string]int{"x-a": 1, "b": 2})).To(be.False())
+
+	// keys and entries are about maps only
+	_, err := keys.Match([]string{"x-a"})
+	be.Expect(t, err).To(be.HaveOccurred())
+}
+
+// TestDiveAnyListsEveryElement guards that a failed DiveAny reports each element
+// with its own mismatch instead of the whole list as the inner actual.
+func TestDiveAnyListsEveryElement(t *testing.T) {
+	anyOf := psi.NewDiveMatcher(gt0(), psi.DiveModeAny)
+	msg := anyOf.FailureMessage([]int{-1, -2})
+	be.Expect(t, msg).To(be.ContainSubstring("[0]: Expected -1 to be > 0"))
+	be.Expect(t, msg).To(be.ContainSubstring("[1]: Expected -2 to be > 0"))
+}
+
+// TestDiveAnyOnEmptyCollection guards that DiveAny succeeds on an empty collection, as it did before
+// the failures got listed; it's DiveEvery that fails when there are no elements.
+func TestDiveAnyOnEmptyCollection(t *testing.T) {
+	anyOf := psi.NewDiveMatcher(gt0(), psi.DiveModeAny)
+	be.ExpectValues(t)(anyOf.Match([]int{})).To(be.True())
+	be.ExpectValues(t)(anyOf.Match(map[string]int{})).To(be.True())
+	be.Expect(t, anyOf.NegatedFailureMessage([]int{})).To(be.ContainSubstring("(but there are no elements)"))
+}
+
+// TestDiveOverMapSortsKeysByValue guards that map keys are visited in their natural order,
+// so the reported failure is the one of the smallest key rather than the smallest rendering.
+func TestDiveOverMapSortsKeysByValue(t *testing.T) {
+	every := psi.NewDiveMatcher(gt0(), psi.DiveModeEvery)
+	msg := every.FailureMessage(map[int]int{10: -10, 2: -2})
+	be.Expect(t, msg).To(be.ContainSubstring("[2]"))
+	be.Expect(t, msg).NotTo(be.ContainSubstring("[10]"))
+}
@@ -1,18 +1,16 @@
-package psi_matchers
+package psi
 
 import (
 	"bytes"
 	"errors"
 	"reflect"
 
-	"github.com/onsi/gomega/format"
-
+	"github.com/expectto/be/internal/beformat"
 	"github.com/expectto/be/types"
 )
 
 type EqMatcher struct {
-	Expected        any
-	lastActualValue any
+	Expected any
 }
 
 var _ types.BeMatcher = &EqMatcher{}
@@ -35,7 +33,6 @@ func (matcher *EqMatcher) Match(actual any) (bool, error) {
 			return bytes.Equal(actualByteSlice, expectedByteSlice), nil
 		}
 	}
-	matcher.lastActualValue = actual
 	return reflect.DeepEqual(actual, matcher.Expected), nil
 }
 
@@ -43,23 +40,29 @@ func (matcher *EqMatcher) FailureMessage(actual any) string {
 	actualString, actualOK := actual.(string)
 	expectedString, expectedOK := matcher.Expected.(string)
 	if actualOK && expectedOK {
-		return format.MessageWithDiff(actualString, "to equal", expectedString)
+		return beformat.Message(actualString, "to equal", expectedString)
+	}
+
+	// structs, maps and slices report only the paths that differ
+	if beformat.Diffable(matcher.Expected, actual) {
+		if lines := beformat.FieldDiff(matcher.Expected, actual, ""); len(lines) > 0 {
+			return beformat.DiffMessage(actual, "to equal", lines)
+		}
 	}
 
-	return format.Message(actual, "to equal", matcher.Expected)
+	return beformat.Message(actual, "to equal", matcher.Expected)
 }
 
 func (matcher *EqMatcher) NegatedFailureMessage(actual any) string {
-	return format.Message(actual, "not to equal", matcher.Expected)
+	return beformat.Message(actual, "not to equal", matcher.Expected)
 }
 
 func (matcher *EqMatcher) Matches(actual any) bool {
 	res, _ := matcher.Match(actual)
-	matcher.lastActualValue = actual
 	return res
 }
 
-// String is considered to be called after Matches() was called
+// String describes the matcher for gomock, e.g. "to equal <string>: world"
 func (matcher *EqMatcher) String() string {
-	return matcher.FailureMessage(matcher.lastActualValue)
+	return "to equal " + beformat.Object(matcher.Expected, 0)
 }
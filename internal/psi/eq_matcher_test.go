@@ -0,0 +1,58 @@
+package psi_test
+
+import (
+	"testing"
+
+	"github.com/expectto/be"
+	"github.com/expectto/be/internal/psi"
+)
+
+func TestEqMatcherMatch(t *testing.T) {
+	for _, tc := range []struct {
+		name             string
+		expected, actual any
+		success, errored bool
+	}{
+		{"nil values (are not comparable)", nil, nil, false, true},
+		{"equal strings", "hello", "hello", true, false},
+		{"equal byte slices", []byte{1, 2, 3}, []byte{1, 2, 3}, true, false},
+		{"not equal strings", "hello", "world", false, false},
+		{"not equal byte slices", []byte{1, 2, 3}, []byte{3, 2, 1}, false, false},
+	} {
+		matcher := psi.NewEqMatcher(tc.expected)
+		success, err := matcher.Match(tc.actual)
+		be.Expect(t, success).To(be.Eq(tc.success), tc.name)
+		be.Expect(t, err != nil).To(be.Eq(tc.errored), tc.name)
+
+		// Matches() is a shortcut to Match
+		be.Expect(t, matcher.Matches(tc.actual)).To(be.Eq(success), tc.name)
+	}
+}
+
+func TestEqMatcherFailureMessage(t *testing.T) {
+	matcher := psi.NewEqMatcher("world")
+	be.Expect(t, matcher.FailureMessage("hello")).To(be.Eq("Expected\n    <string>: hello\nto equal\n    <string>: world"))
+
+	// scalars keep the regular message
+	be.Expect(t, psi.NewEqMatcher(5).FailureMessage(3)).To(be.Eq("Expected\n    <int>: 3\nto equal\n    <int>: 5"))
+
+	// String() describes the expected value regardless of matched values
+	_ = matcher.Matches("hello")
+	be.Expect(t, matcher.String()).To(be.Eq("to equal <string>: world"))
+}
+
+func TestEqMatcherFieldDiff(t *testing.T) {
+	type address struct{ City, Street string }
+	type user struct {
+		Name    string
+		Address address
+		Tags    []string
+	}
+	matcher := psi.NewEqMatcher(user{Name: "Alice", Address: address{City: "Berlin", Street: "Main"}, Tags: []string{"a"}})
+	failureMsg := matcher.FailureMessage(user{Name: "Alice", Address: address{City: "Bonn", Street: "Main"}, Tags: []string{"a", "b"}})
+
+	be.Expect(t, failureMsg).To(be.Eq("Expected psi_test.user to equal, differences (- expected, + actual):\n" +
+		"  - .Address.City: \"Berlin\"\n" +
+		"  + .Address.City: \"Bonn\"\n" +
+		"  + .Tags[1]: \"b\""))
+}
@@ -0,0 +1,151 @@
+package psi
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/expectto/be/types"
+)
+
+// Explain evaluates the matcher against actual and explains the outcome in a single step.
+// Matchers implementing types.ExplainingMatcher are evaluated without any per-call state,
+// other ones (e.g. gomega's matchers) fall back to Match followed by the corresponding message.
+//
+// Composite matchers must evaluate their children via Explain: Match followed by FailureMessage
+// on a shared child is not atomic, another goroutine may match the child in between.
+func Explain(m types.GomegaMatcher, actual any) types.Outcome {
+	if em, ok := m.(types.ExplainingMatcher); ok {
+		return em.Explain(actual)
+	}
+
+	success, err := m.Match(actual)
+	return Outcome(success, err, func() string {
+		if success {
+			return m.NegatedFailureMessage(actual)
+		}
+		return m.FailureMessage(actual)
+	})
+}
+
+// Recall returns the outcome of the last evaluation of actual by the matcher if it remembers one
+// (see types.RememberingMatcher), otherwise it evaluates the matcher (see Explain).
+// It's for explaining an evaluation that has already happened: a FailureMessage following Match,
+// or the sub-matchers of an evaluation tree, which were evaluated by their parent.
+func Recall(m types.GomegaMatcher, actual any) types.Outcome {
+	if o, ok := LastOutcome(m, actual); ok {
+		return o
+	}
+	return Explain(m, actual)
+}
+
+// LastOutcome returns the outcome the matcher remembers for actual (see types.RememberingMatcher).
+// Decorators forward it to the matcher they decorate.
+func LastOutcome(m types.GomegaMatcher, actual any) (types.Outcome, bool) {
+	if rm, ok := m.(types.RememberingMatcher); ok {
+		return rm.LastOutcome(actual)
+	}
+	return types.Outcome{}, false
+}
+
+// Memo remembers a value computed for the last actual, e.g. the outcome of an evaluation
+// with side effects (see types.RememberingMatcher). The same actual is the same comparable value:
+// the same channel, the same reader, ...
+type Memo[V any] struct {
+	mu     sync.Mutex
+	actual any
+	value  V
+	ok     bool
+}
+
+// Remember stores the value computed for actual and returns it
+func (m *Memo[V]) Remember(actual any, value V) V {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.actual, m.value, m.ok = actual, value, true
+	return value
+}
+
+// Recall returns the value remembered for actual, ok is false if it's not the last actual
+func (m *Memo[V]) Recall(actual any) (value V, ok bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.ok || !sameActual(actual, m.actual) {
+		return value, false
+	}
+	return m.value, true
+}
+
+// sameActual reports if both values are equal comparable values
+func sameActual(a, b any) (same bool) {
+	if a == nil || b == nil || reflect.TypeOf(a) != reflect.TypeOf(b) || !reflect.TypeOf(a).Comparable() {
+		return false
+	}
+	defer func() {
+		// a comparable struct may hold a non-comparable value in an interface field
+		if recover() != nil {
+			same = false
+		}
+	}()
+	return a == b
+}
+
+// Outcome builds a types.Outcome from a match result.
+// The message func is called only for a successful or a failed (not errored) match.
+func Outcome(success bool, err error, message func() string) types.Outcome {
+	if err != nil {
+		return types.Outcome{Err: err, Message: err.Error()}
+	}
+	return types.Outcome{Success: success, Message: message()}
+}
+
+// Failed returns the outcome of a failed match explained by given message
+func Failed(message string) types.Outcome {
+	return types.Outcome{Message: message}
+}
+
+// Succeeded returns the outcome of a successful match explained by given (negated failure) message
+func Succeeded(message string) types.Outcome {
+	return types.Outcome{Success: true, Message: message}
+}
+
+// Errored returns the outcome of a match that failed with given error
+func Errored(err error) types.Outcome {
+	return types.Outcome{Err: err, Message: err.Error()}
+}
+
+// Negated returns the outcome of the negated match: a failure explanation becomes
+// the success one and vice versa, an error stays an error
+func Negated(o types.Outcome) types.Outcome {
+	if o.Err != nil {
+		return o
+	}
+	o.Success = !o.Success
+	return o
+}
+
+// AtPath prefixes the message of given outcome with the path segment (see WithPathSegment)
+func AtPath(segment string, o types.Outcome) types.Outcome {
+	if o.Err == nil {
+		o.Message = WithPathSegment(segment, o.Message)
+	}
+	return o
+}
+
+// PollSession starts a poll session of the matcher if it consumes actual (see types.PollingMatcher),
+// other matchers are returned as they are
+func PollSession(m types.BeMatcher) types.BeMatcher {
+	if pm, ok := m.(types.PollingMatcher); ok {
+		return pm.NewPollSession()
+	}
+	return m
+}
+
+// PollSessions starts poll sessions of the given matchers (see PollSession):
+// composite matchers start them for their children
+func PollSessions(ms ...types.BeMatcher) []types.BeMatcher {
+	sessions := make([]types.BeMatcher, len(ms))
+	for i, m := range ms {
+		sessions[i] = PollSession(m)
+	}
+	return sessions
+}
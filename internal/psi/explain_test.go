@@ -0,0 +1,80 @@
+package psi_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/expectto/be"
+	"github.com/expectto/be/internal/psi"
+)
+
+// boundsMatcher is a plain gomega-style matcher (it has no Explain), and it's stateful
+// the way gomega.And is: FailureMessage reports the bound the last Match violated.
+type boundsMatcher struct {
+	lo, hi int
+	failed string
+}
+
+func (m *boundsMatcher) Match(actual any) (bool, error) {
+	n, ok := actual.(int)
+	if !ok {
+		return false, fmt.Errorf("expected an int, got %T", actual)
+	}
+	switch {
+	case n <= m.lo:
+		m.failed = fmt.Sprintf("to be > %d", m.lo)
+	case n >= m.hi:
+		m.failed = fmt.Sprintf("to be < %d", m.hi)
+	default:
+		m.failed = ""
+		return true, nil
+	}
+	return false, nil
+}
+
+func (m *boundsMatcher) FailureMessage(actual any) string {
+	return fmt.Sprintf("Expected %v %s", actual, m.failed)
+}
+
+func (m *boundsMatcher) NegatedFailureMessage(actual any) string {
+	return fmt.Sprintf("Expected %v not to be within (%d, %d)", actual, m.lo, m.hi)
+}
+
+func TestExplainFallsBackToGomegaMessages(t *testing.T) {
+	m := psi.Psi(&boundsMatcher{lo: 5, hi: 10})
+
+	failed := psi.Explain(m, 3)
+	be.Expect(t, failed.Success).To(be.False())
+	be.Expect(t, failed.Message).To(be.Eq(m.FailureMessage(3)))
+
+	succeeded := psi.Explain(m, 7)
+	be.Expect(t, succeeded.Success).To(be.True())
+	be.Expect(t, succeeded.Message).To(be.Eq(m.NegatedFailureMessage(7)))
+
+	errored := psi.Explain(m, "seven")
+	be.Expect(t, errored.Err).To(be.HaveOccurred())
+	be.Expect(t, errored.Message).To(be.Eq(errored.Err.Error()))
+}
+
+func TestExplainSerializesStatefulGomegaMatchers(t *testing.T) {
+	// boundsMatcher remembers the violated bound between Match and FailureMessage
+	m := psi.Psi(&boundsMatcher{lo: 0, hi: 10})
+	tooSmall, tooBig := psi.Explain(m, -1).Message, psi.Explain(m, 11).Message
+
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				if i%2 == 0 {
+					be.Expect(t, psi.Explain(m, -1).Message).To(be.Eq(tooSmall))
+				} else {
+					be.Expect(t, psi.Explain(m, 11).Message).To(be.Eq(tooBig))
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
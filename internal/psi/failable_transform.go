@@ -2,10 +2,10 @@ package psi
 
 import (
 	"fmt"
+	"io"
 	"reflect"
 
-	"github.com/onsi/gomega"
-	"github.com/onsi/gomega/format"
+	"github.com/expectto/be/internal/beformat"
 
 	"github.com/expectto/be/types"
 )
@@ -35,60 +35,173 @@ func IsTransformFunc(v any) bool {
 	return false
 }
 
-// WithFallibleTransform creates a gomega transform matcher that can nicely handle failures
+// WithFallibleTransform creates a transform matcher that can nicely handle failures
 // Also it allows to have nil matcher, meaning that we're OK unless transform failed
-func WithFallibleTransform(transform any, matcher gomega.OmegaMatcher) types.BeMatcher {
+func WithFallibleTransform(transform any, matcher types.GomegaMatcher) types.BeMatcher {
+	tm := &transformMatcher{
+		transform: reflect.ValueOf(transform),
+		argType:   reflect.TypeOf(transform).In(0),
+	}
 	if matcher != nil {
-		matcher = gomega.And(WithTransformError(), matcher)
-	} else {
-		matcher = WithTransformError()
+		tm.matcher = Psi(matcher)
 	}
 
-	return Psi(gomega.WithTransform(transform, matcher))
+	tm.MixinMatcherGomock = NewMixinMatcherGomock(tm, "")
+	return tm
 }
 
-// TransformErrorMatcher is actually a matcher
-type TransformErrorMatcher struct {
-	actual any
-	err    error
+// transformMatcher is a port of gomega's WithTransformMatcher combined with TransformErrorMatcher:
+// a transformed value that is an error fails the match with that error.
+// Unlike gomega's one, it exposes the transformed value to the evaluation tree (see types.CompositeMatcher)
+type transformMatcher struct {
+	*MixinMatcherGomock
+
+	transform reflect.Value
+	argType   reflect.Type
+	matcher   types.BeMatcher
+
+	// last is the last evaluation: a reader is consumed by the transform
+	last Memo[transformation]
 }
 
-func WithTransformError() *TransformErrorMatcher {
-	return &TransformErrorMatcher{}
+var _ types.RememberingMatcher = &transformMatcher{}
+
+// transformation is an evaluation of a transformMatcher: the transformed value and the outcome
+type transformation struct {
+	value   any
+	err     error
+	outcome types.Outcome
 }
 
-func (matcher *TransformErrorMatcher) Match(actual any) (bool, error) {
-	// reset state so a reused matcher instance does not leak a prior error
-	matcher.err = nil
-	matcher.actual = nil
+func (tm *transformMatcher) apply(actual any) (any, error) {
+	// prepare a parameter to pass to the Transform function
+	var param reflect.Value
+	switch {
+	case actual != nil && reflect.TypeOf(actual).AssignableTo(tm.argType):
+		param = reflect.ValueOf(actual)
+	case actual == nil && tm.argType.Kind() == reflect.Interface:
+		param = reflect.Zero(tm.argType)
+	default:
+		return nil, fmt.Errorf("Transform function expects '%s' but we have '%T'", tm.argType, actual)
+	}
+
+	result := tm.transform.Call([]reflect.Value{param})
+	if len(result) == 2 && !result[1].IsNil() {
+		err, _ := result[1].Interface().(error) // always an error: checked by IsTransformFunc
+		return nil, fmt.Errorf("Transform function failed: %w", err)
+	}
+	return result[0].Interface(), nil
+}
+
+// transformed returns the transformed actual value.
+// A reader is consumed by the transform: a seekable one (strings.Reader, bytes.Reader, os.File, ...)
+// is rewound afterwards, so every evaluation of it (e.g. by be.Check walking the evaluation tree)
+// transforms the same content. Other readers can be evaluated only once.
+func (tm *transformMatcher) transformed(actual any) (any, error) {
+	seeker, ok := actual.(io.ReadSeeker)
+	if !ok {
+		return tm.apply(actual)
+	}
+	start, err := seeker.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return tm.apply(actual)
+	}
+	defer func() { _, _ = seeker.Seek(start, io.SeekStart) }()
+	return tm.apply(actual)
+}
+
+func (tm *transformMatcher) Explain(actual any) types.Outcome {
+	return tm.last.Remember(actual, tm.evaluate(actual)).outcome
+}
+
+func (tm *transformMatcher) evaluate(actual any) transformation {
+	v, err := tm.transformed(actual)
+	if err != nil {
+		return transformation{err: err, outcome: Errored(err)}
+	}
+
+	// Surface the transform error instead of swallowing it into a silent non-match
+	if o := Explain(WithTransformError(), v); !o.Success || tm.matcher == nil {
+		return transformation{value: v, outcome: o}
+	}
+	return transformation{value: v, outcome: Explain(tm.matcher, v)}
+}
+
+// LastOutcome returns the outcome of the last evaluation of actual, so explaining it doesn't transform anew
+func (tm *transformMatcher) LastOutcome(actual any) (types.Outcome, bool) {
+	t, ok := tm.last.Recall(actual)
+	return t.outcome, ok
+}
+
+func (tm *transformMatcher) Match(actual any) (bool, error) {
+	o := tm.Explain(actual)
+	return o.Success, o.Err
+}
 
-	if err, ok := actual.(error); ok {
-		matcher.err = err
+func (tm *transformMatcher) FailureMessage(actual any) string {
+	return Recall(tm, actual).Message
+}
+
+func (tm *transformMatcher) NegatedFailureMessage(actual any) string {
+	return Recall(tm, actual).Message
+}
+
+// Children exposes the given matcher applied to the value transformed by the last evaluation of actual
+func (tm *transformMatcher) Children(actual any) []types.Child {
+	if tm.matcher == nil {
+		return nil
+	}
+	t, ok := tm.last.Recall(actual)
+	if !ok {
+		t = tm.last.Remember(actual, tm.evaluate(actual))
 	}
+	if t.err != nil {
+		return nil
+	}
+	return []types.Child{{Matcher: tm.matcher, Actual: t.value}}
+}
+
+// TransformErrorMatcher is actually a matcher:
+// it fails (with an error) on a transformed value that is an error
+type TransformErrorMatcher struct{}
+
+func WithTransformError() *TransformErrorMatcher {
+	return &TransformErrorMatcher{}
+}
+
+// inspect returns the transform error (if actual is one) and the original value that caused it
+func (matcher *TransformErrorMatcher) inspect(actual any) (any, error) {
+	err, _ := actual.(error)
 
-	// Fill in actual value for future messages
+	// Fill in actual value for messages
+	var original any
 	if h, ok := actual.(interface {
 		Actual() any
 	}); ok {
-		matcher.actual = h.Actual()
+		original = h.Actual()
 	}
+	return original, err
+}
 
+func (matcher *TransformErrorMatcher) Match(actual any) (bool, error) {
 	// Surface the transform error instead of swallowing it into a silent
 	// non-match: malformed input that can't be evaluated (unparseable URL,
 	// invalid JSON, undecodable JWT, ...) returns an informative error, while a
 	// value that simply doesn't satisfy the matcher returns (false, nil).
-	if matcher.err != nil {
-		return false, matcher.err
+	if _, err := matcher.inspect(actual); err != nil {
+		return false, err
 	}
 	return true, nil
 }
 
 func (matcher *TransformErrorMatcher) FailureMessage(actual any) string {
-	return fmt.Sprintf("Expected\n%s\nto %s", format.Object(matcher.actual, 1), matcher.err)
+	original, err := matcher.inspect(actual)
+	return fmt.Sprintf("Expected\n%s\nto %s", beformat.Object(original, 1), err)
 }
 
 func (matcher *TransformErrorMatcher) NegatedFailureMessage(actual any) string {
-	return fmt.Sprintf("Expected\n%s\nnot to %s", format.Object(matcher.actual, 1), matcher.err)
+	original, err := matcher.inspect(actual)
+	return fmt.Sprintf("Expected\n%s\nnot to %s", beformat.Object(original, 1), err)
 }
 
 // TransformError is used to store error + actual value which caused the error
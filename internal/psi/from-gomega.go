@@ -4,22 +4,75 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"sync"
 
 	"github.com/expectto/be/types"
 )
 
 func FromGomega(omega types.GomegaMatcher, messagePrefixArg ...string) types.BeMatcher {
-	return &upgradedOmegaMatcher{
-		GomegaMatcher:      omega,
-		MixinMatcherGomock: NewMixinMatcherGomock(omega, messagePrefixArg...),
+	if len(messagePrefixArg) == 0 {
+		messagePrefixArg = []string{fmt.Sprintf("%T", omega)}
 	}
+
+	m := &upgradedOmegaMatcher{GomegaMatcher: omega}
+	m.MixinMatcherGomock = NewMixinMatcherGomock(m, messagePrefixArg...)
+	return m
 }
 
 // upgradedOmegaMatcher wraps GomegaMatcher and GomockMatcher
 // Upgrade "Gomega => Psi" is done via attaching MixinMatcherGomock
+//
+// Gomega matchers may keep per-call state (e.g. gomega.And remembers the failed matcher
+// for its FailureMessage), so calls to the wrapped matcher are serialized,
+// and Explain holds the lock for the whole Match + FailureMessage sequence.
 type upgradedOmegaMatcher struct {
 	types.GomegaMatcher
 	*MixinMatcherGomock
+
+	mu sync.Mutex
+}
+
+func (m *upgradedOmegaMatcher) Explain(actual any) types.Outcome {
+	if em, ok := m.GomegaMatcher.(types.ExplainingMatcher); ok {
+		return em.Explain(actual)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	success, err := m.GomegaMatcher.Match(actual)
+	return Outcome(success, err, func() string {
+		if success {
+			return m.GomegaMatcher.NegatedFailureMessage(actual)
+		}
+		return m.GomegaMatcher.FailureMessage(actual)
+	})
+}
+
+func (m *upgradedOmegaMatcher) Match(actual any) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.GomegaMatcher.Match(actual)
+}
+
+func (m *upgradedOmegaMatcher) FailureMessage(actual any) string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.GomegaMatcher.FailureMessage(actual)
+}
+
+func (m *upgradedOmegaMatcher) NegatedFailureMessage(actual any) string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.GomegaMatcher.NegatedFailureMessage(actual)
+}
+
+// Children exposes the children of the wrapped matcher (if it's a composite one)
+func (m *upgradedOmegaMatcher) Children(actual any) []types.Child {
+	if cm, ok := m.GomegaMatcher.(types.CompositeMatcher); ok {
+		return cm.Children(actual)
+	}
+	return nil
 }
 
 // ExpectedStr2LinesRegex matches first 2 lines of standard gomega failure message
@@ -0,0 +1,125 @@
+package psi
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/expectto/be/internal/beformat"
+	"github.com/expectto/be/types"
+)
+
+// FromMatchFunc makes a matcher out of a match func. The optional message describes
+// what is expected (e.g. "be even"), failures then read as "Expected: <actual> to be even".
+// It's a native replacement of gomega's gcustom.MakeMatcher, with the same failure messages.
+func FromMatchFunc(match func(any) (bool, error), message ...string) types.BeMatcher {
+	if len(message) == 0 {
+		return &FuncMatcher{Name: "custom matcher", MatchFn: match, Template: gcustomTemplate}
+	}
+	return &FuncMatcher{Name: message[0], MatchFn: match, Template: gcustomMessageTemplate}
+}
+
+// gcustomTemplate and gcustomMessageTemplate render the failures the way gcustom does
+var (
+	gcustomTemplate = template.Must(template.New("gcustom").Parse(
+		`{{if eq .To "to"}}Custom matcher failed for:{{else}}Custom matcher succeeded (but was expected to fail) for:{{end}}` +
+			"\n{{.FormattedActual}}",
+	))
+	gcustomMessageTemplate = template.Must(template.New("gcustom").Parse("Expected:\n{{.FormattedActual}}\n{{.To}} {{.Name}}"))
+)
+
+// FuncMatcher is a matcher built from a match function (see be.MatcherFunc).
+// Name describes what is expected (e.g. "be even"), it's used in failure messages
+// and as the gomock description.
+type FuncMatcher struct {
+	Name     string
+	MatchFn  func(actual any) (bool, error)
+	Expected any
+	// HasExpected distinguishes "no expected value" from an expected nil
+	HasExpected bool
+	Template    *template.Template
+}
+
+var _ types.BeMatcher = &FuncMatcher{}
+
+// FuncMatcherTemplateData is the data a FuncMatcher's failure template is executed with
+type FuncMatcherTemplateData struct {
+	Name     string
+	Actual   any
+	Expected any
+	// To is "to" or "not to" depending on whether the failure is negated
+	To string
+	// FormattedActual and FormattedExpected are the values formatted as in regular failure messages
+	FormattedActual   string
+	FormattedExpected string
+}
+
+func NewFuncMatcher(name string, matchFn func(any) (bool, error)) *FuncMatcher {
+	return &FuncMatcher{Name: name, MatchFn: matchFn}
+}
+
+// Explain calls the match function once, so it's not re-run for the failure message
+func (matcher *FuncMatcher) Explain(actual any) types.Outcome {
+	success, err := matcher.MatchFn(actual)
+	return Outcome(success, err, func() string {
+		if success {
+			return matcher.message(actual, "not to")
+		}
+		return matcher.message(actual, "to")
+	})
+}
+
+func (matcher *FuncMatcher) Match(actual any) (bool, error) {
+	return matcher.MatchFn(actual)
+}
+
+func (matcher *FuncMatcher) FailureMessage(actual any) string {
+	return matcher.message(actual, "to")
+}
+
+func (matcher *FuncMatcher) NegatedFailureMessage(actual any) string {
+	return matcher.message(actual, "not to")
+}
+
+func (matcher *FuncMatcher) message(actual any, to string) string {
+	// an iterator is shown as the values it yields
+	shown, err := Collect(actual)
+	if err != nil {
+		shown = actual
+	}
+
+	if matcher.Template == nil {
+		if matcher.HasExpected {
+			return beformat.Message(shown, to+" "+matcher.Name, matcher.Expected)
+		}
+		return beformat.Message(shown, to+" "+matcher.Name)
+	}
+
+	data := FuncMatcherTemplateData{
+		Name:              matcher.Name,
+		Actual:            actual,
+		Expected:          matcher.Expected,
+		To:                to,
+		FormattedActual:   beformat.Object(shown, 1),
+		FormattedExpected: beformat.Object(matcher.Expected, 1),
+	}
+
+	var sb strings.Builder
+	if err := matcher.Template.Execute(&sb, data); err != nil {
+		return fmt.Sprintf("failed to render failure message template of %q: %s", matcher.Name, err)
+	}
+	return sb.String()
+}
+
+func (matcher *FuncMatcher) Matches(actual any) bool {
+	success, _ := matcher.Match(actual)
+	return success
+}
+
+// String describes the matcher for gomock, e.g. "be divisible by 3"
+func (matcher *FuncMatcher) String() string {
+	if matcher.HasExpected {
+		return fmt.Sprintf("%s %v", matcher.Name, matcher.Expected)
+	}
+	return matcher.Name
+}
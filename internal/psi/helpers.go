@@ -1,8 +1,6 @@
 package psi
 
 import (
-	"github.com/onsi/gomega"
-
 	"github.com/expectto/be/types"
 )
 
@@ -26,8 +24,18 @@ func AsMatcher(m any) types.BeMatcher {
 	case types.GomockMatcher:
 		return FromGomock(t)
 	default:
-		return FromGomega(gomega.Equal(t))
+		return NewEqMatcher(t)
+	}
+}
+
+// ChildrenOf returns given matchers as children applied to the same actual value
+// It's a helper for implementing types.CompositeMatcher on logical groups (All, Any, ...)
+func ChildrenOf(actual any, matchers ...types.BeMatcher) []types.Child {
+	children := make([]types.Child, len(matchers))
+	for i, m := range matchers {
+		children[i] = types.Child{Matcher: m, Actual: actual}
 	}
+	return children
 }
 
 // asGomegaMatchFunc returns given `m any` as match func (func (any) (bool, error))
@@ -0,0 +1,127 @@
+package psi
+
+import (
+	"fmt"
+	"reflect"
+	"sync/atomic"
+
+	"github.com/expectto/be/internal/beformat"
+)
+
+// Iterators (iter.Seq[T] and iter.Seq2[K, V]) are collections as well:
+// collection matchers collect them before matching, an iter.Seq into a []T
+// (so it's matched and shown like a slice) and an iter.Seq2 into its entries
+// (keys may repeat, the order is kept).
+//
+// An iterator may be infinite, so no more than IteratorLimit values are consumed:
+// a longer one is an error rather than a hanging test.
+// A matcher may evaluate actual more than once (e.g. Match, then FailureMessage),
+// so the iterator is expected to be reusable (as slices.Values or maps.All are).
+
+// DefaultIteratorLimit is the number of values an iterator may yield unless SetIteratorLimit changes it
+const DefaultIteratorLimit = 10_000
+
+var iteratorLimit atomic.Int64
+
+func init() { iteratorLimit.Store(DefaultIteratorLimit) }
+
+// IteratorLimit returns the number of values an iterator may yield to a matcher
+func IteratorLimit() int { return int(iteratorLimit.Load()) }
+
+// SetIteratorLimit sets the number of values an iterator may yield to a matcher,
+// a non-positive n restores the default one. The returned func restores the previous limit.
+func SetIteratorLimit(n int) (restore func()) {
+	if n <= 0 {
+		n = DefaultIteratorLimit
+	}
+	prev := iteratorLimit.Swap(int64(n))
+	return func() { iteratorLimit.Store(prev) }
+}
+
+// SeqEntry is a key-value pair yielded by an iter.Seq2.
+// It's a beformat.Entry, so collected entries are shown like a map in failure messages.
+type SeqEntry = beformat.Entry
+
+// IsSeq reports whether t is an iter.Seq: func(yield func(T) bool)
+func IsSeq(t reflect.Type) bool {
+	return isIterator(t, 1)
+}
+
+// IsSeq2 reports whether t is an iter.Seq2: func(yield func(K, V) bool)
+func IsSeq2(t reflect.Type) bool {
+	return isIterator(t, 2)
+}
+
+func isIterator(t reflect.Type, arity int) bool {
+	if t == nil || t.Kind() != reflect.Func || t.NumIn() != 1 || t.NumOut() != 0 {
+		return false
+	}
+	yield := t.In(0)
+	return yield.Kind() == reflect.Func && yield.NumIn() == arity &&
+		yield.NumOut() == 1 && yield.Out(0).Kind() == reflect.Bool
+}
+
+// SeqValues collects an iter.Seq into a slice of its element type ([]T).
+// ok is false if actual is not an iter.Seq. A nil iter.Seq is an empty one.
+func SeqValues(actual any) (values any, ok bool, err error) {
+	if !IsSeq(reflect.TypeOf(actual)) {
+		return nil, false, nil
+	}
+	rv := reflect.ValueOf(actual)
+	slice := reflect.MakeSlice(reflect.SliceOf(rv.Type().In(0).In(0)), 0, 0)
+	err = consume(rv, func(args []reflect.Value) {
+		slice = reflect.Append(slice, args[0])
+	})
+	return slice.Interface(), true, err
+}
+
+// SeqEntries collects an iter.Seq2 into its entries.
+// ok is false if actual is not an iter.Seq2. A nil iter.Seq2 is an empty one.
+func SeqEntries(actual any) (entries []SeqEntry, ok bool, err error) {
+	if !IsSeq2(reflect.TypeOf(actual)) {
+		return nil, false, nil
+	}
+	rv := reflect.ValueOf(actual)
+	entries = []SeqEntry{}
+	err = consume(rv, func(args []reflect.Value) {
+		entries = append(entries, SeqEntry{Key: args[0].Interface(), Value: args[1].Interface()})
+	})
+	return entries, true, err
+}
+
+// Collect collects an iterator (see SeqValues and SeqEntries),
+// any other actual is returned as it is
+func Collect(actual any) (any, error) {
+	if values, ok, err := SeqValues(actual); ok {
+		return values, err
+	}
+	if entries, ok, err := SeqEntries(actual); ok {
+		return entries, err
+	}
+	return actual, nil
+}
+
+// consume calls the iterator, passing each yielded value to add,
+// and stops it with an error once it exceeds the limit
+func consume(seq reflect.Value, add func(args []reflect.Value)) error {
+	if seq.IsNil() {
+		return nil
+	}
+
+	limit, n := IteratorLimit(), 0
+	yield := reflect.MakeFunc(seq.Type().In(0), func(args []reflect.Value) []reflect.Value {
+		if n == limit {
+			n++
+			return []reflect.Value{reflect.ValueOf(false)}
+		}
+		n++
+		add(args)
+		return []reflect.Value{reflect.ValueOf(true)}
+	})
+	seq.Call([]reflect.Value{yield})
+
+	if n > limit {
+		return fmt.Errorf("%s yielded more than %d values (the limit is set by be.SetSeqLimit)", seq.Type(), limit)
+	}
+	return nil
+}
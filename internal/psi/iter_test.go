@@ -0,0 +1,65 @@
+package psi_test
+
+import (
+	"iter"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/expectto/be"
+	"github.com/expectto/be/be_string"
+	"github.com/expectto/be/internal/psi"
+)
+
+// naturals is an infinite iterator
+func naturals(yield func(int) bool) {
+	for i := 0; yield(i); i++ {
+	}
+}
+
+func TestCollectIterators(t *testing.T) {
+	values, err := psi.Collect(slices.Values([]int{1, 2}))
+	be.Expect(t, err).NotTo(be.HaveOccurred())
+	be.Expect(t, values).To(be.Eq([]int{1, 2}))
+
+	entries, err := psi.Collect(slices.All([]string{"a", "b"}))
+	be.Expect(t, err).NotTo(be.HaveOccurred())
+	be.Expect(t, entries).To(be.Eq([]psi.SeqEntry{{Key: 0, Value: "a"}, {Key: 1, Value: "b"}}))
+
+	var nilSeq iter.Seq[int]
+	values, err = psi.Collect(nilSeq)
+	be.Expect(t, err).NotTo(be.HaveOccurred())
+	be.Expect(t, values).To(be.Empty())
+
+	// anything else is returned as it is
+	be.ExpectValues(t)(psi.Collect(42)).To(be.Eq(42))
+}
+
+func TestCollectStopsAtTheLimit(t *testing.T) {
+	defer psi.SetIteratorLimit(5)()
+
+	_, err := psi.Collect(iter.Seq[int](naturals))
+	be.Expect(t, err).To(be.MatchError(be.ContainSubstring("yielded more than 5 values")))
+
+	_, err = psi.Collect(slices.Values([]int{1, 2, 3, 4, 5}))
+	be.Expect(t, err).NotTo(be.HaveOccurred())
+}
+
+func TestDiveOverIterators(t *testing.T) {
+	every := psi.NewDiveMatcher(gt0(), psi.DiveModeEvery)
+	be.ExpectValues(t)(every.Match(slices.Values([]int{1, 2}))).To(be.True())
+	be.Expect(t, every.FailureMessage(slices.Values([]int{1, -2}))).To(be_string.HavingPrefix("at [1]:"))
+
+	// iter.Seq2 is pointed at by its keys, and it's ordered: positional modes are fine
+	last := psi.NewDiveMatcher(gt0(), psi.DiveModeLast)
+	be.Expect(t, last.FailureMessage(slices.All([]int{1, -2}))).To(be_string.HavingPrefix("at [1]:"))
+	be.ExpectValues(t)(last.Match(maps.All(map[string]int{"a": 1}))).To(be.True())
+
+	keys := psi.NewDiveKeysMatcher(be_string.HavingPrefix("x-"))
+	be.ExpectValues(t)(keys.Match(maps.All(map[string]int{"x-a": 1}))).To(be.True())
+	_, err := keys.Match(slices.Values([]string{"x-a"}))
+	be.Expect(t, err).To(be.HaveOccurred())
+
+	_, err = every.Match(iter.Seq[int](naturals))
+	be.Expect(t, err).To(be.MatchError(be.ContainSubstring("yielded more than")))
+}
@@ -0,0 +1,113 @@
+package psi
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/expectto/be/types"
+)
+
+// Failure paths: composite matchers (request -> body -> json -> key -> index)
+// prepend a path segment to the failure message of their failing child, so a
+// deeply nested mismatch reads as
+//
+//	at request.body.json["details"][1]["key"]:
+//	Expected ...
+//
+// The path travels inside the message itself (as its first line) rather than
+// through an extra interface: gomega wrappers like And/WithTransform forward
+// the inner message verbatim, so the path survives any level of wrapping.
+
+// pathHeader matches the path line that WithPathSegment puts on top of a message
+var pathHeader = regexp.MustCompile(`^at ([^\n]+):\n`)
+
+// WithPathSegment prepends the given path segment to the failure message.
+// If the message already carries a path, the segment is joined in front of it.
+func WithPathSegment(segment, msg string) string {
+	if segment == "" {
+		return msg
+	}
+
+	path, rest := SplitPath(msg)
+	return "at " + JoinPath(segment, path) + ":\n" + rest
+}
+
+// SplitPath splits a failure message into its path (if any) and the rest of the message
+func SplitPath(msg string) (path, rest string) {
+	m := pathHeader.FindStringSubmatch(msg)
+	if m == nil {
+		return "", msg
+	}
+	return m[1], msg[len(m[0]):]
+}
+
+// JoinPath joins two path segments: named segments are separated by a dot,
+// index-like segments (`[1]`, `["key"]`) are appended as is.
+func JoinPath(parent, child string) string {
+	switch {
+	case parent == "":
+		return child
+	case child == "":
+		return parent
+	case strings.HasPrefix(child, "["):
+		return parent + child
+	default:
+		return parent + "." + child
+	}
+}
+
+// IndexSegment returns a path segment for the given slice index or map key:
+// `[1]` for integers, `["key"]` for strings
+func IndexSegment(key any) string {
+	if s, ok := key.(string); ok {
+		return fmt.Sprintf("[%q]", s)
+	}
+	return fmt.Sprintf("[%v]", key)
+}
+
+// WithPath wraps the given matcher so its failure messages are prefixed by the path segment.
+// It's used by composites that step into a named part of the actual value (e.g. "request", "json").
+func WithPath(segment string, matcher types.BeMatcher) types.BeMatcher {
+	return &pathMatcher{segment: segment, matcher: matcher}
+}
+
+// pathMatcher is a transparent decorator adding a path segment to failure messages
+type pathMatcher struct {
+	segment string
+	matcher types.BeMatcher
+}
+
+func (pm *pathMatcher) Explain(actual any) types.Outcome {
+	return AtPath(pm.segment, Explain(pm.matcher, actual))
+}
+
+// LastOutcome recalls the last evaluation of the decorated matcher (if it remembers one)
+func (pm *pathMatcher) LastOutcome(actual any) (types.Outcome, bool) {
+	o, ok := LastOutcome(pm.matcher, actual)
+	return AtPath(pm.segment, o), ok
+}
+
+func (pm *pathMatcher) Match(actual any) (bool, error) {
+	return pm.matcher.Match(actual)
+}
+
+func (pm *pathMatcher) FailureMessage(actual any) string {
+	return WithPathSegment(pm.segment, pm.matcher.FailureMessage(actual))
+}
+
+func (pm *pathMatcher) NegatedFailureMessage(actual any) string {
+	return WithPathSegment(pm.segment, pm.matcher.NegatedFailureMessage(actual))
+}
+
+func (pm *pathMatcher) Matches(actual any) bool {
+	return pm.matcher.Matches(actual)
+}
+
+func (pm *pathMatcher) String() string {
+	return pm.matcher.String()
+}
+
+func (pm *pathMatcher) Children(actual any) []types.Child {
+	return []types.Child{{Segment: pm.segment, Matcher: pm.matcher, Actual: actual}}
+}
@@ -0,0 +1,25 @@
+package psi_test
+
+import (
+	"testing"
+
+	"github.com/expectto/be"
+	"github.com/expectto/be/internal/psi"
+)
+
+func TestWithPathSegmentJoinsNestedSegments(t *testing.T) {
+	msg := psi.WithPathSegment(`[1]`, "Expected\n    <int>: 3\nto be > 5")
+	msg = psi.WithPathSegment(`["details"]`, msg)
+	msg = psi.WithPathSegment("json", msg)
+	msg = psi.WithPathSegment("body", msg)
+
+	path, rest := psi.SplitPath(msg)
+	be.Expect(t, path).To(be.Eq(`body.json["details"][1]`))
+	be.Expect(t, rest).To(be.Eq("Expected\n    <int>: 3\nto be > 5"))
+}
+
+func TestSplitPathWithoutPath(t *testing.T) {
+	path, rest := psi.SplitPath("Expected\n    <int>: 3\nto be > 5")
+	be.Expect(t, path).To(be.Empty())
+	be.Expect(t, rest).To(be.Eq("Expected\n    <int>: 3\nto be > 5"))
+}
@@ -4,14 +4,12 @@
 //
 // Package psi is considered as internal package to be used only inside `be`
 // It's a core functionality that upgrades any matcher to be a `be` matcher
-// Also it contains some core matchers and upgraded things from `gomega/gcustom` package
+// Also it contains some core matchers and native ports of things from `gomega/gcustom` package
 package psi
 
 import (
 	"strings"
 
-	"github.com/onsi/gomega/gcustom"
-
 	"github.com/expectto/be/types"
 )
 
@@ -31,7 +29,7 @@ func Psi(args ...any) types.BeMatcher {
 
 		// If a Match Func was given: simply wrap it in a matcher
 		if mf := asGomegaMatchFunc(arg); mf != nil {
-			return AsMatcher(gcustom.MakeMatcher(mf))
+			return FromMatchFunc(mf)
 		}
 
 		return AsMatcher(args[0])
@@ -46,9 +44,9 @@ func Psi(args ...any) types.BeMatcher {
 		message, ok := args[1].(string)
 		if ok {
 			if mf := asGomegaMatchFunc(arg); mf != nil {
-				return AsMatcher(gcustom.MakeMatcher(asGomegaMatchFunc(arg), message))
+				return FromMatchFunc(mf, message)
 			} else if IsMatcher(arg) {
-				return AsMatcher(gcustom.MakeMatcher(AsMatcher(arg).Match, message))
+				return FromMatchFunc(AsMatcher(arg).Match, message)
 			}
 		}
 	}
@@ -78,44 +76,48 @@ func Psi(args ...any) types.BeMatcher {
 type allMatcher struct {
 	matchers []types.BeMatcher
 
-	// state
-	firstFailedMatcher types.BeMatcher
-	allSucceedMatchers []types.BeMatcher
+	// last remembers the last evaluation, so failure messages don't evaluate side-effecting matchers anew
+	last Memo[types.Outcome]
 }
 
-func (m *allMatcher) Match(actual any) (bool, error) {
-	m.firstFailedMatcher = nil
-	m.allSucceedMatchers = make([]types.BeMatcher, 0)
+func (m *allMatcher) Explain(actual any) types.Outcome {
+	return m.last.Remember(actual, m.explain(actual))
+}
+
+func (m *allMatcher) explain(actual any) types.Outcome {
+	messages := make([]string, 0, len(m.matchers))
 	for _, matcher := range m.matchers {
-		success, err := matcher.Match(actual)
-		if !success || err != nil {
-			m.firstFailedMatcher = matcher
-			return false, err
-		} else {
-			m.allSucceedMatchers = append(m.allSucceedMatchers, matcher)
+		o := Explain(matcher, actual)
+		if !o.Success {
+			return o
 		}
+		messages = append(messages, o.Message)
 	}
-	return true, nil
+	// todo: make it nicer
+	return Succeeded(strings.Join(messages, "\n and \n"))
+}
+
+// LastOutcome returns the outcome of the last evaluation of actual
+func (m *allMatcher) LastOutcome(actual any) (types.Outcome, bool) {
+	return m.last.Recall(actual)
+}
+
+func (m *allMatcher) Match(actual any) (bool, error) {
+	o := m.Explain(actual)
+	return o.Success, o.Err
 }
 
 func (m *allMatcher) FailureMessage(actual any) string {
-	return m.firstFailedMatcher.FailureMessage(actual)
+	return Recall(m, actual).Message
 }
 
 func (m *allMatcher) NegatedFailureMessage(actual any) string {
-	// todo: make it nicer
-	messages := make([]string, len(m.allSucceedMatchers))
-	for i, m := range m.allSucceedMatchers {
-		messages[i] = m.NegatedFailureMessage(actual)
-	}
-	return strings.Join(messages, "\n and \n")
+	return Recall(m, actual).Message
 }
 
 func (m *allMatcher) Matches(actual any) bool {
-	m.firstFailedMatcher = nil
 	for _, matcher := range m.matchers {
 		if !matcher.Matches(actual) {
-			m.firstFailedMatcher = matcher
 			return false
 		}
 	}
@@ -123,7 +125,20 @@ func (m *allMatcher) Matches(actual any) bool {
 }
 
 func (m *allMatcher) String() string {
-	return m.firstFailedMatcher.String()
+	descriptions := make([]string, len(m.matchers))
+	for i, matcher := range m.matchers {
+		descriptions[i] = matcher.String()
+	}
+	return strings.Join(descriptions, " and ")
+}
+
+func (m *allMatcher) Children(actual any) []types.Child {
+	return ChildrenOf(actual, m.matchers...)
+}
+
+// NewPollSession starts the poll sessions of the matchers consuming actual (see PollSessions)
+func (m *allMatcher) NewPollSession() types.BeMatcher {
+	return &allMatcher{matchers: PollSessions(m.matchers...)}
 }
 
 // todo: allMatcher.MatchMayChangeInTheFuture
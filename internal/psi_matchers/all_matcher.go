@@ -4,8 +4,9 @@ package psi_matchers
 
 import (
 	"fmt"
+	"strings"
 
-	"github.com/onsi/gomega/format"
+	"github.com/expectto/be/internal/beformat"
 
 	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
 	"github.com/expectto/be/types"
@@ -16,11 +17,16 @@ import (
 type AllMatcher struct {
 	Matchers []types.BeMatcher
 
-	// state
-	firstFailedMatcher types.BeMatcher
+	// Path is an optional path segment (e.g. "json") prepended to failure messages
+	Path string
+
+	// last remembers the last evaluation, so FailureMessage doesn't evaluate side-effecting matchers anew
+	last Memo[types.Outcome]
 }
 
 var _ types.BeMatcher = &AllMatcher{}
+var _ types.RememberingMatcher = &AllMatcher{}
+var _ types.PollingMatcher = &AllMatcher{}
 
 func NewAllMatcher(ms ...any) *AllMatcher {
 	matchers := make([]types.BeMatcher, len(ms))
@@ -31,32 +37,45 @@ func NewAllMatcher(ms ...any) *AllMatcher {
 	return &AllMatcher{Matchers: matchers}
 }
 
-func (m *AllMatcher) Match(actual any) (bool, error) {
-	m.firstFailedMatcher = nil
+func (m *AllMatcher) Explain(actual any) types.Outcome {
+	return m.last.Remember(actual, m.explain(actual))
+}
+
+func (m *AllMatcher) explain(actual any) types.Outcome {
 	for _, matcher := range m.Matchers {
-		success, err := matcher.Match(actual)
-		if !success || err != nil {
-			m.firstFailedMatcher = matcher
-			return false, err
+		if o := Explain(matcher, actual); !o.Success {
+			return AtPath(m.Path, o)
 		}
 	}
-	return true, nil
+	return Succeeded(m.NegatedFailureMessage(actual))
 }
 
+// LastOutcome returns the outcome of the last evaluation of actual
+func (m *AllMatcher) LastOutcome(actual any) (types.Outcome, bool) {
+	return m.last.Recall(actual)
+}
+
+func (m *AllMatcher) Match(actual any) (bool, error) {
+	o := m.Explain(actual)
+	return o.Success, o.Err
+}
+
+// FailureMessage explains the failure of the first failed matcher
 func (m *AllMatcher) FailureMessage(actual any) string {
-	return m.firstFailedMatcher.FailureMessage(actual)
+	return Recall(m, actual).Message
 }
 
 func (m *AllMatcher) NegatedFailureMessage(actual any) string {
 	// not the most beautiful list of matchers, but not bad either...
-	return format.Message(actual, fmt.Sprintf("To not satisfy all of these matchers: %s", m.Matchers))
+	return WithPathSegment(
+		m.Path,
+		beformat.Message(actual, fmt.Sprintf("To not satisfy all of these matchers: %s", m.Matchers)),
+	)
 }
 
 func (m *AllMatcher) Matches(actual any) bool {
-	m.firstFailedMatcher = nil
 	for _, matcher := range m.Matchers {
 		if !matcher.Matches(actual) {
-			m.firstFailedMatcher = matcher
 			return false
 		}
 	}
@@ -64,7 +83,25 @@ func (m *AllMatcher) Matches(actual any) bool {
 }
 
 func (m *AllMatcher) String() string {
-	return m.firstFailedMatcher.String()
+	descriptions := make([]string, len(m.Matchers))
+	for i, matcher := range m.Matchers {
+		descriptions[i] = matcher.String()
+	}
+	return strings.Join(descriptions, " and ")
+}
+
+func (m *AllMatcher) Children(actual any) []types.Child {
+	children := ChildrenOf(actual, m.Matchers...)
+	for i := range children {
+		children[i].Segment = m.Path
+	}
+	return children
+}
+
+// NewPollSession starts the poll sessions of the matchers consuming actual (e.g. be.ReceiveAll),
+// so they keep what was consumed across the polls of be.Eventually / be.Consistently
+func (m *AllMatcher) NewPollSession() types.BeMatcher {
+	return &AllMatcher{Matchers: PollSessions(m.Matchers...), Path: m.Path}
 }
 
 // todo: AllMatcher.MatchMayChangeInTheFuture
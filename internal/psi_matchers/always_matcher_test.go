@@ -1,45 +1,46 @@
 package psi_matchers_test
 
 import (
-	. "github.com/onsi/ginkgo/v2"
-	. "github.com/onsi/gomega"
+	"testing"
 
+	"github.com/expectto/be"
 	. "github.com/expectto/be/internal/psi_matchers"
-	"github.com/expectto/be/types"
 )
 
-var _ = Describe("AlwaysMatcher", func() {
-	var matcher types.BeMatcher
+func TestAlwaysMatcher(t *testing.T) {
+	matcher := NewAlwaysMatcher()
 
-	BeforeEach(func() {
-		matcher = NewAlwaysMatcher()
-	})
-
-	Context("Matching", func() {
-		DescribeTable("Always match",
-			func(actual any) {
-				success, err := matcher.Match(actual)
-				Expect(success).To(BeTrue())
-				Expect(err).NotTo(HaveOccurred())
+	t.Run("Matching", func(t *testing.T) {
+		t.Run("Always match", func(t *testing.T) {
+			for _, tc := range []struct {
+				name   string
+				actual any
+			}{
+				{"nil", nil},
+				{"string", "foobar"},
+				{"zero", 0},
+				{"positive int", 5},
+				{"negative int", -5},
+				{"bool true", true},
+				{"bool false", false},
+				{"empty slice", []any{}},
+				{"string slice", []string{"foo", "bar"}},
+				{"empty map", map[string]any{}},
+				{"filled map", map[string]any{"foo": "bar"}},
+			} {
+				t.Run(tc.name, func(t *testing.T) {
+					success, err := matcher.Match(tc.actual)
+					be.Expect(t, success).To(be.True())
+					be.Expect(t, err).NotTo(be.HaveOccurred())
 
-				success = matcher.Matches(actual)
-				Expect(success).To(BeTrue())
+					success = matcher.Matches(tc.actual)
+					be.Expect(t, success).To(be.True())
 
-				Expect(matcher.FailureMessage(actual)).To(BeEmpty())
-				Expect(matcher.NegatedFailureMessage(actual)).To(BeEmpty())
-				Expect(matcher.String()).To(BeEmpty())
-			},
-			Entry("nil", nil),
-			Entry("string", "foobar"),
-			Entry("zero", 0),
-			Entry("positive int", 5),
-			Entry("negative int", -5),
-			Entry("bool true", true),
-			Entry("bool false", false),
-			Entry("empty slice", []any{}),
-			Entry("string slice", []string{"foo", "bar"}),
-			Entry("empty map", map[string]any{}),
-			Entry("filled map", map[string]any{"foo": "bar"}),
-		)
+					be.Expect(t, matcher.FailureMessage(tc.actual)).To(be.Empty())
+					be.Expect(t, matcher.NegatedFailureMessage(tc.actual)).To(be.Empty())
+					be.Expect(t, matcher.String()).To(be.Empty())
+				})
+			}
+		})
 	})
-})
+}
@@ -2,8 +2,9 @@ package psi_matchers
 
 import (
 	"fmt"
+	"strings"
 
-	"github.com/onsi/gomega/format"
+	"github.com/expectto/be/internal/beformat"
 
 	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
 	"github.com/expectto/be/types"
@@ -13,11 +14,13 @@ import (
 type AnyMatcher struct {
 	Matchers []types.BeMatcher
 
-	// state
-	firstSuccessfulMatcher types.BeMatcher
+	// last remembers the last evaluation (see AllMatcher)
+	last Memo[types.Outcome]
 }
 
 var _ types.BeMatcher = &AnyMatcher{}
+var _ types.RememberingMatcher = &AnyMatcher{}
+var _ types.PollingMatcher = &AnyMatcher{}
 
 func NewAnyMatcher(ms ...any) *AnyMatcher {
 	matchers := make([]types.BeMatcher, len(ms))
@@ -28,43 +31,63 @@ func NewAnyMatcher(ms ...any) *AnyMatcher {
 	return &AnyMatcher{Matchers: matchers}
 }
 
-func (m *AnyMatcher) Match(actual any) (bool, error) {
-	m.firstSuccessfulMatcher = nil
+func (m *AnyMatcher) Explain(actual any) types.Outcome {
+	return m.last.Remember(actual, m.explain(actual))
+}
+
+func (m *AnyMatcher) explain(actual any) types.Outcome {
 	for _, matcher := range m.Matchers {
-		currentSuccess, err := matcher.Match(actual)
-		if err != nil {
-			return false, err
-		}
-		if currentSuccess {
-			m.firstSuccessfulMatcher = matcher
-			return true, nil
+		// the first successful matcher explains why the negated assertion fails
+		if o := Explain(matcher, actual); o.Success || o.Err != nil {
+			return o
 		}
 	}
-	return false, nil
+	return Failed(m.FailureMessage(actual))
+}
+
+// LastOutcome returns the outcome of the last evaluation of actual
+func (m *AnyMatcher) LastOutcome(actual any) (types.Outcome, bool) {
+	return m.last.Recall(actual)
+}
+
+func (m *AnyMatcher) Match(actual any) (bool, error) {
+	o := m.Explain(actual)
+	return o.Success, o.Err
 }
 
 func (m *AnyMatcher) FailureMessage(actual any) string {
 	// not the most beautiful list of matchers, but not bad either...
-	return format.Message(actual, fmt.Sprintf("To satisfy at least one of these matchers: %s", m.Matchers))
+	return beformat.Message(actual, fmt.Sprintf("To satisfy at least one of these matchers: %s", m.Matchers))
 }
 
 func (m *AnyMatcher) NegatedFailureMessage(actual any) string {
-	return m.firstSuccessfulMatcher.NegatedFailureMessage(actual)
+	return Recall(m, actual).Message
 }
 
 // todo: MatchMayChangeInTheFuture
 
 func (m *AnyMatcher) Matches(actual any) bool {
-	m.firstSuccessfulMatcher = nil
 	for _, matcher := range m.Matchers {
-		if !matcher.Matches(actual) {
-			m.firstSuccessfulMatcher = matcher
-			return false
+		if matcher.Matches(actual) {
+			return true
 		}
 	}
-	return true
+	return false
 }
 
 func (m *AnyMatcher) String() string {
-	return m.firstSuccessfulMatcher.String()
+	descriptions := make([]string, len(m.Matchers))
+	for i, matcher := range m.Matchers {
+		descriptions[i] = matcher.String()
+	}
+	return strings.Join(descriptions, " or ")
+}
+
+func (m *AnyMatcher) Children(actual any) []types.Child {
+	return ChildrenOf(actual, m.Matchers...)
+}
+
+// NewPollSession starts the poll sessions of the matchers consuming actual (see AllMatcher.NewPollSession)
+func (m *AnyMatcher) NewPollSession() types.BeMatcher {
+	return &AnyMatcher{Matchers: PollSessions(m.Matchers...)}
 }
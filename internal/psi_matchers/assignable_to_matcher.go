@@ -3,7 +3,7 @@ package psi_matchers
 import (
 	"reflect"
 
-	"github.com/onsi/gomega/format"
+	"github.com/expectto/be/internal/beformat"
 
 	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
 	"github.com/expectto/be/types"
@@ -35,9 +35,9 @@ func (matcher *AssignableToMatcher) Match(actual any) (bool, error) {
 }
 
 func (matcher *AssignableToMatcher) FailureMessage(actual any) string {
-	return format.Message(actual, "to be assignable to: "+matcher.assignableTo.String())
+	return beformat.Message(actual, "to be assignable to: "+matcher.assignableTo.String())
 }
 
 func (matcher *AssignableToMatcher) NegatedFailureMessage(actual any) string {
-	return format.Message(actual, "not to be assignable to: "+matcher.assignableTo.String())
+	return beformat.Message(actual, "not to be assignable to: "+matcher.assignableTo.String())
 }
@@ -0,0 +1,106 @@
+package psi_matchers
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/expectto/be/internal/beformat"
+	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
+)
+
+// Basic matchers are plain FuncMatchers: native ports of gomega's matchers
+// (BeNil, BeTrue, BeZero, BeEmpty, ...) with the same semantics and failure messages.
+
+// NewNilMatcher matches nil, a nil inside an interface included
+func NewNilMatcher() *FuncMatcher {
+	return NewFuncMatcher("be nil", func(actual any) (bool, error) {
+		if actual == nil {
+			return true, nil
+		}
+		switch rv := reflect.ValueOf(actual); rv.Kind() {
+		case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Pointer, reflect.Slice, reflect.UnsafePointer:
+			return rv.IsNil(), nil
+		default:
+			return false, nil
+		}
+	})
+}
+
+// NewBoolMatcher matches the given boolean, a non-boolean is an error
+func NewBoolMatcher(expected bool) *FuncMatcher {
+	return NewFuncMatcher(fmt.Sprintf("be %t", expected), func(actual any) (bool, error) {
+		b, ok := actual.(bool)
+		if !ok {
+			return false, fmt.Errorf("Expected a boolean.  Got:\n%s", beformat.Object(actual, 1))
+		}
+		return b == expected, nil
+	})
+}
+
+// NewZeroMatcher matches the zero value of any type
+func NewZeroMatcher() *FuncMatcher {
+	return NewFuncMatcher("be zero-valued", func(actual any) (bool, error) {
+		return actual == nil || reflect.ValueOf(actual).IsZero(), nil
+	})
+}
+
+// NewEmptyMatcher matches a zero-length string, slice, array, map or channel,
+// or an iterator yielding nothing
+func NewEmptyMatcher() *FuncMatcher {
+	return NewFuncMatcher("be empty", func(actual any) (bool, error) {
+		length, _, ok, err := lengthOf(actual)
+		if err != nil {
+			return false, err
+		}
+		if !ok {
+			return false, fmt.Errorf(
+				"BeEmpty matcher expects a string/array/map/channel/slice/iterator.  Got:\n%s", beformat.Object(actual, 1),
+			)
+		}
+		return length == 0, nil
+	})
+}
+
+// NewContainSubstringMatcher matches a string (or []byte, or fmt.Stringer) containing the substring
+func NewContainSubstringMatcher(substr string) *FuncMatcher {
+	matcher := NewFuncMatcher("contain substring", func(actual any) (bool, error) {
+		var s string
+		switch v := actual.(type) {
+		case string:
+			s = v
+		case []byte:
+			s = string(v)
+		case fmt.Stringer:
+			s = v.String()
+		default:
+			return false, fmt.Errorf(
+				"ContainSubstring matcher requires a string or stringer.  Got:\n%s", beformat.Object(actual, 1),
+			)
+		}
+		return strings.Contains(s, substr), nil
+	})
+	matcher.Expected, matcher.HasExpected = substr, true
+	return matcher
+}
+
+// NewIdenticalMatcher matches a value identical to the expected one via Go's == operator
+func NewIdenticalMatcher(expected any) *FuncMatcher {
+	matcher := NewFuncMatcher("be identical to", func(actual any) (success bool, err error) {
+		if actual == nil && expected == nil {
+			return false, errors.New(
+				"refusing to compare <nil> to <nil> - Be explicit and use BeNil() instead.  This is to avoid mistakes where both sides of an assertion are erroneously uninitialized",
+			)
+		}
+		defer func() {
+			// comparing uncomparable values (e.g. slices in interfaces) panics
+			if r := recover(); r != nil {
+				success, err = false, fmt.Errorf("Identical matcher can't compare the values: %v", r)
+			}
+		}()
+		return actual == expected, nil
+	})
+	matcher.Expected, matcher.HasExpected = expected, true
+	return matcher
+}
@@ -0,0 +1,89 @@
+package psi_matchers
+
+import (
+	"fmt"
+	"reflect"
+
+	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
+	"github.com/expectto/be/types"
+)
+
+// CaptureMatcher delegates to the given matcher and, whenever it succeeds,
+// stores the actual value into the destination. Failure messages and the
+// gomock description are the ones of the wrapped matcher.
+type CaptureMatcher struct {
+	*MixinMatcherGomock
+
+	matcher types.BeMatcher
+	dst     reflect.Value // the element of the destination pointer
+}
+
+var _ types.BeMatcher = &CaptureMatcher{}
+
+// NewCaptureMatcher creates a CaptureMatcher storing matched values into dst.
+// With no args any value assignable to T is captured.
+func NewCaptureMatcher[T any](dst *T, args ...any) *CaptureMatcher {
+	if dst == nil {
+		panic("Capture expects a non-nil destination pointer")
+	}
+
+	matcher := &CaptureMatcher{matcher: Psi(args...), dst: reflect.ValueOf(dst).Elem()}
+	matcher.MixinMatcherGomock = NewMixinMatcherGomock(matcher, "Capture")
+
+	return matcher
+}
+
+// Explain stores the actual value only when the wrapped matcher succeeds,
+// so a failing assertion leaves the destination untouched
+func (matcher *CaptureMatcher) Explain(actual any) types.Outcome {
+	v := reflect.ValueOf(actual)
+	switch {
+	case actual == nil:
+		if !isNillable(matcher.dst.Type()) {
+			return Errored(fmt.Errorf("Capture can't store nil into %s", matcher.dst.Type()))
+		}
+		v = reflect.Zero(matcher.dst.Type())
+	case !v.Type().AssignableTo(matcher.dst.Type()):
+		return Errored(fmt.Errorf("Capture expects a value assignable to %s, got %T", matcher.dst.Type(), actual))
+	}
+
+	o := Explain(matcher.matcher, actual)
+	if o.Success && o.Err == nil {
+		matcher.dst.Set(v)
+	}
+	return o
+}
+
+func isNillable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+		return true
+	default:
+		return false
+	}
+}
+
+func (matcher *CaptureMatcher) Match(actual any) (bool, error) {
+	o := matcher.Explain(actual)
+	return o.Success, o.Err
+}
+
+func (matcher *CaptureMatcher) FailureMessage(actual any) string {
+	return matcher.Explain(actual).Message
+}
+
+func (matcher *CaptureMatcher) NegatedFailureMessage(actual any) string {
+	return matcher.Explain(actual).Message
+}
+
+// String is the description of the wrapped matcher: capturing is transparent
+func (matcher *CaptureMatcher) String() string {
+	if s := matcher.matcher.String(); s != "" {
+		return s
+	}
+	return "capture any value"
+}
+
+func (matcher *CaptureMatcher) Children(actual any) []types.Child {
+	return ChildrenOf(actual, matcher.matcher)
+}
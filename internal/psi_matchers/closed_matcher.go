@@ -0,0 +1,68 @@
+package psi_matchers
+
+import (
+	"github.com/expectto/be/internal/beformat"
+	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
+	"github.com/expectto/be/types"
+)
+
+// ClosedMatcher matches a closed channel.
+// Telling it requires receiving from the channel: a value that is there
+// (so the channel is open) is consumed, as with gomega.BeClosed.
+type ClosedMatcher struct {
+	*MixinMatcherGomock
+
+	// last is the outcome of the last evaluation: telling consumes the channel
+	last Memo[types.Outcome]
+}
+
+var _ types.BeMatcher = &ClosedMatcher{}
+var _ types.RememberingMatcher = &ClosedMatcher{}
+
+func NewClosedMatcher() *ClosedMatcher {
+	matcher := &ClosedMatcher{}
+	matcher.MixinMatcherGomock = NewMixinMatcherGomock(matcher, "BeClosed")
+	return matcher
+}
+
+func (matcher *ClosedMatcher) Explain(actual any) types.Outcome {
+	return matcher.last.Remember(actual, matcher.explain(actual))
+}
+
+// LastOutcome returns the outcome of the last evaluation of actual, so explaining it doesn't receive anew
+func (matcher *ClosedMatcher) LastOutcome(actual any) (types.Outcome, bool) {
+	return matcher.last.Recall(actual)
+}
+
+func (matcher *ClosedMatcher) explain(actual any) types.Outcome {
+	ch, err := receivingChan(actual, "BeClosed")
+	if err != nil {
+		return Errored(err)
+	}
+
+	v, status := receive(ch, 0)
+	switch status {
+	case receivedClosed:
+		return Succeeded(beformat.Message(actual, "to be open"))
+	case receivedValue:
+		return Failed(beformat.Message(actual, "to be closed, but received "+beformat.Value(v)))
+	}
+	return Failed(beformat.Message(actual, "to be closed"))
+}
+
+func (matcher *ClosedMatcher) Match(actual any) (bool, error) {
+	o := matcher.Explain(actual)
+	return o.Success, o.Err
+}
+
+func (matcher *ClosedMatcher) FailureMessage(actual any) string {
+	return Recall(matcher, actual).Message
+}
+
+func (matcher *ClosedMatcher) NegatedFailureMessage(actual any) string {
+	return Recall(matcher, actual).Message
+}
+
+func (matcher *ClosedMatcher) String() string {
+	return "be closed"
+}
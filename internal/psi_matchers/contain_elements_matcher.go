@@ -0,0 +1,274 @@
+package psi_matchers
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/expectto/be/internal/beformat"
+	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
+	"github.com/expectto/be/types"
+)
+
+// ContainElementsMatcher matches a slice, array or map (by its values) containing
+// an element for each of the given values or matchers, in any order.
+// Every element is matched by a distinct item of the collection.
+type ContainElementsMatcher struct {
+	*MixinMatcherGomock
+
+	elements []any
+	matchers []types.BeMatcher
+
+	// single switches the failure messages to the ones of ContainElement
+	single bool
+
+	// exact requires every item to be paired with an element as well (ConsistOf)
+	exact bool
+}
+
+var _ types.BeMatcher = &ContainElementsMatcher{}
+
+// NewContainElementsMatcher creates a ContainElementsMatcher.
+// A single slice or array is taken as the list of elements.
+func NewContainElementsMatcher(elements ...any) *ContainElementsMatcher {
+	return newContainElementsMatcher(flattenElements(elements))
+}
+
+func newContainElementsMatcher(elements []any) *ContainElementsMatcher {
+	matcher := &ContainElementsMatcher{elements: elements, matchers: make([]types.BeMatcher, len(elements))}
+	matcher.MixinMatcherGomock = NewMixinMatcherGomock(matcher, "ContainElements")
+
+	for i, el := range elements {
+		matcher.matchers[i] = Psi(el)
+	}
+
+	return matcher
+}
+
+// NewContainElementMatcher creates a ContainElementsMatcher for a single element
+func NewContainElementMatcher(element any) *ContainElementsMatcher {
+	matcher := newContainElementsMatcher([]any{element}) // a slice element is an element itself
+	matcher.single = true
+	matcher.MixinMatcherGomock = NewMixinMatcherGomock(matcher, "ContainElement")
+	return matcher
+}
+
+// NewConsistOfMatcher creates a ContainElementsMatcher that also requires
+// the collection to have no items besides the matched ones.
+// A single slice or array is taken as the list of elements.
+func NewConsistOfMatcher(elements ...any) *ContainElementsMatcher {
+	matcher := NewContainElementsMatcher(elements...)
+	matcher.exact = true
+	matcher.MixinMatcherGomock = NewMixinMatcherGomock(matcher, "ConsistOf")
+	return matcher
+}
+
+// flattenElements unpacks a single slice, array or iter.Seq (not a matcher) given as the list of elements
+func flattenElements(elements []any) []any {
+	if len(elements) != 1 || IsMatcher(elements[0]) {
+		return elements
+	}
+	list := elements[0]
+	if values, ok, err := SeqValues(list); ok {
+		if err != nil {
+			panic(err)
+		}
+		list = values
+	}
+	rv := reflect.ValueOf(list)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return elements
+	}
+	flat := make([]any, rv.Len())
+	for i := range flat {
+		flat[i] = rv.Index(i).Interface()
+	}
+	return flat
+}
+
+// collectionItem is an item of a collection along with its path segment
+// (`[1]` for slices, `["key"]` for maps)
+type collectionItem struct {
+	segment string
+	value   any
+}
+
+// collectionItems returns the items of a slice, array or iter.Seq, the values of a map
+// (sorted by key) or of an iter.Seq2 (pointed at by their keys). shown is what a failure
+// shows as actual: an iterator is shown as the values or entries it yielded.
+func collectionItems(actual any, name string) (items []collectionItem, shown any, err error) {
+	if entries, ok, err := SeqEntries(actual); ok {
+		items = make([]collectionItem, len(entries))
+		for i, e := range entries {
+			items[i] = collectionItem{segment: IndexSegment(e.Key), value: e.Value}
+		}
+		return items, entries, err
+	}
+	shown, err = Collect(actual)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rv := reflect.ValueOf(shown)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		items = make([]collectionItem, rv.Len())
+		for i := range items {
+			items[i] = collectionItem{segment: IndexSegment(i), value: rv.Index(i).Interface()}
+		}
+		return items, shown, nil
+	case reflect.Map:
+		keys := rv.MapKeys()
+		slices.SortFunc(keys, func(a, b reflect.Value) int {
+			return strings.Compare(beformat.Value(a.Interface()), beformat.Value(b.Interface()))
+		})
+		items = make([]collectionItem, len(keys))
+		for i, k := range keys {
+			items[i] = collectionItem{segment: IndexSegment(k.Interface()), value: rv.MapIndex(k).Interface()}
+		}
+		return items, shown, nil
+	default:
+		return nil, nil, fmt.Errorf("%s matcher expects an array/slice/map/iterator.  Got:\n%s", name, beformat.Object(actual, 1))
+	}
+}
+
+func (matcher *ContainElementsMatcher) Explain(actual any) types.Outcome {
+	items, shown, err := collectionItems(actual, matcher.name())
+	if err != nil {
+		return Errored(err)
+	}
+
+	missing, extra := matcher.pair(items)
+	if !matcher.exact {
+		extra = nil
+	}
+	if len(missing) == 0 && len(extra) == 0 {
+		return Succeeded(matcher.message(shown, "not to", nil, nil))
+	}
+	return Failed(matcher.message(shown, "to", missing, extra))
+}
+
+// pair pairs each element with a distinct matching item (bipartite matching)
+// and returns the elements left without a pair along with the items left without one
+func (matcher *ContainElementsMatcher) pair(items []collectionItem) (missing []any, extra []collectionItem) {
+	matches := make([][]int, len(matcher.matchers))
+	for i, m := range matcher.matchers {
+		for j, item := range items {
+			// an item the element can't be matched against (errored) is simply not a match
+			if Explain(m, item.value).Success {
+				matches[i] = append(matches[i], j)
+			}
+		}
+	}
+
+	owner := make([]int, len(items)) // item => index of the element it's paired with + 1
+	var pair func(i int, seen []bool) bool
+	pair = func(i int, seen []bool) bool {
+		for _, j := range matches[i] {
+			if seen[j] {
+				continue
+			}
+			seen[j] = true
+			if owner[j] == 0 || pair(owner[j]-1, seen) {
+				owner[j] = i + 1
+				return true
+			}
+		}
+		return false
+	}
+
+	for i := range matcher.matchers {
+		if !pair(i, make([]bool, len(items))) {
+			missing = append(missing, describe(matcher.elements[i]))
+		}
+	}
+	for j, item := range items {
+		if owner[j] == 0 {
+			extra = append(extra, item)
+		}
+	}
+	return missing, extra
+}
+
+func (matcher *ContainElementsMatcher) message(actual any, to string, missing []any, extra []collectionItem) string {
+	if matcher.single {
+		return beformat.Message(actual, to+" contain element matching", describe(matcher.elements[0]))
+	}
+
+	expected := make([]any, len(matcher.elements))
+	for i, el := range matcher.elements {
+		expected[i] = describe(el)
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %s %s", to, matcher.what(), beformat.Value(expected))
+	if len(missing) > 0 || len(extra) > 0 {
+		b.WriteString(":")
+	}
+	for _, el := range missing {
+		b.WriteString("\n  missing element " + beformat.Value(el))
+	}
+	for _, item := range extra {
+		b.WriteString("\n  " + item.segment + ": extra element " + beformat.Value(item.value))
+	}
+	return beformat.Message(actual, b.String())
+}
+
+func (matcher *ContainElementsMatcher) name() string {
+	switch {
+	case matcher.single:
+		return "ContainElement"
+	case matcher.exact:
+		return "ConsistOf"
+	}
+	return "ContainElements"
+}
+
+func (matcher *ContainElementsMatcher) what() string {
+	if matcher.exact {
+		return "consist of"
+	}
+	return "contain elements"
+}
+
+// String describes the matcher for gomock, e.g. `contain elements [1, "to equal <int>: 2"]`
+func (matcher *ContainElementsMatcher) String() string {
+	if matcher.single {
+		return "contain element " + beformat.Value(describe(matcher.elements[0]))
+	}
+	expected := make([]any, len(matcher.elements))
+	for i, el := range matcher.elements {
+		expected[i] = describe(el)
+	}
+	return matcher.what() + " " + beformat.Value(expected)
+}
+
+// describe shows a matcher by its description and a raw value as it is
+func describe(v any) any {
+	if IsMatcher(v) {
+		return beformat.Compact(AsMatcher(v).String())
+	}
+	return v
+}
+
+// describeValue renders describe(v) for a message: a matcher's description as is
+// (e.g. `to equal 5`), a raw value formatted (e.g. `"five"`)
+func describeValue(v any) string {
+	if IsMatcher(v) {
+		return beformat.Compact(AsMatcher(v).String())
+	}
+	return beformat.Value(v)
+}
+
+func (matcher *ContainElementsMatcher) Match(actual any) (bool, error) {
+	o := matcher.Explain(actual)
+	return o.Success, o.Err
+}
+
+func (matcher *ContainElementsMatcher) FailureMessage(actual any) string {
+	return matcher.Explain(actual).Message
+}
+
+func (matcher *ContainElementsMatcher) NegatedFailureMessage(actual any) string {
+	return matcher.Explain(actual).Message
+}
@@ -0,0 +1,75 @@
+package psi_matchers_test
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/expectto/be"
+	. "github.com/expectto/be/internal/psi_matchers"
+)
+
+func TestContainElementsMatcher(t *testing.T) {
+	t.Run("should match raw elements in any order", func(t *testing.T) {
+		matcher := NewContainElementsMatcher(3, 1)
+
+		be.ExpectValues(t)(matcher.Match([]int{1, 2, 3})).To(be.True())
+		be.ExpectValues(t)(matcher.Match([]int{1, 2})).To(be.False())
+	})
+
+	t.Run("should assign each element a distinct item", func(t *testing.T) {
+		// a greedy assignment would let ">0" take 1 and leave nothing for 1
+		matcher := NewContainElementsMatcher(NewNumericMatcher(">", 0), 1)
+
+		be.ExpectValues(t)(matcher.Match([]int{1, 5})).To(be.True())
+		be.ExpectValues(t)(matcher.Match([]int{1})).To(be.False())
+	})
+
+	t.Run("should look into map values", func(t *testing.T) {
+		be.ExpectValues(t)(NewContainElementMatcher("b").Match(map[string]string{"x": "a", "y": "b"})).To(be.True())
+	})
+
+	t.Run("should look into iterators", func(t *testing.T) {
+		be.ExpectValues(t)(NewContainElementsMatcher(2, 1).Match(slices.Values([]int{1, 2, 3}))).To(be.True())
+		be.ExpectValues(t)(NewContainElementMatcher("b").Match(maps.All(map[string]string{"x": "a", "y": "b"}))).To(be.True())
+		be.ExpectValues(t)(NewConsistOfMatcher(slices.Values([]int{2, 1})).Match(slices.Values([]int{1, 2}))).To(be.True())
+
+		// the extra items of an iter.Seq2 are pointed at by their keys
+		msg := NewConsistOfMatcher("a").FailureMessage(maps.All(map[string]string{"x": "a", "y": "b"}))
+		be.Expect(t, msg).To(be.ContainSubstring(`["y"]: extra element "b"`))
+	})
+
+	t.Run("should report the missing elements", func(t *testing.T) {
+		matcher := NewContainElementsMatcher(1, 4)
+
+		be.Expect(t, matcher.FailureMessage([]int{1, 2})).To(be.ContainSubstring("to contain elements [1, 4]:\n  missing element 4"))
+	})
+
+	t.Run("should error on non-collections", func(t *testing.T) {
+		_, err := NewContainElementMatcher(1).Match(1)
+		be.Expect(t, err).To(be.HaveOccurred())
+	})
+}
+
+func TestConsistOfMatcher(t *testing.T) {
+	t.Run("should require exactly the given elements, in any order", func(t *testing.T) {
+		be.ExpectValues(t)(NewConsistOfMatcher(2, 1).Match([]int{1, 2})).To(be.True())
+		be.ExpectValues(t)(NewConsistOfMatcher([]int{2, 1}).Match([]int{1, 2})).To(be.True())
+		be.ExpectValues(t)(NewConsistOfMatcher(2, 1).Match([]int{1, 2, 3})).To(be.False())
+		be.ExpectValues(t)(NewConsistOfMatcher(1, 1).Match([]int{1, 2})).To(be.False())
+	})
+
+	t.Run("should pair matchers with distinct items", func(t *testing.T) {
+		matcher := NewConsistOfMatcher(NewNumericMatcher(">", 0), 1)
+
+		be.ExpectValues(t)(matcher.Match([]int{5, 1})).To(be.True())
+		be.ExpectValues(t)(matcher.Match([]int{1, 1})).To(be.True())
+		be.ExpectValues(t)(matcher.Match([]int{5, 6})).To(be.False())
+	})
+
+	t.Run("should report the missing and the extra elements", func(t *testing.T) {
+		msg := NewConsistOfMatcher(3, 1, 4).FailureMessage([]int{1, 5, 3})
+		be.Expect(t, msg).To(be.ContainSubstring("missing element 4"))
+		be.Expect(t, msg).To(be.ContainSubstring("[1]: extra element 5"))
+	})
+}
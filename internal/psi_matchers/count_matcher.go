@@ -0,0 +1,117 @@
+package psi_matchers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/expectto/be/internal/beformat"
+
+	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
+	"github.com/expectto/be/types"
+)
+
+// CountMatcher succeeds if the number of matching matchers is within [Min, Max].
+// It's a generalization of All (Min = len) and Any (Min = 1): it backs
+// be.AtLeast, be.AtMost and be.ExactlyOneOf.
+// Unlike All and Any, it always evaluates every matcher,
+// so its messages list which of them matched.
+type CountMatcher struct {
+	Matchers []types.BeMatcher
+	Min, Max int
+}
+
+var _ types.BeMatcher = &CountMatcher{}
+
+func NewCountMatcher(min, max int, ms ...any) *CountMatcher {
+	if min < 0 || max < min {
+		panic(fmt.Sprintf("invalid count range [%d, %d]", min, max))
+	}
+
+	matchers := make([]types.BeMatcher, len(ms))
+	for i, m := range ms {
+		matchers[i] = AsMatcher(m)
+	}
+
+	return &CountMatcher{Matchers: matchers, Min: min, Max: max}
+}
+
+func (m *CountMatcher) Explain(actual any) types.Outcome {
+	outcomes := make([]types.Outcome, len(m.Matchers))
+	matched := 0
+	for i, matcher := range m.Matchers {
+		outcomes[i] = Explain(matcher, actual)
+		if outcomes[i].Err != nil {
+			return outcomes[i]
+		}
+		if outcomes[i].Success {
+			matched++
+		}
+	}
+
+	success := matched >= m.Min && matched <= m.Max
+	to := "to"
+	if success {
+		to = "not to"
+	}
+	return Outcome(success, nil, func() string {
+		return beformat.Message(actual, fmt.Sprintf("%s %s, %d matched:%s", to, m.String(), matched, m.branches(outcomes)))
+	})
+}
+
+// branches lists every matcher along with whether it matched,
+// failed ones are followed by their failure
+func (m *CountMatcher) branches(outcomes []types.Outcome) string {
+	var b strings.Builder
+	for i, o := range outcomes {
+		if o.Success {
+			fmt.Fprintf(&b, "\n  matched: %s", m.describeAt(i))
+			continue
+		}
+		fmt.Fprintf(&b, "\n  failed:  %s", strings.ReplaceAll(beformat.Compact(o.Message), "\n", "\n    "))
+	}
+	return b.String()
+}
+
+func (m *CountMatcher) describeAt(i int) string {
+	if s := m.Matchers[i].String(); s != "" {
+		return s
+	}
+	return fmt.Sprintf("matcher #%d", i+1)
+}
+
+func (m *CountMatcher) Match(actual any) (bool, error) {
+	o := m.Explain(actual)
+	return o.Success, o.Err
+}
+
+func (m *CountMatcher) FailureMessage(actual any) string {
+	return m.Explain(actual).Message
+}
+
+func (m *CountMatcher) NegatedFailureMessage(actual any) string {
+	return m.Explain(actual).Message
+}
+
+func (m *CountMatcher) Matches(actual any) bool {
+	success, _ := m.Match(actual)
+	return success
+}
+
+// String describes the expected count, e.g. "satisfy at least 2 of 3 matchers"
+func (m *CountMatcher) String() string {
+	n := len(m.Matchers)
+	switch {
+	case m.Min == m.Max:
+		return fmt.Sprintf("satisfy exactly %d of %d matchers", m.Min, n)
+	case m.Min == 0:
+		return fmt.Sprintf("satisfy at most %d of %d matchers", m.Max, n)
+	case m.Max >= n:
+		return fmt.Sprintf("satisfy at least %d of %d matchers", m.Min, n)
+	default:
+		return fmt.Sprintf("satisfy %d to %d of %d matchers", m.Min, m.Max, n)
+	}
+}
+
+func (m *CountMatcher) Children(actual any) []types.Child {
+	return ChildrenOf(actual, m.Matchers...)
+}
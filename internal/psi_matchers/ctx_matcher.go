@@ -5,7 +5,7 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/onsi/gomega/format"
+	"github.com/expectto/be/internal/beformat"
 
 	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
 	"github.com/expectto/be/types"
@@ -25,20 +25,18 @@ var (
 // CtxMatcher is a matcher for ctx// Each instance of CtxMatcher can match across only one thing:// (1) ctx value or (2) error or (3) deadline or (4) done signal
 // Do not fill multiple things together here. Use separate instances instead
 type CtxMatcher struct {
-	failReason error
-
 	// 1.  Matching inner ctx value:
 	key   any
-	value any
+	value types.BeMatcher
 
 	// 2. Error matching
 	// matchErr records that error matching was requested (via NewCtxErrMatcher),
 	// so a nil errFn means "expect no error" rather than "no error matcher set".
 	matchErr bool
-	errFn    any
+	errFn    types.BeMatcher
 
 	// 3. Deadline matching
-	deadline any
+	deadline types.BeMatcher
 
 	// 4. Done matching
 	// doneMatcher types.BeMatcher
@@ -58,7 +56,9 @@ func NewCtxValueMatcher(key any, valueArg ...any) *CtxMatcher {
 	case 0:
 		return matcher
 	case 1:
-		matcher.value = valueArg[0]
+		if valueArg[0] != nil {
+			matcher.value = Psi(valueArg[0])
+		}
 		return matcher
 	default:
 		panic("NewCtxValueMatcher expects either 0 or 1 value matcher")
@@ -66,39 +66,75 @@ func NewCtxValueMatcher(key any, valueArg ...any) *CtxMatcher {
 }
 
 func NewCtxDeadlineMatcher(deadline any) *CtxMatcher {
-	return &CtxMatcher{deadline: deadline}
+	matcher := &CtxMatcher{}
+	if deadline != nil {
+		matcher.deadline = Psi(deadline)
+	}
+	return matcher
 }
 
 func NewCtxErrMatcher(errFn any) *CtxMatcher {
-	return &CtxMatcher{matchErr: true, errFn: errFn}
+	matcher := &CtxMatcher{matchErr: true}
+	if errFn != nil {
+		matcher.errFn = Psi(errFn)
+	}
+	return matcher
+}
+
+func (cm *CtxMatcher) Explain(v any) types.Outcome {
+	failReason, err := cm.match(v)
+	switch {
+	case err != nil:
+		return Errored(err)
+	case failReason != nil:
+		return Failed(beformat.Message(v, fmt.Sprintf("to %s", failReason)))
+	}
+	return Succeeded(beformat.Message(v, fmt.Sprintf("not to %s", cm.expectation())))
 }
 
 func (cm *CtxMatcher) Match(v any) (bool, error) {
-	return cm.match(v)
+	o := cm.Explain(v)
+	return o.Success, o.Err
 }
 
 func (cm *CtxMatcher) FailureMessage(v any) string {
-	return format.Message(v, fmt.Sprintf("to %s", cm.failReason))
+	return cm.Explain(v).Message
 }
 
 func (cm *CtxMatcher) NegatedFailureMessage(v any) string {
-	return format.Message(v, fmt.Sprintf("not to %s", cm.failReason))
+	return cm.Explain(v).Message
 }
 
 func (cm *CtxMatcher) Matches(v any) bool {
-	success, _ := cm.match(v)
+	success, _ := cm.Match(v)
 	return success
 }
 
 func (cm *CtxMatcher) String() string {
-	return "failed"
+	return cm.expectation().Error()
+}
+
+// expectation describes what the matcher expects from a ctx
+func (cm *CtxMatcher) expectation() error {
+	switch {
+	case cm.key != nil && cm.value == nil:
+		return fmt.Errorf("%w key=`%s`", ErrCtxValueExpected, cm.key)
+	case cm.key != nil:
+		return fmt.Errorf("%w key=`%s`", ErrCtxValueNotMatched, cm.key)
+	case cm.matchErr:
+		return ErrCtxErrorNotMatched
+	case cm.deadline != nil:
+		return ErrCtxDeadlineNotMatched
+	default:
+		return ErrCtxNotAContext
+	}
 }
 
-func (cm *CtxMatcher) match(v any) (bool, error) {
+// match returns the reason why v does not match (nil reason if it matches)
+func (cm *CtxMatcher) match(v any) (failReason error, err error) {
 	ctx, ok := v.(context.Context)
 	if !ok {
-		cm.failReason = ErrCtxNotAContext
-		return false, nil
+		return ErrCtxNotAContext, nil
 	}
 
 	// (1) matching context value
@@ -108,70 +144,59 @@ func (cm *CtxMatcher) match(v any) (bool, error) {
 		if cm.value == nil {
 			// simply match existence of a value
 			if foundValue == nil {
-				cm.failReason = fmt.Errorf("%w key=`%s`", ErrCtxValueExpected, cm.key)
-				return false, nil
+				return fmt.Errorf("%w key=`%s`", ErrCtxValueExpected, cm.key), nil
 			}
 
-			return true, nil
+			return nil, nil
 		}
 
-		valueMatcher := Psi(cm.value)
-		succeed, err := valueMatcher.Match(foundValue)
-		if err != nil {
-			return false, err
+		o := Explain(cm.value, foundValue)
+		if o.Err != nil {
+			return nil, o.Err
 		}
-		if !succeed {
-			cm.failReason = fmt.Errorf(
-				"%w key=`%s` that failed on match:\n%s",
-				ErrCtxValueNotMatched,
-				cm.key,
-				valueMatcher.FailureMessage(foundValue),
-			)
+		if !o.Success {
+			return fmt.Errorf("%w key=`%s` that failed on match:\n%s", ErrCtxValueNotMatched, cm.key, o.Message), nil
 		}
-		return succeed, nil
+		return nil, nil
 	}
 	// (2) matching context err
 	if cm.matchErr {
 		// CtxWithError(nil) asserts the context carries no error.
 		if cm.errFn == nil {
 			if ctx.Err() != nil {
-				cm.failReason = fmt.Errorf("%w: expected no error, got %w", ErrCtxErrorNotMatched, ctx.Err())
-				return false, nil //nolint:nilerr // ctx.Err() is the value under test; a non-nil ctx error means "no match", not a matcher failure
+				return fmt.Errorf("%w: expected no error, got %w", ErrCtxErrorNotMatched, ctx.Err()), nil
 			}
-			return true, nil
+			return nil, nil
 		}
 
-		errMatcher := Psi(cm.errFn)
-		succeed, err := errMatcher.Match(ctx.Err())
-		if err != nil {
-			return false, err
+		o := Explain(cm.errFn, ctx.Err())
+		if o.Err != nil {
+			return nil, o.Err
 		}
-		if !succeed {
-			cm.failReason = fmt.Errorf("%w: %s", ErrCtxErrorNotMatched, errMatcher.FailureMessage(ctx.Err()))
+		if !o.Success {
+			return fmt.Errorf("%w: %s", ErrCtxErrorNotMatched, o.Message), nil
 		}
-		return succeed, nil
+		return nil, nil
 	}
 	// (3) matching context deadline
 	if cm.deadline != nil {
 		// first simple check if deadline exists
 		deadline, ok := ctx.Deadline()
 		if !ok {
-			cm.failReason = ErrCtxDeadlineExpected
-			return false, nil
+			return ErrCtxDeadlineExpected, nil
 		}
 
-		deadlineMatcher := Psi(cm.deadline)
-		succeed, err := deadlineMatcher.Match(deadline)
-		if err != nil {
-			return false, err
+		o := Explain(cm.deadline, deadline)
+		if o.Err != nil {
+			return nil, o.Err
 		}
-		if !succeed {
-			cm.failReason = fmt.Errorf("%w: %s", ErrCtxDeadlineNotMatched, deadlineMatcher.FailureMessage(deadline))
+		if !o.Success {
+			return fmt.Errorf("%w: %s", ErrCtxDeadlineNotMatched, o.Message), nil
 		}
-		return succeed, nil
+		return nil, nil
 	}
 	// (4) matching context Done signal TODO
 	// ctx.Done()
 
-	return true, nil
+	return nil, nil
 }
@@ -0,0 +1,167 @@
+package psi_matchers
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/expectto/be/internal/beformat"
+	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
+	"github.com/expectto/be/types"
+)
+
+// HaveFieldsMatcher matches a struct whose fields (specified as in gomega.HaveField:
+// a name, a dotted path or a "Method()" call) match the given values or matchers.
+// Unlike a group of HaveField matchers, it checks all the fields and reports
+// every mismatching one: raw values as a field diff, matchers by their failure message.
+type HaveFieldsMatcher struct {
+	*MixinMatcherGomock
+
+	keys     []string
+	expected map[string]any
+	matchers map[string]types.BeMatcher
+}
+
+var _ types.BeMatcher = &HaveFieldsMatcher{}
+
+// NewHaveFieldsMatcher creates a new HaveFieldsMatcher.
+// Fields are checked in sorted-key order, so failure output is deterministic.
+func NewHaveFieldsMatcher(fields map[string]any) *HaveFieldsMatcher {
+	matcher := &HaveFieldsMatcher{
+		keys:     slices.Sorted(maps.Keys(fields)),
+		expected: fields,
+		matchers: make(map[string]types.BeMatcher, len(fields)),
+	}
+	matcher.MixinMatcherGomock = NewMixinMatcherGomock(matcher, "HaveFields")
+
+	for k, v := range fields {
+		matcher.matchers[k] = Psi(v)
+	}
+
+	return matcher
+}
+
+// extractField is a port of gomega's HaveField extraction: the field spec is a name,
+// a "Method()" call (with no args and a single result), or a dotted path of those
+func extractField(actual any, field string) (any, error) {
+	name, rest, nested := strings.Cut(field, ".")
+
+	rv := reflect.ValueOf(actual)
+	if rv.Kind() == reflect.Pointer {
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() {
+		return nil, fmt.Errorf("HaveField encountered nil while dereferencing a pointer of type %T.", actual)
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("HaveField encountered:\n%s\nWhich is not a struct.", beformat.Object(actual, 1))
+	}
+
+	var extracted reflect.Value
+	if methodName, ok := strings.CutSuffix(name, "()"); ok {
+		extracted = rv.MethodByName(methodName)
+		if !extracted.IsValid() && rv.CanAddr() {
+			extracted = rv.Addr().MethodByName(methodName)
+		}
+		if !extracted.IsValid() {
+			// pointer receivers of a struct passed by value
+			ptr := reflect.New(rv.Type())
+			ptr.Elem().Set(rv)
+			extracted = ptr.MethodByName(methodName)
+		}
+		if !extracted.IsValid() {
+			return nil, fmt.Errorf("HaveField could not find method named '%s' in struct of type %T.", name, actual)
+		}
+		if t := extracted.Type(); t.NumIn() != 0 || t.NumOut() != 1 {
+			return nil, fmt.Errorf(
+				"HaveField found an invalid method named '%s' in struct of type %T.\nMethods must take no arguments and return exactly one value.",
+				name, actual,
+			)
+		}
+		extracted = extracted.Call(nil)[0]
+	} else {
+		extracted = rv.FieldByName(name)
+		if !extracted.IsValid() {
+			return nil, fmt.Errorf("HaveField could not find field named '%s' in struct:\n%s", name, beformat.Object(actual, 1))
+		}
+		if !extracted.CanInterface() {
+			return nil, fmt.Errorf("HaveField can't access unexported field '%s' in struct of type %T.", name, actual)
+		}
+	}
+
+	if nested {
+		return extractField(extracted.Interface(), rest)
+	}
+	return extracted.Interface(), nil
+}
+
+func (matcher *HaveFieldsMatcher) Explain(actual any) types.Outcome {
+	var lines []string
+	for _, k := range matcher.keys {
+		v, err := extractField(actual, k)
+		if err != nil {
+			return Errored(err)
+		}
+
+		o := Explain(matcher.matchers[k], v)
+		switch {
+		case o.Err != nil:
+			return AtPath(k, o)
+		case o.Success:
+			continue
+		}
+
+		if expected := matcher.expected[k]; !IsMatcher(expected) {
+			if diff := beformat.FieldDiff(expected, v, "."+k); len(diff) > 0 {
+				lines = append(lines, diff...)
+				continue
+			}
+		}
+		lines = append(lines, "  ."+k+": "+beformat.Compact(o.Message))
+	}
+
+	if len(lines) == 0 {
+		return Succeeded(beformat.Message(actual, "not to "+matcher.String()))
+	}
+	return Failed(beformat.DiffMessage(actual, "to "+matcher.what(), lines))
+}
+
+func (matcher *HaveFieldsMatcher) what() string {
+	if len(matcher.keys) == 1 {
+		return "have field"
+	}
+	return "have fields"
+}
+
+// String describes the matcher for gomock, e.g. "have fields Age, Name"
+func (matcher *HaveFieldsMatcher) String() string {
+	return matcher.what() + " " + strings.Join(matcher.keys, ", ")
+}
+
+func (matcher *HaveFieldsMatcher) Match(actual any) (bool, error) {
+	o := matcher.Explain(actual)
+	return o.Success, o.Err
+}
+
+func (matcher *HaveFieldsMatcher) FailureMessage(actual any) string {
+	return matcher.Explain(actual).Message
+}
+
+func (matcher *HaveFieldsMatcher) NegatedFailureMessage(actual any) string {
+	return matcher.Explain(actual).Message
+}
+
+// Children exposes each of the field matchers applied to its field
+func (matcher *HaveFieldsMatcher) Children(actual any) []types.Child {
+	children := make([]types.Child, 0, len(matcher.keys))
+	for _, k := range matcher.keys {
+		v, err := extractField(actual, k)
+		if err != nil {
+			return nil
+		}
+		children = append(children, types.Child{Segment: k, Matcher: matcher.matchers[k], Actual: v})
+	}
+	return children
+}
@@ -0,0 +1,191 @@
+package psi_matchers
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/expectto/be/internal/beformat"
+
+	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
+	"github.com/expectto/be/types"
+)
+
+// HaveKeyValueMatcher matches a map (or an iter.Seq2) having the given key (compared by value, or
+// matched when the key is a matcher), and optionally its value matching the given matcher.
+// Unlike gomega.HaveKeyWithValue, it reports the failure of the value matcher
+// itself, prefixed with the key as a path segment (e.g. `["details"]`).
+type HaveKeyValueMatcher struct {
+	*MixinMatcherGomock
+
+	key         any
+	keyMatching types.BeMatcher
+	matching    types.BeMatcher
+}
+
+var _ types.BeMatcher = &HaveKeyValueMatcher{}
+
+// NewHaveKeyValueMatcher creates a new HaveKeyValueMatcher.
+// No args means that the matcher succeeds when the key simply exists.
+func NewHaveKeyValueMatcher(key any, args ...any) *HaveKeyValueMatcher {
+	matcher := &HaveKeyValueMatcher{key: key}
+	matcher.MixinMatcherGomock = NewMixinMatcherGomock(matcher, "HaveKeyValue")
+
+	if IsMatcher(key) {
+		matcher.keyMatching = AsMatcher(key)
+	}
+
+	if len(args) > 0 {
+		matcher.matching = Psi(args...)
+	}
+
+	return matcher
+}
+
+// keyedValue is a key of actual matching the expected key, along with its value
+type keyedValue struct {
+	key, value any
+}
+
+// matchingKeys returns the keys of the map (or of the iter.Seq2 entries, in order)
+// that are equal to (or match) the expected key, along with their values
+func (matcher *HaveKeyValueMatcher) matchingKeys(actual any) ([]keyedValue, error) {
+	if entries, ok, err := SeqEntries(actual); ok {
+		if err != nil {
+			return nil, err
+		}
+		var found []keyedValue
+		for _, e := range entries {
+			if matcher.keyMatches(e.Key) {
+				found = append(found, keyedValue{key: e.Key, value: e.Value})
+			}
+		}
+		return found, nil
+	}
+
+	rv := reflect.ValueOf(actual)
+	if rv.Kind() != reflect.Map {
+		return nil, fmt.Errorf("HaveKeyValue matcher expects a map or an iter.Seq2.  Got:\n%s", beformat.Object(actual, 1))
+	}
+
+	if matcher.keyMatching != nil {
+		var found []keyedValue
+		for _, k := range rv.MapKeys() {
+			if Explain(matcher.keyMatching, k.Interface()).Success {
+				found = append(found, keyedValue{key: k.Interface(), value: rv.MapIndex(k).Interface()})
+			}
+		}
+		slices.SortFunc(found, func(a, b keyedValue) int {
+			return strings.Compare(beformat.Value(a.key), beformat.Value(b.key))
+		})
+		return found, nil
+	}
+
+	key, ok := convertKey(matcher.key, rv.Type().Key())
+	if !ok {
+		return nil, nil
+	}
+	v := rv.MapIndex(key)
+	if !v.IsValid() {
+		return nil, nil
+	}
+	return []keyedValue{{key: key.Interface(), value: v.Interface()}}, nil
+}
+
+// keyMatches tells whether the key (of an iter.Seq2 entry) is the expected one
+func (matcher *HaveKeyValueMatcher) keyMatches(key any) bool {
+	if matcher.keyMatching != nil {
+		return Explain(matcher.keyMatching, key).Success
+	}
+	if key == nil {
+		return matcher.key == nil
+	}
+	expected, ok := convertKey(matcher.key, reflect.TypeOf(key))
+	return ok && reflect.DeepEqual(expected.Interface(), key)
+}
+
+// convertKey converts the expected key to the key type of actual (if it's possible)
+func convertKey(expected any, keyType reflect.Type) (reflect.Value, bool) {
+	key := reflect.ValueOf(expected)
+	switch {
+	case !key.IsValid():
+		return key, false
+	case key.Type().AssignableTo(keyType):
+		return key, true
+	case key.Kind() == keyType.Kind() && key.Type().ConvertibleTo(keyType):
+		// custom types with the same underlying kind, e.g. `type Key string`
+		return key.Convert(keyType), true
+	}
+	return key, false
+}
+
+// segment is the path segment of the given key
+func (matcher *HaveKeyValueMatcher) segment(key any) string {
+	if matcher.keyMatching != nil {
+		return IndexSegment(key)
+	}
+	return IndexSegment(matcher.key)
+}
+
+func (matcher *HaveKeyValueMatcher) Explain(actual any) types.Outcome {
+	found, err := matcher.matchingKeys(actual)
+	switch {
+	case err != nil:
+		return Errored(err)
+	case len(found) == 0:
+		return Failed(beformat.Message(shownAsEntries(actual), "to have key", describe(matcher.key)))
+	case matcher.matching == nil:
+		return Succeeded(beformat.Message(shownAsEntries(actual), "not to have key", describe(matcher.key)))
+	}
+
+	// with a key matcher (or repeated keys of an iter.Seq2), any of the found keys may hold the value
+	var first types.Outcome
+	for i, kv := range found {
+		o := AtPath(matcher.segment(kv.key), Explain(matcher.matching, kv.value))
+		if o.Success {
+			return o
+		}
+		if i == 0 {
+			first = o
+		}
+	}
+	return first
+}
+
+func (matcher *HaveKeyValueMatcher) Match(actual any) (bool, error) {
+	o := matcher.Explain(actual)
+	return o.Success, o.Err
+}
+
+func (matcher *HaveKeyValueMatcher) FailureMessage(actual any) string {
+	return matcher.Explain(actual).Message
+}
+
+func (matcher *HaveKeyValueMatcher) NegatedFailureMessage(actual any) string {
+	return matcher.Explain(actual).Message
+}
+
+// String describes the matcher for gomock, e.g. `have key "name"`
+func (matcher *HaveKeyValueMatcher) String() string {
+	if matcher.matching == nil {
+		return "have key " + beformat.Value(describe(matcher.key))
+	}
+	return fmt.Sprintf("have key %s with value %s", beformat.Value(describe(matcher.key)), matcher.matching.String())
+}
+
+func (matcher *HaveKeyValueMatcher) Children(actual any) []types.Child {
+	found, _ := matcher.matchingKeys(actual)
+	if len(found) == 0 || matcher.matching == nil {
+		return nil
+	}
+	return []types.Child{{Segment: matcher.segment(found[0].key), Matcher: matcher.matching, Actual: found[0].value}}
+}
+
+// shownAsEntries shows an iter.Seq2 as the entries it yields
+func shownAsEntries(actual any) any {
+	if entries, ok, err := SeqEntries(actual); ok && err == nil {
+		return entries
+	}
+	return actual
+}
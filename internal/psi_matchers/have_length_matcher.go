@@ -6,7 +6,7 @@ import (
 
 	"github.com/amberpixels/k1/cast"
 	"github.com/amberpixels/k1/reflectish"
-	"github.com/onsi/gomega/format"
+	"github.com/expectto/be/internal/beformat"
 
 	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
 	"github.com/expectto/be/types"
@@ -43,12 +43,27 @@ func NewHaveLengthMatcher(args ...any) *HaveLengthMatcher {
 	return matcher
 }
 
+// lengthOf returns the length of actual, an iterator's length is the number of values
+// (or entries) it yields. shown is what a failure shows as actual: an iterator is
+// shown as the values or entries it yielded.
+func lengthOf(actual any) (length int, shown any, ok bool, err error) {
+	shown, err = Collect(actual)
+	if err != nil {
+		return 0, actual, true, err
+	}
+	length, ok = reflectish.LengthOf(shown)
+	return length, shown, ok, nil
+}
+
 func (matcher *HaveLengthMatcher) Match(actual any) (bool, error) {
-	length, ok := reflectish.LengthOf(actual)
+	length, _, ok, err := lengthOf(actual)
+	if err != nil {
+		return false, err
+	}
 	if !ok {
 		return false, fmt.Errorf(
-			"HaveLen matcher expects a string/array/map/channel/slice.  Got:\n%s",
-			format.Object(actual, 1),
+			"HaveLen matcher expects a string/array/map/channel/slice/iterator.  Got:\n%s",
+			beformat.Object(actual, 1),
 		)
 	}
 
@@ -60,8 +75,9 @@ func (matcher *HaveLengthMatcher) Match(actual any) (bool, error) {
 }
 
 func (matcher *HaveLengthMatcher) FailureMessage(actual any) string {
+	_, actual, _, _ = lengthOf(actual)
 	if matcher.count != nil {
-		return fmt.Sprintf("Expected\n%s\nto have length = %d", format.Object(actual, 1), *matcher.count)
+		return fmt.Sprintf("Expected\n%s\nto have length = %d", beformat.Object(actual, 1), *matcher.count)
 	}
 
 	// Assuming that underlying message will be the same format
@@ -75,8 +91,9 @@ func (matcher *HaveLengthMatcher) FailureMessage(actual any) string {
 }
 
 func (matcher *HaveLengthMatcher) NegatedFailureMessage(actual any) string {
+	_, actual, _, _ = lengthOf(actual)
 	if matcher.count != nil {
-		return fmt.Sprintf("Expected\n%s\nnot to have length = %d", format.Object(actual, 1), *matcher.count)
+		return fmt.Sprintf("Expected\n%s\nnot to have length = %d", beformat.Object(actual, 1), *matcher.count)
 	}
 
 	// Assuming that underlying message will be the same format
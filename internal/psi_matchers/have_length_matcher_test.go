@@ -1,85 +1,94 @@
 package psi_matchers_test
 
 import (
-	. "github.com/onsi/ginkgo/v2"
-	. "github.com/onsi/gomega"
+	"maps"
+	"slices"
+	"testing"
 
+	"github.com/expectto/be"
+	"github.com/expectto/be/be_string"
 	. "github.com/expectto/be/internal/psi_matchers"
 )
 
-var _ = Describe("HaveLengthMatcher", func() {
-	Context("Match", func() {
-		It("should match the length correctly", func() {
+func TestHaveLengthMatcher(t *testing.T) {
+	t.Run("Match", func(t *testing.T) {
+		t.Run("should match the length correctly", func(t *testing.T) {
 			actual := []int{1, 2, 3}
 			matcher := NewHaveLengthMatcher(3)
 
-			Expect(matcher.Match(actual)).To(BeTrue())
+			be.ExpectValues(t)(matcher.Match(actual)).To(be.True())
 		})
 
-		It("should fail for incorrect length", func() {
+		t.Run("should fail for incorrect length", func(t *testing.T) {
 			actual := []int{1, 2}
 			matcher := NewHaveLengthMatcher(3)
 
-			Expect(matcher.Match(actual)).To(BeFalse())
+			be.ExpectValues(t)(matcher.Match(actual)).To(be.False())
 		})
 
-		It("should handle invalid input type", func() {
+		t.Run("should handle invalid input type", func(t *testing.T) {
 			actual := 123 // Invalid type
 			matcher := NewHaveLengthMatcher(3)
 			_, err := matcher.Match(actual)
 
-			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(ContainSubstring("HaveLen matcher expects a string/array/map/channel/slice"))
+			be.Expect(t, err).To(be.HaveOccurred())
+			be.Expect(t, err.Error()).To(be.ContainSubstring("HaveLen matcher expects a string/array/map/channel/slice"))
 		})
 
-		It("should match length against a matcher instead of a count", func() {
-			matcher := NewHaveLengthMatcher(BeNumerically(">", 5))
+		t.Run("should match length against a matcher instead of a count", func(t *testing.T) {
+			matcher := NewHaveLengthMatcher(be.Gt(5))
 
-			Expect(matcher.Match([]int{1, 2, 3, 4, 5, 6})).To(BeTrue())
-			Expect(matcher.Match([]int{1, 2, 3})).To(BeFalse())
-			Expect(matcher.Match("long enough")).To(BeTrue())
+			be.ExpectValues(t)(matcher.Match([]int{1, 2, 3, 4, 5, 6})).To(be.True())
+			be.ExpectValues(t)(matcher.Match([]int{1, 2, 3})).To(be.False())
+			be.ExpectValues(t)(matcher.Match("long enough")).To(be.True())
+		})
+
+		t.Run("should count the values of an iterator", func(t *testing.T) {
+			be.ExpectValues(t)(NewHaveLengthMatcher(3).Match(slices.Values([]int{1, 2, 3}))).To(be.True())
+			be.ExpectValues(t)(NewHaveLengthMatcher(2).Match(maps.All(map[string]int{"a": 1, "b": 2}))).To(be.True())
+			be.Expect(t, NewHaveLengthMatcher(2).FailureMessage(slices.Values([]int{1, 2, 3}))).To(be.ContainSubstring("[1, 2, 3]"))
 		})
 	})
 
-	Context("FailureMessage", func() {
-		It("should return the failure message for incorrect length", func() {
+	t.Run("FailureMessage", func(t *testing.T) {
+		t.Run("should return the failure message for incorrect length", func(t *testing.T) {
 			matcher := NewHaveLengthMatcher(3)
 			actual := []int{1, 2}
-			Expect(matcher.FailureMessage(actual)).To(SatisfyAll(
-				HavePrefix("Expected"),
-				HaveSuffix("to have length = 3"),
+			be.Expect(t, matcher.FailureMessage(actual)).To(be.All(
+				be_string.HavingPrefix("Expected"),
+				be_string.HavingSuffix("to have length = 3"),
 			))
 		})
 
-		It("should return the failure message for matcher comparison", func() {
-			matcher := NewHaveLengthMatcher(BeNumerically(">", 5))
+		t.Run("should return the failure message for matcher comparison", func(t *testing.T) {
+			matcher := NewHaveLengthMatcher(be.Gt(5))
 			actual := []int{1, 2, 3}
-			Expect(matcher.FailureMessage(actual)).To(SatisfyAll(
-				HavePrefix("Expected"),
-				ContainSubstring("length to be >"),
-				ContainSubstring("5"),
+			be.Expect(t, matcher.FailureMessage(actual)).To(be.All(
+				be_string.HavingPrefix("Expected"),
+				be.ContainSubstring("length to be >"),
+				be.ContainSubstring("5"),
 			))
 		})
 	})
 
-	Context("NegatedFailureMessage", func() {
-		It("should return the negated failure message for incorrect length", func() {
+	t.Run("NegatedFailureMessage", func(t *testing.T) {
+		t.Run("should return the negated failure message for incorrect length", func(t *testing.T) {
 			matcher := NewHaveLengthMatcher(3)
 			actual := []int{1, 2}
-			Expect(matcher.NegatedFailureMessage(actual)).To(SatisfyAll(
-				HavePrefix("Expected"),
-				HaveSuffix("not to have length = 3"),
+			be.Expect(t, matcher.NegatedFailureMessage(actual)).To(be.All(
+				be_string.HavingPrefix("Expected"),
+				be_string.HavingSuffix("not to have length = 3"),
 			))
 		})
 
-		It("should return the negated failure message for matcher comparison", func() {
-			matcher := NewHaveLengthMatcher(BeNumerically(">", 5))
+		t.Run("should return the negated failure message for matcher comparison", func(t *testing.T) {
+			matcher := NewHaveLengthMatcher(be.Gt(5))
 			actual := []int{1, 2, 3}
-			Expect(matcher.NegatedFailureMessage(actual)).To(SatisfyAll(
-				HavePrefix("Expected"),
-				ContainSubstring("length not to be >"),
-				ContainSubstring("5"),
+			be.Expect(t, matcher.NegatedFailureMessage(actual)).To(be.All(
+				be_string.HavingPrefix("Expected"),
+				be.ContainSubstring("length not to be >"),
+				be.ContainSubstring("5"),
 			))
 		})
 	})
-})
+}
@@ -3,7 +3,7 @@ package psi_matchers
 import (
 	"reflect"
 
-	"github.com/onsi/gomega/format"
+	"github.com/expectto/be/internal/beformat"
 
 	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
 	"github.com/expectto/be/types"
@@ -39,9 +39,9 @@ func (matcher *ImplementsMatcher) Match(actual any) (bool, error) {
 }
 
 func (matcher *ImplementsMatcher) FailureMessage(actual any) string {
-	return format.Message(actual, "to implement: "+matcher.implements.String())
+	return beformat.Message(actual, "to implement: "+matcher.implements.String())
 }
 
 func (matcher *ImplementsMatcher) NegatedFailureMessage(actual any) string {
-	return format.Message(actual, "not to implement: "+matcher.implements.String())
+	return beformat.Message(actual, "not to implement: "+matcher.implements.String())
 }
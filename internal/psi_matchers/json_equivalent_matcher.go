@@ -0,0 +1,308 @@
+package psi_matchers
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/amberpixels/k1/cast"
+
+	"github.com/expectto/be/internal/beformat"
+	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
+	"github.com/expectto/be/types"
+)
+
+// JsonEquivalentMatcher matches a JSON value semantically equal to the expected one:
+// objects regardless of the key order, numbers regardless of their Go type.
+// It can be relaxed by the exported fields (set them before matching):
+// JSON Pointers (RFC 6901) to ignore or to compare as unordered arrays, where a "*"
+// token stands for any key or index (e.g. "/items/*/id"), a tolerance for numbers
+// and extra keys (in actual objects) being allowed.
+//
+// A failure shows all the differences at once, as a diff by path:
+// `- ["meta"]["count"]: 1` and `+ ["meta"]["count"]: 2`.
+type JsonEquivalentMatcher struct {
+	*MixinMatcherGomock
+
+	Ignore         []string // JSON Pointers of the values not compared
+	Unordered      []string // JSON Pointers of the arrays compared regardless of the order
+	AllUnordered   bool     // every array is compared regardless of the order
+	Tolerance      float64  // numbers differing by no more than it are equal
+	AllowExtraKeys bool     // actual objects may have keys the expected ones don't
+
+	expected any
+}
+
+var _ types.BeMatcher = &JsonEquivalentMatcher{}
+
+// NewJsonEquivalentMatcher creates a JsonEquivalentMatcher of the expected value:
+// a JSON string/bytes or any value that marshals to JSON
+func NewJsonEquivalentMatcher(expected any) (*JsonEquivalentMatcher, error) {
+	var data any
+	var err error
+	if cast.IsStringish(expected) {
+		err = json.Unmarshal(cast.AsBytes(expected), &data)
+	} else {
+		data, err = remarshal(expected)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid expected JSON: %w", err)
+	}
+
+	matcher := &JsonEquivalentMatcher{expected: data}
+	matcher.MixinMatcherGomock = NewMixinMatcherGomock(matcher, "Equivalent")
+	return matcher, nil
+}
+
+// remarshal turns a value into its decoded JSON counterpart,
+// e.g. a struct into a map[string]any, an int into a float64
+func remarshal(v any) (any, error) {
+	contents, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var data any
+	err = json.Unmarshal(contents, &data)
+	return data, err
+}
+
+// jsonComparison walks the expected and actual values side by side, collecting the diff lines
+type jsonComparison struct {
+	ignore    [][]string
+	unordered [][]string
+	matcher   *JsonEquivalentMatcher
+
+	lines []string
+	bound map[string]jsonBinding // the placeholders of a JSON template met so far
+	err   error
+}
+
+func (matcher *JsonEquivalentMatcher) Explain(actual any) types.Outcome {
+	ignore, err := parseJsonPointers(matcher.Ignore)
+	if err != nil {
+		return Errored(err)
+	}
+	unordered, err := parseJsonPointers(matcher.Unordered)
+	if err != nil {
+		return Errored(err)
+	}
+	c := &jsonComparison{ignore: ignore, unordered: unordered, matcher: matcher}
+
+	data, err := remarshal(actual)
+	if err != nil {
+		return Errored(fmt.Errorf("Equivalent matcher expects a JSON value: %w", err))
+	}
+
+	c.compare(nil, "", matcher.expected, data)
+	if len(c.lines) == 0 {
+		return Succeeded(beformat.Message(actual, "not to "+matcher.String(), matcher.expected))
+	}
+	return Failed(beformat.DiffMessage(actual, "to "+matcher.String(), c.lines))
+}
+
+func (c *jsonComparison) compare(tokens []string, path string, expected, actual any) {
+	if c.err != nil || matchesAnyPointer(c.ignore, tokens) {
+		return
+	}
+	if e, ok := expected.(jsonExpectation); ok {
+		e.compareJSON(c, path, actual)
+		return
+	}
+
+	switch exp := expected.(type) {
+	case map[string]any:
+		if act, ok := actual.(map[string]any); ok {
+			c.compareObjects(tokens, path, exp, act)
+			return
+		}
+	case []any:
+		if act, ok := actual.([]any); ok {
+			if c.matcher.AllUnordered || matchesAnyPointer(c.unordered, tokens) {
+				c.compareUnordered(tokens, path, exp, act)
+			} else {
+				c.compareOrdered(tokens, path, exp, act)
+			}
+			return
+		}
+	case float64:
+		if act, ok := actual.(float64); ok && math.Abs(exp-act) <= c.matcher.Tolerance {
+			return
+		}
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		c.lines = append(c.lines, beformat.FieldDiff(expected, actual, path)...)
+	}
+}
+
+func (c *jsonComparison) compareObjects(tokens []string, path string, expected, actual map[string]any) {
+	keys := make([]string, 0, len(expected)+len(actual))
+	for k := range expected {
+		keys = append(keys, k)
+	}
+	for k := range actual {
+		if _, ok := expected[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+
+	for _, k := range keys {
+		childTokens, childPath := append(slices.Clip(tokens), k), path+IndexSegment(k)
+		exp, inExpected := expected[k]
+		act, inActual := actual[k]
+		switch {
+		case matchesAnyPointer(c.ignore, childTokens):
+		case !inActual:
+			c.lines = append(c.lines, "- "+childPath+": "+beformat.Value(exp))
+		case !inExpected:
+			if !c.matcher.AllowExtraKeys {
+				c.lines = append(c.lines, "+ "+childPath+": "+beformat.Value(act))
+			}
+		default:
+			c.compare(childTokens, childPath, exp, act)
+		}
+	}
+}
+
+func (c *jsonComparison) compareOrdered(tokens []string, path string, expected, actual []any) {
+	for i := range max(len(expected), len(actual)) {
+		childTokens, childPath := append(slices.Clip(tokens), fmt.Sprint(i)), path+IndexSegment(i)
+		switch {
+		case matchesAnyPointer(c.ignore, childTokens):
+		case i >= len(actual):
+			c.lines = append(c.lines, "- "+childPath+": "+beformat.Value(expected[i]))
+		case i >= len(expected):
+			c.lines = append(c.lines, "+ "+childPath+": "+beformat.Value(actual[i]))
+		default:
+			c.compare(childTokens, childPath, expected[i], actual[i])
+		}
+	}
+}
+
+// compareUnordered pairs each expected element with an equivalent actual one,
+// the elements left unpaired on either side are the differences
+func (c *jsonComparison) compareUnordered(tokens []string, path string, expected, actual []any) {
+	paired := make([]bool, len(actual))
+	var missing []int
+	for i, exp := range expected {
+		childTokens := append(slices.Clip(tokens), fmt.Sprint(i))
+		found := false
+		for j, act := range actual {
+			if paired[j] {
+				continue
+			}
+			probe := &jsonComparison{ignore: c.ignore, unordered: c.unordered, matcher: c.matcher}
+			if probe.compare(childTokens, "", exp, act); len(probe.lines) == 0 {
+				paired[j], found = true, true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, i)
+		}
+	}
+
+	for _, i := range missing {
+		c.lines = append(c.lines, "- "+path+IndexSegment(i)+": "+beformat.Value(expected[i]))
+	}
+	for j, act := range actual {
+		if !paired[j] {
+			c.lines = append(c.lines, "+ "+path+IndexSegment(j)+": "+beformat.Value(act))
+		}
+	}
+}
+
+// explain applies the matcher of the expected value (e.g. a placeholder) to the actual one
+func (c *jsonComparison) explain(path string, expected fmt.Stringer, matcher types.BeMatcher, actual any) {
+	o := Explain(matcher, actual)
+	switch {
+	case o.Err != nil:
+		c.err = fmt.Errorf("%s: %w", displayJsonPath(path), o.Err)
+	case !o.Success:
+		c.lines = append(c.lines, fmt.Sprintf("  %s: %s: %s", displayJsonPath(path), expected, beformat.Compact(o.Message)))
+	}
+}
+
+func displayJsonPath(path string) string {
+	if path == "" {
+		return "."
+	}
+	return path
+}
+
+func parseJsonPointers(pointers []string) ([][]string, error) {
+	parsed := make([][]string, len(pointers))
+	for i, pointer := range pointers {
+		tokens, err := parseJsonPointer(pointer)
+		if err != nil {
+			return nil, err
+		}
+		parsed[i] = tokens
+	}
+	return parsed, nil
+}
+
+// matchesAnyPointer tells whether the path (as reference tokens) is one of the pointers,
+// a "*" token of a pointer matching any token
+func matchesAnyPointer(pointers [][]string, tokens []string) bool {
+	for _, pointer := range pointers {
+		if len(pointer) != len(tokens) {
+			continue
+		}
+		matches := true
+		for i, token := range pointer {
+			if token != "*" && token != tokens[i] {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return true
+		}
+	}
+	return false
+}
+
+func (matcher *JsonEquivalentMatcher) Match(actual any) (bool, error) {
+	o := matcher.Explain(actual)
+	return o.Success, o.Err
+}
+
+func (matcher *JsonEquivalentMatcher) FailureMessage(actual any) string {
+	return matcher.Explain(actual).Message
+}
+
+func (matcher *JsonEquivalentMatcher) NegatedFailureMessage(actual any) string {
+	return matcher.Explain(actual).Message
+}
+
+// String describes the matcher for gomock along with every relaxation of the comparison,
+// e.g. `be JSON equivalent to {"id": 1} ignoring ["/id"], with number tolerance 1e-09`
+func (matcher *JsonEquivalentMatcher) String() string {
+	var relaxations []string
+	if len(matcher.Ignore) > 0 {
+		relaxations = append(relaxations, "ignoring "+beformat.Value(matcher.Ignore))
+	}
+	switch {
+	case matcher.AllUnordered:
+		relaxations = append(relaxations, "with unordered arrays")
+	case len(matcher.Unordered) > 0:
+		relaxations = append(relaxations, "with unordered arrays "+beformat.Value(matcher.Unordered))
+	}
+	if matcher.Tolerance != 0 {
+		relaxations = append(relaxations, "with number tolerance "+beformat.Value(matcher.Tolerance))
+	}
+	if matcher.AllowExtraKeys {
+		relaxations = append(relaxations, "allowing extra keys")
+	}
+
+	s := "be JSON equivalent to " + beformat.Value(matcher.expected)
+	if len(relaxations) > 0 {
+		s += " " + strings.Join(relaxations, ", ")
+	}
+	return s
+}
@@ -0,0 +1,116 @@
+package psi_matchers_test
+
+import (
+	"testing"
+
+	"github.com/expectto/be"
+	"github.com/expectto/be/be_string"
+	. "github.com/expectto/be/internal/psi_matchers"
+)
+
+func TestJsonEquivalentMatcher(t *testing.T) {
+	const expected = `{"id": 1, "name": "gopher", "tags": ["a", "b"], "meta": {"score": 0.3, "createdAt": "2024-01-01"}}`
+
+	t.Run("should compare semantically", func(t *testing.T) {
+		for _, tc := range []struct {
+			name      string
+			actual    string
+			configure func(*JsonEquivalentMatcher)
+			success   bool
+		}{
+			{"equal regardless of the key order",
+				`{"meta": {"createdAt": "2024-01-01", "score": 0.3}, "tags": ["a", "b"], "name": "gopher", "id": 1}`,
+				func(*JsonEquivalentMatcher) {}, true},
+			{"different value",
+				`{"id": 2, "name": "gopher", "tags": ["a", "b"], "meta": {"score": 0.3, "createdAt": "2024-01-01"}}`,
+				func(*JsonEquivalentMatcher) {}, false},
+			{"ignored paths",
+				`{"id": 2, "name": "gopher", "tags": ["a", "b"], "meta": {"score": 0.3, "createdAt": "2025-05-05"}}`,
+				func(m *JsonEquivalentMatcher) { m.Ignore = []string{"/id", "/meta/createdAt"} }, true},
+			{"ignored path missing in actual",
+				`{"name": "gopher", "tags": ["a", "b"], "meta": {"score": 0.3, "createdAt": "2024-01-01"}}`,
+				func(m *JsonEquivalentMatcher) { m.Ignore = []string{"/id"} }, true},
+			{"wildcard ignored path",
+				`{"id": 1, "name": "gopher", "tags": ["x", "y"], "meta": {"score": 0.3, "createdAt": "2024-01-01"}}`,
+				func(m *JsonEquivalentMatcher) { m.Ignore = []string{"/tags/*"} }, true},
+			{"ordered array",
+				`{"id": 1, "name": "gopher", "tags": ["b", "a"], "meta": {"score": 0.3, "createdAt": "2024-01-01"}}`,
+				func(*JsonEquivalentMatcher) {}, false},
+			{"unordered array",
+				`{"id": 1, "name": "gopher", "tags": ["b", "a"], "meta": {"score": 0.3, "createdAt": "2024-01-01"}}`,
+				func(m *JsonEquivalentMatcher) { m.Unordered = []string{"/tags"} }, true},
+			{"all arrays unordered",
+				`{"id": 1, "name": "gopher", "tags": ["b", "a"], "meta": {"score": 0.3, "createdAt": "2024-01-01"}}`,
+				func(m *JsonEquivalentMatcher) { m.AllUnordered = true }, true},
+			{"unordered array with a different element",
+				`{"id": 1, "name": "gopher", "tags": ["b", "c"], "meta": {"score": 0.3, "createdAt": "2024-01-01"}}`,
+				func(m *JsonEquivalentMatcher) { m.Unordered = []string{"/tags"} }, false},
+			{"numbers within the tolerance",
+				`{"id": 1, "name": "gopher", "tags": ["a", "b"], "meta": {"score": 0.30000000004, "createdAt": "2024-01-01"}}`,
+				func(m *JsonEquivalentMatcher) { m.Tolerance = 1e-9 }, true},
+			{"numbers beyond the tolerance",
+				`{"id": 1, "name": "gopher", "tags": ["a", "b"], "meta": {"score": 0.31, "createdAt": "2024-01-01"}}`,
+				func(m *JsonEquivalentMatcher) { m.Tolerance = 1e-9 }, false},
+			{"extra key",
+				`{"id": 1, "name": "gopher", "tags": ["a", "b"], "meta": {"score": 0.3, "createdAt": "2024-01-01", "v": 2}}`,
+				func(*JsonEquivalentMatcher) {}, false},
+			{"allowed extra key",
+				`{"id": 1, "name": "gopher", "tags": ["a", "b"], "meta": {"score": 0.3, "createdAt": "2024-01-01", "v": 2}}`,
+				func(m *JsonEquivalentMatcher) { m.AllowExtraKeys = true }, true},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				matcher, err := NewJsonEquivalentMatcher(expected)
+				be.Expect(t, err).NotTo(be.HaveOccurred())
+				tc.configure(matcher)
+				be.ExpectValues(t)(matcher.Match(decoded(tc.actual))).To(be.Eq(tc.success), matcher.FailureMessage(decoded(tc.actual)))
+			})
+		}
+	})
+
+	t.Run("should show all the differences by path", func(t *testing.T) {
+		matcher, _ := NewJsonEquivalentMatcher(expected)
+		matcher.Unordered = []string{"/tags"}
+		actual := decoded(`{"name": "gopher", "tags": ["c", "a"], "meta": {"score": 0.5, "createdAt": "2024-01-01"}, "v": 2}`)
+		be.Expect(t, matcher.FailureMessage(actual)).To(be_string.HavingSuffix(`differences (- expected, + actual):
+  - ["id"]: 1
+  - ["meta"]["score"]: 0.3
+  + ["meta"]["score"]: 0.5
+  - ["tags"][1]: "b"
+  + ["tags"][0]: "c"
+  + ["v"]: 2`))
+	})
+
+	t.Run("should describe every relaxation of the comparison", func(t *testing.T) {
+		matcher, _ := NewJsonEquivalentMatcher(`{"id": 1}`)
+		be.Expect(t, matcher.String()).To(be.Eq(`be JSON equivalent to {"id": 1}`))
+
+		matcher.Ignore = []string{"/id"}
+		matcher.Unordered = []string{"/tags"}
+		matcher.Tolerance = 1e-9
+		matcher.AllowExtraKeys = true
+		be.Expect(t, matcher.String()).To(be.Eq(`be JSON equivalent to {"id": 1} ignoring ["/id"], ` +
+			`with unordered arrays ["/tags"], with number tolerance 1e-09, allowing extra keys`))
+
+		matcher.AllUnordered = true
+		be.Expect(t, matcher.String()).To(be.ContainSubstring(`with unordered arrays, `))
+	})
+
+	t.Run("should compare Go values as JSON", func(t *testing.T) {
+		matcher, err := NewJsonEquivalentMatcher(struct {
+			ID   int      `json:"id"`
+			Tags []string `json:"tags"`
+		}{1, []string{"a"}})
+		be.Expect(t, err).NotTo(be.HaveOccurred())
+		be.ExpectValues(t)(matcher.Match(map[string]any{"id": uint8(1), "tags": []any{"a"}})).To(be.True())
+	})
+
+	t.Run("should error on an invalid expected JSON or pointer", func(t *testing.T) {
+		_, err := NewJsonEquivalentMatcher(`{"id": `)
+		be.Expect(t, err).To(be.MatchError(be.ContainSubstring("invalid expected JSON")))
+
+		matcher, _ := NewJsonEquivalentMatcher(expected)
+		matcher.Ignore = []string{"id"}
+		_, err = matcher.Match(decoded(expected))
+		be.Expect(t, err).To(be.MatchError(be.ContainSubstring("invalid JSON pointer")))
+	})
+}
@@ -0,0 +1,227 @@
+package psi_matchers
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/expectto/be/internal/beformat"
+	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
+	"github.com/expectto/be/types"
+)
+
+// JsonPointerMatcher matches a decoded JSON value (objects are maps with string keys,
+// arrays are slices) having a value at the given JSON Pointer (RFC 6901), e.g. "/details/0/key",
+// optionally matching the given values or matchers.
+//
+// A missing value fails telling where the path breaks off, a mismatching one fails
+// with the message of the matcher, prefixed with the path (e.g. `at ["details"][0]["key"]`).
+type JsonPointerMatcher struct {
+	*MixinMatcherGomock
+
+	pointer  string
+	tokens   []string
+	matching types.BeMatcher
+}
+
+var _ types.BeMatcher = &JsonPointerMatcher{}
+
+// NewJsonPointerMatcher creates a JsonPointerMatcher.
+// No args means that the matcher succeeds when there is a value at the pointer.
+func NewJsonPointerMatcher(pointer string, args ...any) (*JsonPointerMatcher, error) {
+	tokens, err := parseJsonPointer(pointer)
+	if err != nil {
+		return nil, err
+	}
+
+	matcher := &JsonPointerMatcher{pointer: pointer, tokens: tokens}
+	matcher.MixinMatcherGomock = NewMixinMatcherGomock(matcher, "At")
+	if len(args) > 0 {
+		matcher.matching = Psi(args...)
+	}
+	return matcher, nil
+}
+
+// parseJsonPointer splits a JSON Pointer into its reference tokens, unescaping them
+func parseJsonPointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil // the whole document
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("invalid JSON pointer %q: it must be empty or start with '/'", pointer)
+	}
+
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		for j := 0; j < len(token); j++ {
+			if token[j] == '~' && (j+1 == len(token) || (token[j+1] != '0' && token[j+1] != '1')) {
+				return nil, fmt.Errorf("invalid JSON pointer %q: '~' must be escaped as '~0'", pointer)
+			}
+		}
+		tokens[i] = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+	}
+	return tokens, nil
+}
+
+func (matcher *JsonPointerMatcher) Explain(actual any) types.Outcome {
+	v, path, reason := matcher.resolve(actual)
+	if reason != "" {
+		return Failed(beformat.Message(actual, fmt.Sprintf(
+			"to have a value at %q, but %s %s", matcher.pointer, jsonLocation(path), reason,
+		)))
+	}
+
+	if matcher.matching == nil {
+		return Succeeded(beformat.Message(actual, fmt.Sprintf("not to have a value at %q", matcher.pointer)))
+	}
+	return AtPath(path, Explain(matcher.matching, v))
+}
+
+// resolve finds the value at the pointer along with its path (e.g. `["details"][0]`).
+// If there is no such value, reason tells why and path is where the pointer breaks off.
+func (matcher *JsonPointerMatcher) resolve(actual any) (v any, path, reason string) {
+	v = actual
+	for _, token := range matcher.tokens {
+		child, segment, reason := jsonPointerChild(v, token)
+		if reason != "" {
+			return nil, path, reason
+		}
+		v, path = child, path+segment
+	}
+	return v, path, ""
+}
+
+// jsonPointerChild steps into the value by the reference token:
+// a key of an object, an index of an array (decimal, no leading zeros).
+// If there is no such child, reason tells why.
+func jsonPointerChild(v any, token string) (child any, segment, reason string) {
+	rv := reflect.ValueOf(v)
+	switch {
+	case isJsonObject(rv):
+		child, ok := jsonObjectValue(rv, token)
+		if !ok {
+			return nil, "", fmt.Sprintf("has no key %q", token)
+		}
+		return child, IndexSegment(token), ""
+	case isJsonArray(rv):
+		i, err := strconv.Atoi(token)
+		if err != nil || i < 0 || (len(token) > 1 && token[0] == '0') {
+			return nil, "", fmt.Sprintf("is an array, %q is not an index", token)
+		}
+		if i >= rv.Len() {
+			return nil, "", fmt.Sprintf("has %d element%s, there is no [%d]", rv.Len(), plural(rv.Len()), i)
+		}
+		return rv.Index(i).Interface(), IndexSegment(i), ""
+	}
+	return nil, "", fmt.Sprintf("is %s, not an object or array", jsonTypeName(v))
+}
+
+func plural(n int) string {
+	if n == 1 {
+		return ""
+	}
+	return "s"
+}
+
+// jsonLocation names the value at the path in a failure message
+func jsonLocation(path string) string {
+	if path == "" {
+		return "the document"
+	}
+	return path
+}
+
+func isJsonObject(rv reflect.Value) bool {
+	return rv.Kind() == reflect.Map && rv.Type().Key().Kind() == reflect.String
+}
+
+func isJsonArray(rv reflect.Value) bool {
+	return (rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array) && rv.Type() != reflect.TypeFor[[]byte]()
+}
+
+// jsonObjectValue returns the value of the object's key
+func jsonObjectValue(rv reflect.Value, key string) (any, bool) {
+	v := rv.MapIndex(reflect.ValueOf(key).Convert(rv.Type().Key()))
+	if !v.IsValid() {
+		return nil, false
+	}
+	return v.Interface(), true
+}
+
+// jsonChildren returns the members of an object (in sorted-key order) or the elements of an array,
+// along with their path segments
+func jsonChildren(v any) (children []any, segments []string) {
+	rv := reflect.ValueOf(v)
+	switch {
+	case isJsonObject(rv):
+		keys := rv.MapKeys()
+		slices.SortFunc(keys, func(a, b reflect.Value) int { return strings.Compare(a.String(), b.String()) })
+		for _, k := range keys {
+			children = append(children, rv.MapIndex(k).Interface())
+			segments = append(segments, IndexSegment(k.String()))
+		}
+	case isJsonArray(rv):
+		for i := range rv.Len() {
+			children = append(children, rv.Index(i).Interface())
+			segments = append(segments, IndexSegment(i))
+		}
+	}
+	return children, segments
+}
+
+// jsonTypeName names the JSON type of a decoded value, e.g. "a string"
+func jsonTypeName(v any) string {
+	rv := reflect.ValueOf(v)
+	switch {
+	case !rv.IsValid():
+		return "null"
+	case isJsonObject(rv):
+		return "an object"
+	case isJsonArray(rv):
+		return "an array"
+	}
+	switch rv.Kind() {
+	case reflect.String:
+		return "a string"
+	case reflect.Bool:
+		return "a boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "a number"
+	}
+	return fmt.Sprintf("a %T", v)
+}
+
+func (matcher *JsonPointerMatcher) Match(actual any) (bool, error) {
+	o := matcher.Explain(actual)
+	return o.Success, o.Err
+}
+
+func (matcher *JsonPointerMatcher) FailureMessage(actual any) string {
+	return matcher.Explain(actual).Message
+}
+
+func (matcher *JsonPointerMatcher) NegatedFailureMessage(actual any) string {
+	return matcher.Explain(actual).Message
+}
+
+// String describes the matcher for gomock, e.g. `have a value at "/details/0" matching ...`
+func (matcher *JsonPointerMatcher) String() string {
+	s := fmt.Sprintf("have a value at %q", matcher.pointer)
+	if matcher.matching != nil {
+		s += " matching " + beformat.Compact(matcher.matching.String())
+	}
+	return s
+}
+
+// Children exposes the value matcher applied to the value at the pointer
+func (matcher *JsonPointerMatcher) Children(actual any) []types.Child {
+	v, path, reason := matcher.resolve(actual)
+	if reason != "" || matcher.matching == nil {
+		return nil
+	}
+	return []types.Child{{Segment: path, Matcher: matcher.matching, Actual: v}}
+}
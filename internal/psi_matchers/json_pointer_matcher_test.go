@@ -0,0 +1,83 @@
+package psi_matchers_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/expectto/be"
+	"github.com/expectto/be/be_string"
+	. "github.com/expectto/be/internal/psi_matchers"
+)
+
+// decoded decodes the JSON document the way be_json does
+func decoded(doc string) any {
+	var v any
+	if err := json.Unmarshal([]byte(doc), &v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func TestJsonPointerMatcher(t *testing.T) {
+	doc := decoded(`{"details": [{"key": "foo"}], "a/b": 1, "m~n": 2, "": 3, "n": null}`)
+
+	t.Run("should find the value at the pointer", func(t *testing.T) {
+		for _, tc := range []struct {
+			name     string
+			pointer  string
+			expected any
+		}{
+			{"nested", "/details/0/key", "foo"},
+			{"escaped slash", "/a~1b", 1.0},
+			{"escaped tilde", "/m~0n", 2.0},
+			{"empty key", "/", 3.0},
+			{"null", "/n", be.Nil()},
+			{"the whole document", "", be.HaveKey("details")},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				matcher, err := NewJsonPointerMatcher(tc.pointer, tc.expected)
+				be.Expect(t, err).NotTo(be.HaveOccurred())
+				be.ExpectValues(t)(matcher.Match(doc)).To(be.True())
+			})
+		}
+	})
+
+	t.Run("should tell where a missing value's path breaks off", func(t *testing.T) {
+		for _, tc := range []struct {
+			name    string
+			pointer string
+			reason  string
+		}{
+			{"missing key", "/missing", `but the document has no key "missing"`},
+			{"index out of range", "/details/1", `but ["details"] has 1 element, there is no [1]`},
+			{"not an index", "/details/first", `but ["details"] is an array, "first" is not an index`},
+			{"leading zero", "/details/00", `"00" is not an index`},
+			{"scalar", "/details/0/key/x", `but ["details"][0]["key"] is a string, not an object or array`},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				matcher, err := NewJsonPointerMatcher(tc.pointer)
+				be.Expect(t, err).NotTo(be.HaveOccurred())
+				be.ExpectValues(t)(matcher.Match(doc)).To(be.False())
+				be.Expect(t, matcher.FailureMessage(doc)).To(be.ContainSubstring(tc.reason))
+			})
+		}
+	})
+
+	t.Run("should report a mismatching value at its path", func(t *testing.T) {
+		matcher, _ := NewJsonPointerMatcher("/details/0/key", "bar")
+		be.Expect(t, matcher.FailureMessage(doc)).To(be_string.HavingPrefix("at [\"details\"][0][\"key\"]:\n"))
+	})
+
+	t.Run("should work on Go maps and slices", func(t *testing.T) {
+		matcher, _ := NewJsonPointerMatcher("/users/1/age", 30)
+		be.ExpectValues(t)(matcher.Match(map[string]any{"users": []map[string]int{{"age": 20}, {"age": 30}}})).To(be.True())
+	})
+
+	t.Run("should error on an invalid pointer", func(t *testing.T) {
+		_, err := NewJsonPointerMatcher("details")
+		be.Expect(t, err).To(be.MatchError(be.ContainSubstring("must be empty or start with '/'")))
+
+		_, err = NewJsonPointerMatcher("/a~2")
+		be.Expect(t, err).To(be.MatchError(be.ContainSubstring("'~' must be escaped")))
+	})
+}
@@ -0,0 +1,564 @@
+package psi_matchers
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/expectto/be/internal/beformat"
+	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
+	"github.com/expectto/be/types"
+)
+
+// JsonQueryMatcher matches a decoded JSON value (objects are maps with string keys,
+// arrays are slices) by the values a JSONPath query selects, e.g. "$.items[?(@.status=='active')].id".
+// The selected values (a []any, in document order) are matched against the given values
+// or matchers. With no args, it succeeds if the query selects anything.
+//
+// The supported subset of JSONPath:
+//   - `$` the root, `.name` / `['name']` / `["name"]` a member, `['a','b']` several members,
+//   - `[0]` an element (`[-1]` is the last one), `.*` / `[*]` all the members or elements,
+//   - `..name`, `..*`, `..[0]` the same applied to the value and all its descendants,
+//   - `[?(@.status == 'active')]` the members or elements passing the filter: `@` paths compared
+//     (==, !=, <, <=, >, >=) with strings, numbers, true, false and null, or `[?(@.name)]` existence,
+//     joined with && and ||.
+type JsonQueryMatcher struct {
+	*MixinMatcherGomock
+
+	query    string
+	steps    []jsonStep
+	matching types.BeMatcher
+}
+
+var _ types.BeMatcher = &JsonQueryMatcher{}
+
+// NewJsonQueryMatcher creates a JsonQueryMatcher, an invalid query is an error
+func NewJsonQueryMatcher(query string, args ...any) (*JsonQueryMatcher, error) {
+	steps, err := (&jsonQueryParser{query: query}).parse()
+	if err != nil {
+		return nil, err
+	}
+
+	matcher := &JsonQueryMatcher{query: query, steps: steps}
+	matcher.MixinMatcherGomock = NewMixinMatcherGomock(matcher, "Query")
+	if len(args) > 0 {
+		matcher.matching = Psi(args...)
+	}
+	return matcher, nil
+}
+
+func (matcher *JsonQueryMatcher) Explain(actual any) types.Outcome {
+	selected := matcher.selectValues(actual)
+
+	if matcher.matching == nil {
+		if len(selected) == 0 {
+			return Failed(beformat.Message(actual, "to "+matcher.String()+", but nothing was selected"))
+		}
+		return Succeeded(beformat.Message(actual, "not to "+matcher.String()+", but selected "+beformat.Value(selected)))
+	}
+
+	o := Explain(matcher.matching, selected)
+	switch {
+	case o.Err != nil:
+		return Errored(fmt.Errorf("%s selected %s: %w", matcher.query, beformat.Value(selected), o.Err))
+	case o.Success:
+		return Succeeded(beformat.Message(actual, "not to "+matcher.String()+", but selected "+beformat.Value(selected)))
+	case len(selected) == 0:
+		return Failed(beformat.Message(actual, "to "+matcher.String()+", but nothing was selected"))
+	}
+	return Failed(beformat.Message(actual, fmt.Sprintf(
+		"to %s, but selected %s that don't match:\n  %s",
+		matcher.String(), beformat.Value(selected), indentListed(beformat.Compact(o.Message)),
+	)))
+}
+
+// selectValues applies the steps of the query one after another, starting at the root
+func (matcher *JsonQueryMatcher) selectValues(actual any) []any {
+	nodes := []any{actual}
+	for _, step := range matcher.steps {
+		var next []any
+		for _, node := range nodes {
+			if step.descendants {
+				for _, n := range jsonDescendants(node) {
+					next = append(next, step.apply(n)...)
+				}
+				continue
+			}
+			next = append(next, step.apply(node)...)
+		}
+		nodes = next
+	}
+
+	if nodes == nil {
+		return []any{}
+	}
+	return nodes
+}
+
+// jsonDescendants returns the value and all its descendants (depth-first, in document order)
+func jsonDescendants(v any) []any {
+	nodes := []any{v}
+	children, _ := jsonChildren(v)
+	for _, child := range children {
+		nodes = append(nodes, jsonDescendants(child)...)
+	}
+	return nodes
+}
+
+// jsonStep selects some of the children of a node:
+// the members by names, the element by index, all of them (wildcard) or the ones passing the filter
+type jsonStep struct {
+	descendants bool // applied to the node and all its descendants (`..`)
+
+	names    []string
+	index    *int
+	wildcard bool
+	filter   [][]jsonCondition // ORed groups of ANDed conditions
+}
+
+func (step jsonStep) apply(node any) []any {
+	rv := reflect.ValueOf(node)
+	switch {
+	case step.names != nil:
+		if !isJsonObject(rv) {
+			return nil
+		}
+		var selected []any
+		for _, name := range step.names {
+			if v, ok := jsonObjectValue(rv, name); ok {
+				selected = append(selected, v)
+			}
+		}
+		return selected
+	case step.index != nil:
+		if !isJsonArray(rv) {
+			return nil
+		}
+		i := *step.index
+		if i < 0 {
+			i += rv.Len()
+		}
+		if i < 0 || i >= rv.Len() {
+			return nil
+		}
+		return []any{rv.Index(i).Interface()}
+	}
+
+	children, _ := jsonChildren(node)
+	if step.wildcard {
+		return children
+	}
+	var selected []any
+	for _, child := range children {
+		if step.passes(child) {
+			selected = append(selected, child)
+		}
+	}
+	return selected
+}
+
+func (step jsonStep) passes(v any) bool {
+	for _, group := range step.filter {
+		passed := true
+		for _, cond := range group {
+			if !cond.holds(v) {
+				passed = false
+				break
+			}
+		}
+		if passed {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonCondition is a filter condition: the value at the relative path (`@.a.b`)
+// compared with the literal, or existing (no operator)
+type jsonCondition struct {
+	path    []any // member names (string) and indices (int)
+	op      string
+	literal any
+}
+
+func (cond jsonCondition) holds(v any) bool {
+	for _, key := range cond.path {
+		rv := reflect.ValueOf(v)
+		var ok bool
+		switch key := key.(type) {
+		case string:
+			if isJsonObject(rv) {
+				v, ok = jsonObjectValue(rv, key)
+			}
+		case int:
+			if isJsonArray(rv) && key >= 0 && key < rv.Len() {
+				v, ok = rv.Index(key).Interface(), true
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+
+	if cond.op == "" {
+		return true
+	}
+	return jsonCompare(v, cond.op, cond.literal)
+}
+
+// jsonCompare compares a decoded JSON value with a literal:
+// numbers of any Go type by their value, strings lexicographically, anything else for equality only
+func jsonCompare(v any, op string, literal any) bool {
+	a, b := jsonNumber(v), jsonNumber(literal)
+	switch op {
+	case "==":
+		return reflect.DeepEqual(a, b)
+	case "!=":
+		return !reflect.DeepEqual(a, b)
+	}
+
+	var c int
+	switch a := a.(type) {
+	case float64:
+		b, ok := b.(float64)
+		if !ok {
+			return false
+		}
+		c = cmp.Compare(a, b)
+	case string:
+		b, ok := b.(string)
+		if !ok {
+			return false
+		}
+		c = strings.Compare(a, b)
+	default:
+		return false
+	}
+
+	switch op {
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	case ">":
+		return c > 0
+	}
+	return c >= 0
+}
+
+// jsonNumber converts a number of any Go type to float64 (as encoding/json decodes them)
+// and a string of any string type to string, so a query compares Go maps and decoded JSON alike
+func jsonNumber(v any) any {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint())
+	case reflect.Float32, reflect.Float64:
+		return rv.Float()
+	case reflect.String:
+		return rv.String()
+	}
+	return v
+}
+
+func (matcher *JsonQueryMatcher) Match(actual any) (bool, error) {
+	o := matcher.Explain(actual)
+	return o.Success, o.Err
+}
+
+func (matcher *JsonQueryMatcher) FailureMessage(actual any) string {
+	return matcher.Explain(actual).Message
+}
+
+func (matcher *JsonQueryMatcher) NegatedFailureMessage(actual any) string {
+	return matcher.Explain(actual).Message
+}
+
+// String describes the matcher for gomock, e.g. `select values at $.items[*].id matching ...`
+func (matcher *JsonQueryMatcher) String() string {
+	s := "select values at " + matcher.query
+	if matcher.matching != nil {
+		s += " matching " + beformat.Compact(matcher.matching.String())
+	}
+	return s
+}
+
+// jsonQueryParser parses a JSONPath query (see JsonQueryMatcher for the supported subset)
+type jsonQueryParser struct {
+	query string
+	pos   int
+}
+
+func (p *jsonQueryParser) parse() ([]jsonStep, error) {
+	p.skipSpaces()
+	if !p.consume("$") {
+		return nil, p.errorf("it must start with '$'")
+	}
+
+	var steps []jsonStep
+	for p.skipSpaces(); p.pos < len(p.query); p.skipSpaces() {
+		var step jsonStep
+		var err error
+		switch {
+		case p.consume(".."):
+			step.descendants = true
+			if p.peek() == '[' {
+				err = p.bracket(&step)
+			} else {
+				err = p.dotted(&step)
+			}
+		case p.consume("."):
+			err = p.dotted(&step)
+		case p.peek() == '[':
+			err = p.bracket(&step)
+		default:
+			err = p.errorf("unexpected %q", p.query[p.pos])
+		}
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, step)
+	}
+	return steps, nil
+}
+
+// dotted parses `*` or a member name following a dot
+func (p *jsonQueryParser) dotted(step *jsonStep) error {
+	if p.consume("*") {
+		step.wildcard = true
+		return nil
+	}
+	name := p.name()
+	if name == "" {
+		return p.errorf("a member name or '*' expected")
+	}
+	step.names = []string{name}
+	return nil
+}
+
+// bracket parses `[*]`, `[0]`, `['a', 'b']` or `[?(...)]`
+func (p *jsonQueryParser) bracket(step *jsonStep) error {
+	p.consume("[")
+	p.skipSpaces()
+
+	switch c := p.peek(); {
+	case c == '*':
+		p.pos++
+		step.wildcard = true
+	case c == '?':
+		p.pos++
+		p.skipSpaces()
+		if !p.consume("(") {
+			return p.errorf("'(' expected after '?'")
+		}
+		filter, err := p.filter()
+		if err != nil {
+			return err
+		}
+		step.filter = filter
+	case c == '\'' || c == '"':
+		step.names = []string{}
+		for {
+			name, err := p.quoted()
+			if err != nil {
+				return err
+			}
+			step.names = append(step.names, name)
+			if p.skipSpaces(); !p.consume(",") {
+				break
+			}
+			p.skipSpaces()
+		}
+	default:
+		i, err := p.integer()
+		if err != nil {
+			return err
+		}
+		step.index = &i
+	}
+
+	if p.skipSpaces(); !p.consume("]") {
+		return p.errorf("']' expected")
+	}
+	return nil
+}
+
+// filter parses the conditions of `[?(...)]` up to the closing parenthesis
+func (p *jsonQueryParser) filter() ([][]jsonCondition, error) {
+	groups := [][]jsonCondition{nil}
+	for {
+		cond, err := p.condition()
+		if err != nil {
+			return nil, err
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], cond)
+
+		p.skipSpaces()
+		switch {
+		case p.consume(")"):
+			return groups, nil
+		case p.consume("&&"):
+		case p.consume("||"):
+			groups = append(groups, nil)
+		default:
+			return nil, p.errorf("'&&', '||' or ')' expected")
+		}
+	}
+}
+
+// condition parses `@.path`, optionally followed by an operator and a literal
+func (p *jsonQueryParser) condition() (jsonCondition, error) {
+	var cond jsonCondition
+	if p.skipSpaces(); !p.consume("@") {
+		return cond, p.errorf("'@' expected")
+	}
+	for {
+		switch {
+		case p.consume("."):
+			name := p.name()
+			if name == "" {
+				return cond, p.errorf("a member name expected")
+			}
+			cond.path = append(cond.path, name)
+			continue
+		case p.peek() == '[':
+			p.pos++
+			p.skipSpaces()
+			if c := p.peek(); c == '\'' || c == '"' {
+				name, err := p.quoted()
+				if err != nil {
+					return cond, err
+				}
+				cond.path = append(cond.path, name)
+			} else {
+				i, err := p.integer()
+				if err != nil {
+					return cond, err
+				}
+				cond.path = append(cond.path, i)
+			}
+			if p.skipSpaces(); !p.consume("]") {
+				return cond, p.errorf("']' expected")
+			}
+			continue
+		}
+		break
+	}
+
+	p.skipSpaces()
+	for _, op := range []string{"==", "!=", "<=", ">=", "<", ">"} {
+		if p.consume(op) {
+			cond.op = op
+			literal, err := p.literal()
+			if err != nil {
+				return cond, err
+			}
+			cond.literal = literal
+			break
+		}
+	}
+	return cond, nil
+}
+
+// literal parses a string, a number, true, false or null
+func (p *jsonQueryParser) literal() (any, error) {
+	p.skipSpaces()
+	if c := p.peek(); c == '\'' || c == '"' {
+		return p.quoted()
+	}
+	switch {
+	case p.consume("true"):
+		return true, nil
+	case p.consume("false"):
+		return false, nil
+	case p.consume("null"):
+		return nil, nil
+	}
+
+	start := p.pos
+	for p.pos < len(p.query) && strings.IndexByte("+-0123456789.eE", p.query[p.pos]) >= 0 {
+		p.pos++
+	}
+	n, err := strconv.ParseFloat(p.query[start:p.pos], 64)
+	if err != nil {
+		p.pos = start
+		return nil, p.errorf("a string, number, true, false or null expected")
+	}
+	return n, nil
+}
+
+// quoted parses a single- or double-quoted string, backslash escapes the next character
+func (p *jsonQueryParser) quoted() (string, error) {
+	quote := p.query[p.pos]
+	p.pos++
+
+	var b strings.Builder
+	for p.pos < len(p.query) {
+		c := p.query[p.pos]
+		p.pos++
+		switch {
+		case c == quote:
+			return b.String(), nil
+		case c == '\\' && p.pos < len(p.query):
+			b.WriteByte(p.query[p.pos])
+			p.pos++
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", p.errorf("unterminated string")
+}
+
+func (p *jsonQueryParser) integer() (int, error) {
+	start := p.pos
+	if p.peek() == '-' {
+		p.pos++
+	}
+	for p.pos < len(p.query) && p.query[p.pos] >= '0' && p.query[p.pos] <= '9' {
+		p.pos++
+	}
+	i, err := strconv.Atoi(p.query[start:p.pos])
+	if err != nil {
+		p.pos = start
+		return 0, p.errorf("an index, a quoted name, '*' or '?(' expected")
+	}
+	return i, nil
+}
+
+// name parses a member name: everything up to a delimiter
+func (p *jsonQueryParser) name() string {
+	start := p.pos
+	for p.pos < len(p.query) && !strings.ContainsRune(".[]()=!<>&| \t", rune(p.query[p.pos])) {
+		p.pos++
+	}
+	return p.query[start:p.pos]
+}
+
+func (p *jsonQueryParser) peek() byte {
+	if p.pos < len(p.query) {
+		return p.query[p.pos]
+	}
+	return 0
+}
+
+func (p *jsonQueryParser) consume(s string) bool {
+	if strings.HasPrefix(p.query[p.pos:], s) {
+		p.pos += len(s)
+		return true
+	}
+	return false
+}
+
+func (p *jsonQueryParser) skipSpaces() {
+	for p.pos < len(p.query) && (p.query[p.pos] == ' ' || p.query[p.pos] == '\t') {
+		p.pos++
+	}
+}
+
+func (p *jsonQueryParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("invalid JSONPath query %q at %d: %s", p.query, p.pos, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,86 @@
+package psi_matchers_test
+
+import (
+	"testing"
+
+	"github.com/expectto/be"
+	"github.com/expectto/be/be_string"
+	. "github.com/expectto/be/internal/psi_matchers"
+)
+
+func TestJsonQueryMatcher(t *testing.T) {
+	doc := decoded(`{
+		"store": {
+			"items": [
+				{"id": 1, "status": "active", "price": 5, "tags": ["new"]},
+				{"id": 2, "status": "gone", "price": 20},
+				{"id": 3, "status": "active", "price": 15, "meta": {"owner": "bob"}}
+			],
+			"owner": "alice"
+		}
+	}`)
+
+	t.Run("should select values", func(t *testing.T) {
+		for _, tc := range []struct {
+			name     string
+			query    string
+			expected []any
+		}{
+			{"member", "$.store.owner", []any{"alice"}},
+			{"bracketed members", "$['store'][\"owner\"]", []any{"alice"}},
+			{"element", "$.store.items[1].id", []any{2.0}},
+			{"last element", "$.store.items[-1].id", []any{3.0}},
+			{"wildcard", "$.store.items[*].id", []any{1.0, 2.0, 3.0}},
+			{"dot wildcard", "$.store.items.*.status", []any{"active", "gone", "active"}},
+			{"several members", "$.store.items[0]['id','price']", []any{1.0, 5.0}},
+			{"descendants", "$..owner", []any{"alice", "bob"}},
+			{"filter ==", "$.store.items[?(@.status=='active')].id", []any{1.0, 3.0}},
+			{"filter <", "$.store.items[?(@.price < 10)].id", []any{1.0}},
+			{"filter &&", `$.store.items[?(@.status == "active" && @.price >= 15)].id`, []any{3.0}},
+			{"filter ||", "$.store.items[?(@.id == 1 || @.id == 2)].id", []any{1.0, 2.0}},
+			{"filter existence", "$.store.items[?(@.tags)].id", []any{1.0}},
+			{"filter nested path", "$.store.items[?(@.meta.owner == 'bob')].id", []any{3.0}},
+			{"nothing", "$.store.missing", []any{}},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				matcher, err := NewJsonQueryMatcher(tc.query, be.Eq(tc.expected))
+				be.Expect(t, err).NotTo(be.HaveOccurred())
+				be.ExpectValues(t)(matcher.Match(doc)).To(be.True(), matcher.FailureMessage(doc))
+			})
+		}
+	})
+
+	t.Run("should succeed with no args if anything is selected", func(t *testing.T) {
+		matcher, _ := NewJsonQueryMatcher("$.store.items[?(@.price > 100)]")
+		be.ExpectValues(t)(matcher.Match(doc)).To(be.False())
+		be.Expect(t, matcher.FailureMessage(doc)).To(be_string.HavingSuffix("but nothing was selected"))
+	})
+
+	t.Run("should tell the selected values that don't match", func(t *testing.T) {
+		matcher, _ := NewJsonQueryMatcher("$.store.items[*].id", be.HaveLength(2))
+		be.Expect(t, matcher.FailureMessage(doc)).To(be.ContainSubstring("but selected [1, 2, 3] that don't match:"))
+	})
+
+	t.Run("should compare numbers of any Go type", func(t *testing.T) {
+		matcher, _ := NewJsonQueryMatcher("$[?(@.age > 21)].name", be.Eq([]any{"bob"}))
+		be.ExpectValues(t)(matcher.Match([]map[string]any{{"name": "alice", "age": 20}, {"name": "bob", "age": uint8(30)}})).To(be.True())
+	})
+
+	t.Run("should error on an invalid query", func(t *testing.T) {
+		for _, tc := range []struct {
+			name  string
+			query string
+		}{
+			{"no root", "store.owner"},
+			{"unclosed bracket", "$.store[0"},
+			{"unterminated string", "$['store"},
+			{"bad filter", "$.items[?(@.id ==)]"},
+			{"empty member", "$."},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := NewJsonQueryMatcher(tc.query)
+				be.Expect(t, err).To(be.MatchError(be.ContainSubstring("invalid JSONPath query")))
+			})
+		}
+	})
+}
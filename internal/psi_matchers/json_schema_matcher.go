@@ -0,0 +1,215 @@
+package psi_matchers
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/amberpixels/k1/cast"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+
+	"github.com/expectto/be/internal/beformat"
+	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
+	"github.com/expectto/be/types"
+)
+
+// schemaURL is the URL an in-memory schema is compiled under
+const schemaURL = "be:///schema.json"
+
+// JsonSchemaMatcher matches a decoded JSON value (or any value that marshals to JSON)
+// valid against a JSON Schema: draft 2020-12 by default (or the one given by `$schema`),
+// the core, applicator and validation vocabularies; `format` is an annotation only.
+//
+// A failure lists every violation with its instance path and the keyword location
+// in the schema, e.g. `["items"][0]["id"]: minimum: got 0, want 1 (#/$defs/id/minimum)`.
+type JsonSchemaMatcher struct {
+	*MixinMatcherGomock
+
+	source string // the file name, if loaded from a file
+	url    string
+	schema *jsonschema.Schema
+}
+
+var _ types.BeMatcher = &JsonSchemaMatcher{}
+
+// NewJsonSchemaMatcher creates a JsonSchemaMatcher of the schema given as
+// a JSON string/bytes or as an already decoded value
+func NewJsonSchemaMatcher(schema any) (*JsonSchemaMatcher, error) {
+	var doc any
+	var err error
+	if cast.IsStringish(schema) {
+		doc, err = jsonschema.UnmarshalJSON(bytes.NewReader(cast.AsBytes(schema)))
+	} else {
+		doc, err = jsonValue(schema)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid JSON schema: %w", err)
+	}
+
+	c := jsonschema.NewCompiler()
+	if err := c.AddResource(schemaURL, doc); err != nil {
+		return nil, fmt.Errorf("invalid JSON schema: %w", err)
+	}
+	return newJsonSchemaMatcher(c, schemaURL, "")
+}
+
+// NewJsonSchemaFileMatcher creates a JsonSchemaMatcher of the schema file of fsys.
+// Relative `$ref`s (e.g. "common.json#/$defs/id") are loaded from fsys as well.
+func NewJsonSchemaFileMatcher(fsys fs.FS, name string) (*JsonSchemaMatcher, error) {
+	c := jsonschema.NewCompiler()
+	c.UseLoader(jsonschema.SchemeURLLoader{"fs": fsLoader{fsys}})
+	return newJsonSchemaMatcher(c, (&url.URL{Scheme: "fs", Path: "/" + name}).String(), name)
+}
+
+func newJsonSchemaMatcher(c *jsonschema.Compiler, url, source string) (*JsonSchemaMatcher, error) {
+	c.DefaultDraft(jsonschema.Draft2020)
+	schema, err := c.Compile(url)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JSON schema: %w", err)
+	}
+
+	matcher := &JsonSchemaMatcher{source: source, url: url, schema: schema}
+	matcher.MixinMatcherGomock = NewMixinMatcherGomock(matcher, "MatchSchema")
+	return matcher, nil
+}
+
+// fsLoader loads the `fs:///path` URLs from the file system
+type fsLoader struct {
+	fsys fs.FS
+}
+
+func (l fsLoader) Load(rawURL string) (any, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	contents, err := fs.ReadFile(l.fsys, strings.TrimPrefix(u.Path, "/"))
+	if err != nil {
+		return nil, err
+	}
+	return jsonschema.UnmarshalJSON(bytes.NewReader(contents))
+}
+
+// jsonValue returns the value the way the validator expects it (numbers as json.Number),
+// re-marshaling it, so structs, typed maps and slices become JSON values too
+func jsonValue(v any) (any, error) {
+	contents, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return jsonschema.UnmarshalJSON(bytes.NewReader(contents))
+}
+
+// schemaViolation is a single failed keyword: where in the instance and where in the schema
+type schemaViolation struct {
+	path    string // instance path, e.g. `["items"][0]`
+	pointer string // instance JSON Pointer, used for sorting
+	keyword string // keyword location, e.g. `#/$defs/id/minimum`
+	message string
+}
+
+var schemaPrinter = message.NewPrinter(language.English)
+
+func (matcher *JsonSchemaMatcher) Explain(actual any) types.Outcome {
+	doc, err := jsonValue(actual)
+	if err != nil {
+		return Errored(fmt.Errorf("MatchSchema matcher expects a JSON value: %w", err))
+	}
+
+	err = matcher.schema.Validate(doc)
+	if err == nil {
+		return Succeeded(beformat.Message(actual, "not to "+matcher.String()))
+	}
+	var verr *jsonschema.ValidationError
+	if !errors.As(err, &verr) {
+		return Errored(err)
+	}
+
+	violations := matcher.violations(doc, verr, nil)
+	slices.SortStableFunc(violations, func(a, b schemaViolation) int {
+		return cmp.Or(strings.Compare(a.pointer, b.pointer), strings.Compare(a.keyword, b.keyword))
+	})
+
+	lines := make([]string, len(violations))
+	for i, v := range violations {
+		lines[i] = fmt.Sprintf("%s: %s (%s)", jsonLocation(v.path), v.message, v.keyword)
+	}
+	return Failed(beformat.Message(actual, fmt.Sprintf(
+		"to %s, but it has %d violation%s:\n    %s",
+		matcher.String(), len(violations), plural(len(violations)), strings.Join(lines, "\n    "),
+	)))
+}
+
+// violations collects the leaves of the error tree: the keywords that failed
+func (matcher *JsonSchemaMatcher) violations(doc any, verr *jsonschema.ValidationError, acc []schemaViolation) []schemaViolation {
+	if len(verr.Causes) > 0 {
+		for _, cause := range verr.Causes {
+			acc = matcher.violations(doc, cause, acc)
+		}
+		return acc
+	}
+
+	keyword := verr.SchemaURL
+	for _, token := range verr.ErrorKind.KeywordPath() {
+		keyword += "/" + strings.NewReplacer("~", "~0", "/", "~1").Replace(token)
+	}
+
+	return append(acc, schemaViolation{
+		path:    instancePath(doc, verr.InstanceLocation),
+		pointer: "/" + strings.Join(verr.InstanceLocation, "/"),
+		keyword: matcher.displayURL(keyword),
+		message: verr.ErrorKind.LocalizedString(schemaPrinter),
+	})
+}
+
+// instancePath renders the instance location the way paths are shown, e.g. `["items"][0]`
+func instancePath(doc any, tokens []string) string {
+	var path string
+	v := doc
+	for _, token := range tokens {
+		child, segment, reason := jsonPointerChild(v, token)
+		if reason != "" {
+			segment = IndexSegment(token)
+		}
+		v, path = child, path+segment
+	}
+	return path
+}
+
+// displayURL shortens a schema location:
+// `#/...` within the schema itself, `common.json#/...` within another file
+func (matcher *JsonSchemaMatcher) displayURL(u string) string {
+	if rest, ok := strings.CutPrefix(u, matcher.url); ok && (rest == "" || rest[0] == '#') {
+		return "#" + strings.TrimPrefix(rest, "#")
+	}
+	return strings.TrimPrefix(u, "fs:///")
+}
+
+func (matcher *JsonSchemaMatcher) Match(actual any) (bool, error) {
+	o := matcher.Explain(actual)
+	return o.Success, o.Err
+}
+
+func (matcher *JsonSchemaMatcher) FailureMessage(actual any) string {
+	return matcher.Explain(actual).Message
+}
+
+func (matcher *JsonSchemaMatcher) NegatedFailureMessage(actual any) string {
+	return matcher.Explain(actual).Message
+}
+
+// String describes the matcher for gomock, e.g. `match the JSON schema "schemas/user.json"`
+func (matcher *JsonSchemaMatcher) String() string {
+	if matcher.source != "" {
+		return fmt.Sprintf("match the JSON schema %q", matcher.source)
+	}
+	return "match the JSON schema"
+}
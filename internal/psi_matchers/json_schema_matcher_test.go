@@ -0,0 +1,92 @@
+package psi_matchers_test
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/expectto/be"
+	"github.com/expectto/be/be_string"
+	. "github.com/expectto/be/internal/psi_matchers"
+)
+
+func TestJsonSchemaMatcher(t *testing.T) {
+	const schema = `{
+		"$defs": {"id": {"type": "integer", "minimum": 1}},
+		"type": "object",
+		"required": ["name", "items"],
+		"properties": {
+			"name": {"type": "string", "minLength": 1},
+			"items": {"type": "array", "items": {"properties": {"id": {"$ref": "#/$defs/id"}}}}
+		},
+		"additionalProperties": false
+	}`
+
+	t.Run("should validate against the schema", func(t *testing.T) {
+		for _, tc := range []struct {
+			name     string
+			actual   any
+			expected bool
+		}{
+			{"valid", decoded(`{"name": "gopher", "items": [{"id": 1}, {"id": 2}]}`), true},
+			{"valid Go values", map[string]any{"name": "gopher", "items": []map[string]int{{"id": 1}}}, true},
+			{"missing property", decoded(`{"items": []}`), false},
+			{"not an integer", decoded(`{"name": "gopher", "items": [{"id": 1.5}]}`), false},
+			{"not an object", decoded(`[]`), false},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				matcher, err := NewJsonSchemaMatcher(schema)
+				be.Expect(t, err).NotTo(be.HaveOccurred())
+				be.ExpectValues(t)(matcher.Match(tc.actual)).To(be.Eq(tc.expected))
+			})
+		}
+	})
+
+	t.Run("should list every violation with its instance path and keyword location", func(t *testing.T) {
+		matcher, _ := NewJsonSchemaMatcher(schema)
+		actual := decoded(`{"name": "", "items": [{"id": 0}, {"id": 1.5}], "extra": true}`)
+		be.Expect(t, matcher.FailureMessage(actual)).To(be_string.HavingSuffix(`to match the JSON schema, but it has 4 violations:
+    the document: additional properties 'extra' not allowed (#/additionalProperties)
+    ["items"][0]["id"]: minimum: got 0, want 1 (#/$defs/id/minimum)
+    ["items"][1]["id"]: got number, want integer (#/$defs/id/type)
+    ["name"]: minLength: got 0, want 1 (#/properties/name/minLength)`))
+	})
+
+	t.Run("should accept an already decoded schema", func(t *testing.T) {
+		matcher, err := NewJsonSchemaMatcher(map[string]any{"type": "string", "enum": []string{"a", "b"}})
+		be.Expect(t, err).NotTo(be.HaveOccurred())
+		be.ExpectValues(t)(matcher.Match("a")).To(be.True())
+		be.ExpectValues(t)(matcher.Match("c")).To(be.False())
+	})
+
+	t.Run("should follow the $schema draft", func(t *testing.T) {
+		matcher, err := NewJsonSchemaMatcher(`{"$schema": "http://json-schema.org/draft-07/schema#", "items": [{"type": "string"}]}`)
+		be.Expect(t, err).NotTo(be.HaveOccurred())
+		be.ExpectValues(t)(matcher.Match([]any{"a", 1})).To(be.True())
+		be.ExpectValues(t)(matcher.Match([]any{1})).To(be.False())
+	})
+
+	t.Run("should load the schema file and its references from the file system", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"schemas/user.json":   {Data: []byte(`{"properties": {"id": {"$ref": "common.json#/$defs/id"}}}`)},
+			"schemas/common.json": {Data: []byte(`{"$defs": {"id": {"type": "string"}}}`)},
+		}
+		matcher, err := NewJsonSchemaFileMatcher(fsys, "schemas/user.json")
+		be.Expect(t, err).NotTo(be.HaveOccurred())
+		be.ExpectValues(t)(matcher.Match(map[string]any{"id": "u1"})).To(be.True())
+		be.Expect(t, matcher.FailureMessage(map[string]any{"id": 1})).To(be.ContainSubstring(
+			`["id"]: got number, want string (schemas/common.json#/$defs/id/type)`,
+		))
+		be.Expect(t, matcher.String()).To(be.Eq(`match the JSON schema "schemas/user.json"`))
+	})
+
+	t.Run("should error on an invalid schema", func(t *testing.T) {
+		_, err := NewJsonSchemaMatcher(`{"type": 1}`)
+		be.Expect(t, err).To(be.MatchError(be.ContainSubstring("invalid JSON schema")))
+
+		_, err = NewJsonSchemaMatcher(`{"type": `)
+		be.Expect(t, err).To(be.MatchError(be.ContainSubstring("invalid JSON schema")))
+
+		_, err = NewJsonSchemaFileMatcher(fstest.MapFS{}, "missing.json")
+		be.Expect(t, err).To(be.MatchError(be.ContainSubstring("invalid JSON schema")))
+	})
+}
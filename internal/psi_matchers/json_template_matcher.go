@@ -0,0 +1,231 @@
+package psi_matchers
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/expectto/be/internal/beformat"
+	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
+	"github.com/expectto/be/types"
+)
+
+// JsonTemplateMatcher matches a JSON value against a JSON template: the expected JSON text
+// with `{{name}}` placeholders, each matched by the value (V) of the same name, e.g.
+// `{"id": "{{id}}", "items": [{"n": {{n}}}], "auth": "Bearer {{jwt}}"}`.
+//
+// A quoted placeholder (`"{{id}}"`) stands for a string, a bare one (`{{n}}`) for any JSON value,
+// placeholders within a string are matched as be_string.MatchTemplate does.
+// A placeholder with no value matches anything, and all the occurrences of a placeholder
+// must be the same value. The rest of the template is compared as JsonEquivalentMatcher does.
+type JsonTemplateMatcher struct {
+	*MixinMatcherGomock
+
+	template string
+	expected any
+}
+
+var _ types.BeMatcher = &JsonTemplateMatcher{}
+
+var placeholderRegex = regexp.MustCompile(`{{\s*([^}\s]+)\s*}}`)
+
+// barePlaceholder marks a bare placeholder turned into a string, so the template can be decoded
+const barePlaceholder = "\x00"
+
+// NewJsonTemplateMatcher creates a JsonTemplateMatcher.
+// Every value must have its placeholder in the template.
+func NewJsonTemplateMatcher(template string, values ...*Value) (*JsonTemplateMatcher, error) {
+	if strings.Contains(template, "}}{{") {
+		return nil, fmt.Errorf("invalid JSON template: placeholders can't be concatenated without separators")
+	}
+
+	var decoded any
+	if err := json.Unmarshal([]byte(quoteBarePlaceholders(template)), &decoded); err != nil {
+		return nil, fmt.Errorf("invalid JSON template: %w", err)
+	}
+
+	byName := make(map[string]*Value, len(values))
+	for _, v := range values {
+		byName[strings.ToLower(v.Name)] = v
+	}
+	used := make(map[string]bool, len(values))
+	expected := compileTemplate(decoded, byName, used)
+	for _, v := range values {
+		if !used[strings.ToLower(v.Name)] {
+			return nil, fmt.Errorf("invalid JSON template: no {{%s}} placeholder for the value", v.Name)
+		}
+	}
+
+	matcher := &JsonTemplateMatcher{template: template, expected: expected}
+	matcher.MixinMatcherGomock = NewMixinMatcherGomock(matcher, "MatchTemplate")
+	return matcher, nil
+}
+
+// quoteBarePlaceholders turns the placeholders outside of strings into (marked) strings,
+// e.g. `{"n": {{n}}}` into `{"n": "\u0000{{n}}"}`
+func quoteBarePlaceholders(template string) string {
+	var b strings.Builder
+	inString := false
+	for i := 0; i < len(template); i++ {
+		switch ch := template[i]; {
+		case inString && ch == '\\' && i+1 < len(template):
+			b.WriteString(template[i : i+2])
+			i++
+			continue
+		case ch == '"':
+			inString = !inString
+		case !inString && ch == '{':
+			if loc := placeholderRegex.FindStringIndex(template[i:]); loc != nil && loc[0] == 0 {
+				quoted, _ := json.Marshal(barePlaceholder + template[i:i+loc[1]])
+				b.Write(quoted)
+				i += loc[1] - 1
+				continue
+			}
+		}
+		b.WriteByte(template[i])
+	}
+	return b.String()
+}
+
+// compileTemplate replaces the strings having placeholders in the decoded template
+// by jsonPlaceholder and jsonStringTemplate, marking the values they use
+func compileTemplate(v any, values map[string]*Value, used map[string]bool) any {
+	switch x := v.(type) {
+	case map[string]any:
+		for k, child := range x {
+			x[k] = compileTemplate(child, values, used)
+		}
+	case []any:
+		for i, child := range x {
+			x[i] = compileTemplate(child, values, used)
+		}
+	case string:
+		text, bare := strings.CutPrefix(x, barePlaceholder)
+		if m := placeholderRegex.FindStringSubmatch(text); m != nil && m[0] == text {
+			name := strings.ToLower(m[1])
+			p := jsonPlaceholder{name: name, quoted: !bare}
+			if value, ok := values[name]; ok {
+				p.matcher, used[name] = value.Matcher, true
+			}
+			return p
+		}
+
+		var stringValues []*Value
+		for _, m := range placeholderRegex.FindAllStringSubmatch(x, -1) {
+			name := strings.ToLower(m[1])
+			if value, ok := values[name]; ok && !slices.Contains(stringValues, value) {
+				stringValues, used[name] = append(stringValues, value), true
+			}
+		}
+		if len(stringValues) > 0 || placeholderRegex.MatchString(x) {
+			return jsonStringTemplate{text: x, matcher: NewStringTemplateMatcher(x, stringValues...)}
+		}
+	}
+	return v
+}
+
+// jsonExpectation is an expected value of a JSON comparison that compares itself with the actual one
+type jsonExpectation interface {
+	compareJSON(c *jsonComparison, path string, actual any)
+}
+
+// jsonPlaceholder is a `{{name}}` placeholder of a JSON template
+type jsonPlaceholder struct {
+	name    string
+	quoted  bool
+	matcher types.BeMatcher // nil matches anything
+}
+
+func (p jsonPlaceholder) compareJSON(c *jsonComparison, path string, actual any) {
+	if _, ok := actual.(string); p.quoted && !ok {
+		c.lines = append(c.lines, fmt.Sprintf("  %s: %s expects a string, got %s", displayJsonPath(path), p, beformat.Value(actual)))
+		return
+	}
+
+	if bound, ok := c.bound[p.name]; ok {
+		if !reflect.DeepEqual(bound.value, actual) {
+			c.lines = append(c.lines, fmt.Sprintf("  %s: %s is %s, but %s at %s",
+				displayJsonPath(path), p, beformat.Value(actual), beformat.Value(bound.value), displayJsonPath(bound.path)))
+		}
+		return
+	}
+	if c.bound == nil {
+		c.bound = make(map[string]jsonBinding)
+	}
+	c.bound[p.name] = jsonBinding{value: actual, path: path}
+
+	if p.matcher != nil {
+		c.explain(path, p, p.matcher, actual)
+	}
+}
+
+// String renders the placeholder the way it's written in the template
+func (p jsonPlaceholder) String() string {
+	if p.quoted {
+		return strconv.Quote("{{" + p.name + "}}")
+	}
+	return "{{" + p.name + "}}"
+}
+
+// jsonBinding is the value of a placeholder: where it was met first
+type jsonBinding struct {
+	value any
+	path  string
+}
+
+// jsonStringTemplate is a string of a JSON template with placeholders among other text
+type jsonStringTemplate struct {
+	text    string
+	matcher *StringTemplateMatcher
+}
+
+func (t jsonStringTemplate) compareJSON(c *jsonComparison, path string, actual any) {
+	if _, ok := actual.(string); !ok {
+		c.lines = append(c.lines, fmt.Sprintf("  %s: %s expects a string, got %s", displayJsonPath(path), t, beformat.Value(actual)))
+		return
+	}
+	c.explain(path, t, t.matcher, actual)
+}
+
+func (t jsonStringTemplate) String() string {
+	return strconv.Quote(t.text)
+}
+
+func (matcher *JsonTemplateMatcher) Explain(actual any) types.Outcome {
+	data, err := remarshal(actual)
+	if err != nil {
+		return Errored(fmt.Errorf("MatchTemplate matcher expects a JSON value: %w", err))
+	}
+
+	c := &jsonComparison{matcher: &JsonEquivalentMatcher{}}
+	c.compare(nil, "", matcher.expected, data)
+	switch {
+	case c.err != nil:
+		return Errored(c.err)
+	case len(c.lines) == 0:
+		return Succeeded(beformat.Message(actual, "not to "+matcher.String()))
+	}
+	return Failed(beformat.DiffMessage(actual, "to "+matcher.String(), c.lines))
+}
+
+func (matcher *JsonTemplateMatcher) Match(actual any) (bool, error) {
+	o := matcher.Explain(actual)
+	return o.Success, o.Err
+}
+
+func (matcher *JsonTemplateMatcher) FailureMessage(actual any) string {
+	return matcher.Explain(actual).Message
+}
+
+func (matcher *JsonTemplateMatcher) NegatedFailureMessage(actual any) string {
+	return matcher.Explain(actual).Message
+}
+
+// String describes the matcher for gomock, e.g. `match the JSON template {"id": "{{id}}"}`
+func (matcher *JsonTemplateMatcher) String() string {
+	return "match the JSON template " + strings.Join(strings.Fields(matcher.template), " ")
+}
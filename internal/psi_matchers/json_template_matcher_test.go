@@ -0,0 +1,68 @@
+package psi_matchers_test
+
+import (
+	"testing"
+
+	"github.com/expectto/be"
+	"github.com/expectto/be/be_string"
+	. "github.com/expectto/be/internal/psi_matchers"
+)
+
+func TestJsonTemplateMatcher(t *testing.T) {
+	const template = `{
+		"id": "{{id}}",
+		"owner": {"id": "{{id}}"},
+		"items": [{"n": {{n}}, "note": "{{ note }}"}],
+		"auth": "Bearer {{token}}",
+		"text": "a \"{{quoted}}\" {{word}}"
+	}`
+	values := []*Value{V("id", be_string.HavingPrefix("u-")), V("n", be.Gt(0)), V("token", be.HaveLength(3))}
+
+	t.Run("should match the template", func(t *testing.T) {
+		for _, tc := range []struct {
+			name     string
+			actual   string
+			expected bool
+		}{
+			{"matching",
+				`{"id": "u-1", "owner": {"id": "u-1"}, "items": [{"n": 2, "note": "any"}], "auth": "Bearer abc", "text": "a \"x\" y"}`, true},
+			{"a value not matching its placeholder",
+				`{"id": "x-1", "owner": {"id": "x-1"}, "items": [{"n": 2, "note": "any"}], "auth": "Bearer abc", "text": "a \"x\" y"}`, false},
+			{"different values of the same placeholder",
+				`{"id": "u-1", "owner": {"id": "u-2"}, "items": [{"n": 2, "note": "any"}], "auth": "Bearer abc", "text": "a \"x\" y"}`, false},
+			{"a quoted placeholder not being a string",
+				`{"id": "u-1", "owner": {"id": "u-1"}, "items": [{"n": 2, "note": 5}], "auth": "Bearer abc", "text": "a \"x\" y"}`, false},
+			{"a string template not matching",
+				`{"id": "u-1", "owner": {"id": "u-1"}, "items": [{"n": 2, "note": "any"}], "auth": "Basic abc", "text": "a \"x\" y"}`, false},
+			{"a different structure",
+				`{"id": "u-1", "owner": {"id": "u-1"}, "items": [], "auth": "Bearer abc", "text": "a \"x\" y"}`, false},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				matcher, err := NewJsonTemplateMatcher(template, values...)
+				be.Expect(t, err).NotTo(be.HaveOccurred())
+				be.ExpectValues(t)(matcher.Match(decoded(tc.actual))).To(be.Eq(tc.expected))
+			})
+		}
+	})
+
+	t.Run("should show the differences by path", func(t *testing.T) {
+		matcher, _ := NewJsonTemplateMatcher(template, values...)
+		actual := decoded(`{"id": "u-1", "owner": {"id": "u-2"}, "items": [{"n": 0, "note": 1}], "auth": "Bearer abc", "text": "a \"x\" y", "v": 1}`)
+		be.Expect(t, matcher.FailureMessage(actual)).To(be_string.HavingSuffix(`differences (- expected, + actual):
+    ["items"][0]["n"]: {{n}}: Expected 0 to be > 0
+    ["items"][0]["note"]: "{{note}}" expects a string, got 1
+    ["owner"]["id"]: "{{id}}" is "u-2", but "u-1" at ["id"]
+  + ["v"]: 1`))
+	})
+
+	t.Run("should error on an invalid template", func(t *testing.T) {
+		_, err := NewJsonTemplateMatcher(`{"id": {{id}`)
+		be.Expect(t, err).To(be.MatchError(be.ContainSubstring("invalid JSON template")))
+
+		_, err = NewJsonTemplateMatcher(`{"id": "{{id}}"}`, V("name", "x"))
+		be.Expect(t, err).To(be.MatchError(be.ContainSubstring("no {{name}} placeholder")))
+
+		_, err = NewJsonTemplateMatcher(`{"id": "{{a}}{{b}}"}`)
+		be.Expect(t, err).To(be.MatchError(be.ContainSubstring("can't be concatenated")))
+	})
+}
@@ -2,10 +2,9 @@ package psi_matchers
 
 import (
 	"fmt"
-	"strings"
 
+	"github.com/expectto/be/internal/beformat"
 	"github.com/golang-jwt/jwt/v5"
-	"github.com/onsi/gomega/format"
 
 	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
 	"github.com/expectto/be/types"
@@ -42,43 +41,49 @@ func NewJwtTokenMatcher(publicName string, cb func(token *jwt.Token) any, args .
 	return matcher
 }
 
-func (matcher *JwtTokenMatcher) Match(actual any) (bool, error) {
+func (matcher *JwtTokenMatcher) Explain(actual any) types.Outcome {
 	if actual == nil {
-		return false, fmt.Errorf("%s() expects actual value not to be nil", "jwt.Match")
+		return Errored(fmt.Errorf("%s() expects actual value not to be nil", "jwt.Match"))
 	}
 
-	actualUrl, ok := actual.(*jwt.Token)
+	token, ok := actual.(*jwt.Token)
 	if !ok {
-		return false, nil
+		return Failed(beformat.Message(actual, "to be a *jwt.Token"))
 	}
 
 	if matcher.cb == nil {
-		// we're just matching a valid URL
-		return true, nil
+		// we're just matching a valid token
+		return Succeeded(beformat.Message(actual, "not to be a *jwt.Token"))
 	}
 
-	v := matcher.cb(actualUrl)
+	v := matcher.cb(token)
 
 	// If no inner matchers were given, then we simply validated if {field value} is not empty
 	if matcher.matching == nil {
-		return v != "" && v != nil && v != 0, nil
+		return AtPath(matcher.publicName, nonEmpty(v, matcher.publicName))
 	}
 
 	// simply allow underlying matchers to do their job
-	return matcher.matching.Match(v)
+	return AtPath(matcher.publicName, Explain(matcher.matching, v))
 }
 
-func (matcher *JwtTokenMatcher) FailureMessage(actual any) string {
-	token, _ := actual.(*jwt.Token) // FailureMessage is only reached after Match saw a *jwt.Token
-	v := matcher.cb(token)
+func (matcher *JwtTokenMatcher) Match(actual any) (bool, error) {
+	o := matcher.Explain(actual)
+	return o.Success, o.Err
+}
 
-	if matcher.matching == nil {
-		return format.Message(v, "to be a non-empty "+"foo")
-	}
-	return matcher.matching.FailureMessage(v)
+func (matcher *JwtTokenMatcher) FailureMessage(actual any) string {
+	return matcher.Explain(actual).Message
 }
 
 func (matcher *JwtTokenMatcher) NegatedFailureMessage(actual any) string {
-	// todo: not so accurate
-	return strings.Replace(matcher.FailureMessage(actual), "\nto ", "\nnot to ", 1)
+	return matcher.Explain(actual).Message
+}
+
+func (matcher *JwtTokenMatcher) Children(actual any) []types.Child {
+	token, ok := actual.(*jwt.Token)
+	if !ok || matcher.cb == nil || matcher.matching == nil {
+		return nil
+	}
+	return []types.Child{{Segment: matcher.publicName, Matcher: matcher.matching, Actual: matcher.cb(token)}}
 }
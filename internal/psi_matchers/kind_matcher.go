@@ -5,7 +5,7 @@ import (
 	"strings"
 
 	"github.com/amberpixels/k1/cast"
-	"github.com/onsi/gomega/format"
+	"github.com/expectto/be/internal/beformat"
 
 	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
 	"github.com/expectto/be/types"
@@ -56,7 +56,7 @@ func (matcher *KindMatcher) Match(actual any) (bool, error) {
 
 func (matcher *KindMatcher) FailureMessage(actual any) string {
 	if matcher.kind != nil {
-		return format.Message(actual, "to be kind of "+matcher.kind.String())
+		return beformat.Message(actual, "to be kind of "+matcher.kind.String())
 	}
 
 	// Assuming that underlying message will be the same format
@@ -72,7 +72,7 @@ func (matcher *KindMatcher) FailureMessage(actual any) string {
 
 func (matcher *KindMatcher) NegatedFailureMessage(actual any) string {
 	if matcher.kind != nil {
-		return format.Message(actual, "not to be kind of "+matcher.kind.String())
+		return beformat.Message(actual, "not to be kind of "+matcher.kind.String())
 	}
 
 	failureMessage := matcher.matching.NegatedFailureMessage(actual)
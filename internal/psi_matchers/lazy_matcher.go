@@ -0,0 +1,56 @@
+package psi_matchers
+
+import (
+	"sync"
+
+	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
+	"github.com/expectto/be/types"
+)
+
+// LazyMatcher builds its matcher on first use, so a matcher can refer to itself
+// (e.g. a tree node whose children must be valid nodes as well).
+// The built matcher is reused afterward.
+type LazyMatcher struct {
+	build func() types.BeMatcher
+}
+
+var _ types.BeMatcher = &LazyMatcher{}
+
+func NewLazyMatcher(build func() types.BeMatcher) *LazyMatcher {
+	if build == nil {
+		panic("Lazy expects a non-nil matcher builder")
+	}
+	return &LazyMatcher{build: sync.OnceValue(build)}
+}
+
+func (m *LazyMatcher) Explain(actual any) types.Outcome {
+	return Explain(m.build(), actual)
+}
+
+func (m *LazyMatcher) Match(actual any) (bool, error) {
+	o := m.Explain(actual)
+	return o.Success, o.Err
+}
+
+func (m *LazyMatcher) FailureMessage(actual any) string {
+	return m.Explain(actual).Message
+}
+
+func (m *LazyMatcher) NegatedFailureMessage(actual any) string {
+	return m.Explain(actual).Message
+}
+
+func (m *LazyMatcher) Matches(actual any) bool {
+	success, _ := m.Match(actual)
+	return success
+}
+
+// String doesn't describe the built matcher:
+// a recursive one would describe itself endlessly
+func (m *LazyMatcher) String() string {
+	return "lazy matcher"
+}
+
+func (m *LazyMatcher) Children(actual any) []types.Child {
+	return ChildrenOf(actual, m.build())
+}
@@ -0,0 +1,226 @@
+package psi_matchers
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/expectto/be/internal/beformat"
+	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
+	"github.com/expectto/be/types"
+)
+
+type MapMode string
+
+const (
+	MapModeContaining MapMode = "containing"
+	MapModeExactly    MapMode = "exactly"
+	MapModeOnlyKeys   MapMode = "only keys"
+)
+
+// MapMatcher matches a map against expected entries, whose values are values or matchers:
+// all of them must be there with matching values (containing), and no other key (exactly).
+// In the only keys mode, it matches a map having no key but the given ones (values or matchers).
+//
+// Like HaveFieldsMatcher, it checks all the keys and reports every difference at once,
+// in sorted-key order: a missing key, an extra key, a mismatching value
+// (raw values as a diff, matchers by their failure message).
+type MapMatcher struct {
+	*MixinMatcherGomock
+
+	mode     MapMode
+	keys     []any
+	expected map[any]any
+	matchers map[any]types.BeMatcher
+}
+
+var _ types.BeMatcher = &MapMatcher{}
+
+// NewMapMatcher creates a MapMatcher of the containing or exactly mode
+func NewMapMatcher(mode MapMode, expected map[any]any) *MapMatcher {
+	matcher := &MapMatcher{
+		mode:     mode,
+		keys:     sortedKeys(expected),
+		expected: expected,
+		matchers: make(map[any]types.BeMatcher, len(expected)),
+	}
+	matcher.MixinMatcherGomock = NewMixinMatcherGomock(matcher, matcher.name())
+
+	for k, v := range expected {
+		matcher.matchers[k] = Psi(v)
+	}
+	return matcher
+}
+
+// NewHaveOnlyKeysMatcher creates a MapMatcher of the only keys mode
+func NewHaveOnlyKeysMatcher(keys ...any) *MapMatcher {
+	matcher := &MapMatcher{mode: MapModeOnlyKeys, keys: keys}
+	matcher.MixinMatcherGomock = NewMixinMatcherGomock(matcher, "HaveOnlyKeys")
+	return matcher
+}
+
+// sortedKeys returns the keys sorted by their rendering, so failure output is deterministic
+func sortedKeys(m map[any]any) []any {
+	keys := make([]any, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.SortFunc(keys, compareKeys)
+	return keys
+}
+
+func compareKeys(a, b any) int {
+	return strings.Compare(beformat.Value(a), beformat.Value(b))
+}
+
+func (matcher *MapMatcher) Explain(actual any) types.Outcome {
+	rv := reflect.ValueOf(actual)
+	if rv.Kind() != reflect.Map {
+		return Errored(fmt.Errorf("%s matcher expects a map.  Got:\n%s", matcher.name(), beformat.Object(actual, 1)))
+	}
+
+	// lines of each key (missing, extra or mismatching), sorted by key at the end
+	type keyLines struct {
+		key   any
+		lines []string
+	}
+	var diff []keyLines
+
+	if matcher.mode != MapModeOnlyKeys {
+		for _, k := range matcher.keys {
+			lines, err := matcher.entryLines(rv, k)
+			if err != nil {
+				return Errored(err)
+			}
+			if len(lines) > 0 {
+				diff = append(diff, keyLines{key: k, lines: lines})
+			}
+		}
+	}
+
+	if matcher.mode != MapModeContaining {
+		for _, k := range rv.MapKeys() {
+			if !matcher.expects(k.Interface()) {
+				diff = append(diff, keyLines{
+					key:   k.Interface(),
+					lines: []string{"+ " + IndexSegment(k.Interface()) + ": " + beformat.Value(rv.MapIndex(k).Interface())},
+				})
+			}
+		}
+	}
+
+	if len(diff) == 0 {
+		return Succeeded(beformat.Message(actual, "not to "+matcher.String()))
+	}
+
+	slices.SortStableFunc(diff, func(a, b keyLines) int { return compareKeys(a.key, b.key) })
+	var lines []string
+	for _, d := range diff {
+		lines = append(lines, d.lines...)
+	}
+	return Failed(beformat.DiffMessage(actual, "to "+matcher.String(), lines))
+}
+
+// entryLines tells how the entry of the given key differs from the expected one:
+// no lines if it matches, a single line if it's missing, the value diff otherwise
+func (matcher *MapMatcher) entryLines(rv reflect.Value, k any) ([]string, error) {
+	expected, segment := matcher.expected[k], IndexSegment(k)
+
+	key, ok := convertKey(k, rv.Type().Key())
+	var v reflect.Value
+	if ok {
+		v = rv.MapIndex(key)
+	}
+	if !v.IsValid() {
+		return []string{"- " + segment + ": " + describeValue(expected)}, nil
+	}
+
+	o := Explain(matcher.matchers[k], v.Interface())
+	switch {
+	case o.Err != nil:
+		return nil, fmt.Errorf("%s: %w", segment, o.Err)
+	case o.Success:
+		return nil, nil
+	}
+
+	if !IsMatcher(expected) {
+		if diff := beformat.FieldDiff(expected, v.Interface(), segment); len(diff) > 0 {
+			return diff, nil
+		}
+	}
+	return []string{"  " + segment + ": " + beformat.Compact(o.Message)}, nil
+}
+
+// expects tells whether the key of actual is one of the expected keys (equal to it or matching it)
+func (matcher *MapMatcher) expects(key any) bool {
+	for _, k := range matcher.keys {
+		if IsMatcher(k) {
+			if Explain(AsMatcher(k), key).Success {
+				return true
+			}
+			continue
+		}
+		if expected, ok := convertKey(k, reflect.TypeOf(key)); ok && reflect.DeepEqual(expected.Interface(), key) {
+			return true
+		}
+	}
+	return false
+}
+
+func (matcher *MapMatcher) name() string {
+	switch matcher.mode {
+	case MapModeExactly:
+		return "MapExactly"
+	case MapModeOnlyKeys:
+		return "HaveOnlyKeys"
+	}
+	return "MapContaining"
+}
+
+// String describes the matcher for gomock, e.g. `contain map entries ["age", "name"]`
+func (matcher *MapMatcher) String() string {
+	keys := make([]any, len(matcher.keys))
+	for i, k := range matcher.keys {
+		keys[i] = describe(k)
+	}
+
+	switch matcher.mode {
+	case MapModeExactly:
+		return "have exactly map entries " + beformat.Value(keys)
+	case MapModeOnlyKeys:
+		return "have only keys " + beformat.Value(keys)
+	}
+	return "contain map entries " + beformat.Value(keys)
+}
+
+func (matcher *MapMatcher) Match(actual any) (bool, error) {
+	o := matcher.Explain(actual)
+	return o.Success, o.Err
+}
+
+func (matcher *MapMatcher) FailureMessage(actual any) string {
+	return matcher.Explain(actual).Message
+}
+
+func (matcher *MapMatcher) NegatedFailureMessage(actual any) string {
+	return matcher.Explain(actual).Message
+}
+
+// Children exposes each of the value matchers applied to the value of its key
+func (matcher *MapMatcher) Children(actual any) []types.Child {
+	rv := reflect.ValueOf(actual)
+	if rv.Kind() != reflect.Map || matcher.mode == MapModeOnlyKeys {
+		return nil
+	}
+
+	children := make([]types.Child, 0, len(matcher.keys))
+	for _, k := range matcher.keys {
+		key, ok := convertKey(k, rv.Type().Key())
+		if !ok || !rv.MapIndex(key).IsValid() {
+			continue
+		}
+		children = append(children, types.Child{Segment: IndexSegment(k), Matcher: matcher.matchers[k], Actual: rv.MapIndex(key).Interface()})
+	}
+	return children
+}
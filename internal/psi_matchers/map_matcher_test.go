@@ -0,0 +1,83 @@
+package psi_matchers_test
+
+import (
+	"testing"
+
+	"github.com/expectto/be"
+	"github.com/expectto/be/be_string"
+	. "github.com/expectto/be/internal/psi_matchers"
+)
+
+func TestMapMatcher(t *testing.T) {
+	type Key string
+
+	t.Run("containing", func(t *testing.T) {
+		for _, tc := range []struct {
+			name     string
+			expected map[any]any
+			actual   any
+			success  bool
+		}{
+			{"subset", map[any]any{"a": 1}, map[string]int{"a": 1, "b": 2}, true},
+			{"matcher value", map[any]any{"a": be.HaveLength(2)}, map[string]any{"a": "xy"}, true},
+			{"mismatching value", map[any]any{"a": 2}, map[string]int{"a": 1}, false},
+			{"missing key", map[any]any{"c": 1}, map[string]int{"a": 1}, false},
+			{"custom key type", map[any]any{"a": 1}, map[Key]int{"a": 1}, true},
+			{"nil map", map[any]any{}, map[string]int(nil), true},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				be.ExpectValues(t)(NewMapMatcher(MapModeContaining, tc.expected).Match(tc.actual)).To(be.Eq(tc.success))
+			})
+		}
+	})
+
+	t.Run("exactly", func(t *testing.T) {
+		for _, tc := range []struct {
+			name     string
+			expected map[any]any
+			actual   any
+			success  bool
+		}{
+			{"same keys", map[any]any{"a": 1, "b": 2}, map[string]int{"a": 1, "b": 2}, true},
+			{"extra key", map[any]any{"a": 1}, map[string]int{"a": 1, "b": 2}, false},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				be.ExpectValues(t)(NewMapMatcher(MapModeExactly, tc.expected).Match(tc.actual)).To(be.Eq(tc.success))
+			})
+		}
+	})
+
+	t.Run("only keys", func(t *testing.T) {
+		for _, tc := range []struct {
+			name    string
+			keys    []any
+			actual  any
+			success bool
+		}{
+			{"some of the keys", []any{"a", "b"}, map[string]int{"a": 1}, true},
+			{"other key", []any{"a"}, map[string]int{"a": 1, "b": 2}, false},
+			{"key matcher", []any{be_string.HavingPrefix("x-")}, map[string]int{"x-a": 1}, true},
+			{"empty map", []any{}, map[string]int{}, true},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				be.ExpectValues(t)(NewHaveOnlyKeysMatcher(tc.keys...).Match(tc.actual)).To(be.Eq(tc.success))
+			})
+		}
+	})
+
+	t.Run("should list all the differences in sorted-key order", func(t *testing.T) {
+		matcher := NewMapMatcher(MapModeExactly, map[any]any{"b": 2, "a": be.HaveLength(3), "d": 4})
+		msg := matcher.FailureMessage(map[string]any{"a": "xy", "c": 3, "d": 5})
+		be.Expect(t, msg).To(be_string.HavingSuffix(`differences (- expected, + actual):
+    ["a"]: Expected xy to have length = 3
+  - ["b"]: 2
+  + ["c"]: 3
+  - ["d"]: 4
+  + ["d"]: 5`))
+	})
+
+	t.Run("should error on anything but a map", func(t *testing.T) {
+		_, err := NewHaveOnlyKeysMatcher("a").Match([]string{"a"})
+		be.Expect(t, err).To(be.MatchError(be.ContainSubstring("HaveOnlyKeys matcher expects a map")))
+	})
+}
@@ -0,0 +1,94 @@
+package psi_matchers
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/expectto/be/internal/beformat"
+	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
+	"github.com/expectto/be/types"
+)
+
+// MatchErrorMatcher matches an error against the expected one, that is either:
+// an error (compared via errors.Is or deep equality), a string (compared with err.Error())
+// or a matcher (applied to err.Error()).
+type MatchErrorMatcher struct {
+	*MixinMatcherGomock
+
+	expected any
+	matching types.BeMatcher
+}
+
+var _ types.BeMatcher = &MatchErrorMatcher{}
+
+func NewMatchErrorMatcher(expected any) *MatchErrorMatcher {
+	matcher := &MatchErrorMatcher{expected: expected}
+	matcher.MixinMatcherGomock = NewMixinMatcherGomock(matcher, "MatchError")
+
+	if IsMatcher(expected) {
+		matcher.matching = AsMatcher(expected)
+	}
+
+	return matcher
+}
+
+func (matcher *MatchErrorMatcher) Explain(actual any) types.Outcome {
+	if actual == nil {
+		return Errored(errors.New("Expected an error, got nil"))
+	}
+	err, ok := actual.(error)
+	if !ok {
+		return Errored(fmt.Errorf("Expected an error.  Got:\n%s", beformat.Object(actual, 1)))
+	}
+
+	var success bool
+	switch expected := matcher.expected.(type) {
+	case error:
+		success = errors.Is(err, expected) || reflect.DeepEqual(err, expected)
+	case string:
+		success = err.Error() == expected
+	default:
+		if matcher.matching == nil {
+			return Errored(fmt.Errorf(
+				"MatchError must be passed an error, a string, or a Matcher that can match on strings.  Got:\n%s",
+				beformat.Object(matcher.expected, 1),
+			))
+		}
+		o := Explain(matcher.matching, err.Error())
+		if o.Err != nil {
+			return o
+		}
+		success = o.Success
+	}
+
+	if success {
+		return Succeeded(beformat.Message(actual, "not to match error", matcher.expectedValue()))
+	}
+	return Failed(beformat.Message(actual, "to match error", matcher.expectedValue()))
+}
+
+// expectedValue is what the failure message shows as expected
+func (matcher *MatchErrorMatcher) expectedValue() any {
+	if matcher.matching != nil {
+		return matcher.matching.String()
+	}
+	return matcher.expected
+}
+
+func (matcher *MatchErrorMatcher) Match(actual any) (bool, error) {
+	o := matcher.Explain(actual)
+	return o.Success, o.Err
+}
+
+func (matcher *MatchErrorMatcher) FailureMessage(actual any) string {
+	return matcher.Explain(actual).Message
+}
+
+func (matcher *MatchErrorMatcher) NegatedFailureMessage(actual any) string {
+	return matcher.Explain(actual).Message
+}
+
+func (matcher *MatchErrorMatcher) String() string {
+	return "match error " + beformat.Value(matcher.expectedValue())
+}
@@ -0,0 +1,67 @@
+package psi_matchers_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/expectto/be"
+	. "github.com/expectto/be/internal/psi_matchers"
+)
+
+func TestMatchErrorMatcher(t *testing.T) {
+	errNotFound := errors.New("not found")
+
+	t.Run("should match a wrapped error via errors.Is", func(t *testing.T) {
+		be.ExpectValues(t)(NewMatchErrorMatcher(errNotFound).Match(fmt.Errorf("user: %w", errNotFound))).To(be.True())
+		be.ExpectValues(t)(NewMatchErrorMatcher(errNotFound).Match(errors.New("gone"))).To(be.False())
+	})
+
+	t.Run("should match the error message against a string or a matcher", func(t *testing.T) {
+		be.ExpectValues(t)(NewMatchErrorMatcher("not found").Match(errNotFound)).To(be.True())
+		be.ExpectValues(t)(NewMatchErrorMatcher(NewContainSubstringMatcher("found")).Match(errNotFound)).To(be.True())
+	})
+
+	t.Run("should error on nil", func(t *testing.T) {
+		_, err := NewMatchErrorMatcher(errNotFound).Match(nil)
+		be.Expect(t, err).To(be.MatchError("Expected an error, got nil"))
+	})
+}
+
+func TestPanicMatcherBasics(t *testing.T) {
+	t.Run("should match panicking funcs only", func(t *testing.T) {
+		be.ExpectValues(t)(NewPanicMatcher().Match(func() { panic("boom") })).To(be.True())
+		be.ExpectValues(t)(NewPanicMatcher().Match(func() {})).To(be.False())
+	})
+
+	t.Run("should error on non-funcs", func(t *testing.T) {
+		_, err := NewPanicMatcher().Match(func(int) {})
+		be.Expect(t, err).To(be.HaveOccurred())
+	})
+}
+
+func TestNumericMatcher(t *testing.T) {
+	t.Run("comparing numbers of any kind", func(t *testing.T) {
+		for _, tc := range []struct {
+			name       string
+			actual     any
+			comparator string
+			compareTo  []any
+			expected   bool
+		}{
+			{"int > int", 6, ">", []any{5}, true},
+			{"uint <= float", uint(5), "<=", []any{4.5}, false},
+			{"~ within threshold", 1.001, "~", []any{1, 0.01}, true},
+			{"~ outside threshold", 1.1, "~", []any{1, 0.01}, false},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				be.ExpectValues(t)(NewNumericMatcher(tc.comparator, tc.compareTo...).Match(tc.actual)).To(be.Eq(tc.expected))
+			})
+		}
+	})
+
+	t.Run("should error on non-numbers", func(t *testing.T) {
+		_, err := NewNumericMatcher(">", 5).Match("6")
+		be.Expect(t, err).To(be.HaveOccurred())
+	})
+}
@@ -2,7 +2,8 @@ package psi_matchers
 
 import "github.com/expectto/be/types"
 
-// NeverMatcher never matches (always fails)
+// NeverMatcher never matches: it fails with the given error,
+// so a negated assertion (e.g. a matcher that can't be built) fails as well
 type NeverMatcher struct {
 	err error
 }
@@ -13,7 +14,7 @@ func NewNeverMatcher(err error) *NeverMatcher {
 	return &NeverMatcher{err: err}
 }
 
-func (m *NeverMatcher) Match(_ any) (bool, error)               { return false, nil }
+func (m *NeverMatcher) Match(_ any) (bool, error)               { return false, m.err }
 func (m *NeverMatcher) FailureMessage(actual any) string        { return m.err.Error() }
 func (m *NeverMatcher) NegatedFailureMessage(actual any) string { return m.err.Error() /* todo */ }
 func (m *NeverMatcher) Matches(actual any) bool                 { return false }
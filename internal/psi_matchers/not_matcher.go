@@ -6,22 +6,34 @@ import (
 )
 
 type NotMatcher struct {
-	Matcher         types.BeMatcher
-	lastActualValue any
+	Matcher types.BeMatcher
 }
 
 var _ types.BeMatcher = &NotMatcher{}
+var _ types.RememberingMatcher = &NotMatcher{}
+var _ types.PollingMatcher = &NotMatcher{}
 
 func NewNotMatcher(m any) *NotMatcher {
 	return &NotMatcher{Matcher: AsMatcher(m)}
 }
 
+// Explain flips the outcome of the given matcher: its explanation reads
+// as the explanation of the negated match as well (works beautifully)
+func (m *NotMatcher) Explain(actual any) types.Outcome {
+	return Negated(Explain(m.Matcher, actual))
+}
+
+// LastOutcome recalls the last evaluation of the negated matcher (if it remembers one)
+func (m *NotMatcher) LastOutcome(actual any) (types.Outcome, bool) {
+	o, ok := LastOutcome(m.Matcher, actual)
+	return Negated(o), ok
+}
+
 func (m *NotMatcher) Match(actual any) (bool, error) {
 	success, err := m.Matcher.Match(actual)
 	if err != nil {
 		return false, err
 	}
-	m.lastActualValue = actual
 	return !success, nil
 }
 
@@ -38,11 +50,18 @@ func (m *NotMatcher) Matches(actual any) bool {
 	return res
 }
 
-// String returns the failure message for the last matched value.
-// Todo: inaccurate behavior should be fixed.
 func (m *NotMatcher) String() string {
-	mes := m.FailureMessage(m.lastActualValue)
-	return mes
+	return "not " + m.Matcher.String()
+}
+
+func (m *NotMatcher) Children(actual any) []types.Child {
+	return ChildrenOf(actual, m.Matcher)
+}
+
+// NewPollSession starts the poll session of the negated matcher if it consumes actual
+// (see AllMatcher.NewPollSession)
+func (m *NotMatcher) NewPollSession() types.BeMatcher {
+	return &NotMatcher{Matcher: PollSession(m.Matcher)}
 }
 
 // todo: MatchMayChangeInTheFuture
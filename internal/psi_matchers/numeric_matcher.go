@@ -0,0 +1,187 @@
+package psi_matchers
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/expectto/be/internal/beformat"
+	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
+	"github.com/expectto/be/types"
+)
+
+// NumericMatcher is a port of gomega's BeNumericallyMatcher: it compares numbers
+// of any numeric type via the given comparator ("==", "~", ">", ">=", "<", "<=").
+// "~" takes an optional threshold (1e-8 for floats, 0 for integers by default).
+type NumericMatcher struct {
+	*MixinMatcherGomock
+
+	Comparator string
+	CompareTo  []any
+}
+
+var _ types.BeMatcher = &NumericMatcher{}
+
+func NewNumericMatcher(comparator string, compareTo ...any) *NumericMatcher {
+	matcher := &NumericMatcher{Comparator: comparator, CompareTo: compareTo}
+	matcher.MixinMatcherGomock = NewMixinMatcherGomock(matcher, "BeNumerically")
+	return matcher
+}
+
+func (matcher *NumericMatcher) Explain(actual any) types.Outcome {
+	success, err := matcher.match(actual)
+	return Outcome(success, err, func() string {
+		if success {
+			return matcher.message(actual, "not to")
+		}
+		return matcher.message(actual, "to")
+	})
+}
+
+func (matcher *NumericMatcher) match(actual any) (bool, error) {
+	if len(matcher.CompareTo) == 0 || len(matcher.CompareTo) > 2 {
+		return false, fmt.Errorf(
+			"BeNumerically requires 1 or 2 CompareTo arguments.  Got:\n%s", beformat.Object(matcher.CompareTo, 1),
+		)
+	}
+	for _, v := range append([]any{actual}, matcher.CompareTo...) {
+		if !isNumber(v) {
+			return false, fmt.Errorf("Expected a number.  Got:\n%s", beformat.Object(v, 1))
+		}
+	}
+	switch matcher.Comparator {
+	case "==", "~", ">", ">=", "<", "<=":
+	default:
+		return false, fmt.Errorf("Unknown comparator: %s", matcher.Comparator)
+	}
+
+	a, b := reflect.ValueOf(actual), reflect.ValueOf(matcher.CompareTo[0])
+	var threshold reflect.Value
+	if len(matcher.CompareTo) == 2 {
+		threshold = reflect.ValueOf(matcher.CompareTo[1])
+	}
+
+	switch {
+	case a.CanFloat() || b.CanFloat():
+		th := 1e-8
+		if threshold.IsValid() {
+			th = toFloat(threshold)
+		}
+		if matcher.Comparator == "~" {
+			return math.Abs(toFloat(a)-toFloat(b)) <= th, nil
+		}
+		return compare(matcher.Comparator, toFloat(a), toFloat(b), 0), nil
+	case a.CanInt():
+		var th int64
+		if threshold.IsValid() {
+			th = toInt(threshold)
+		}
+		return compare(matcher.Comparator, a.Int(), toInt(b), th), nil
+	case a.CanUint():
+		var th uint64
+		if threshold.IsValid() {
+			th = toUint(threshold)
+		}
+		x, y := a.Uint(), toUint(b)
+		if matcher.Comparator == "==" || matcher.Comparator == "~" {
+			return max(x, y)-min(x, y) <= th, nil
+		}
+		return compare(matcher.Comparator, x, y, 0), nil
+	default:
+		return false, fmt.Errorf(
+			"Failed to compare:\n%s\n%s:\n%s",
+			beformat.Object(actual, 1), matcher.Comparator, beformat.Object(matcher.CompareTo[0], 1),
+		)
+	}
+}
+
+// compare applies the comparator, "==" and "~" allow the given difference
+func compare[T int64 | uint64 | float64](comparator string, actual, compareTo, threshold T) bool {
+	switch comparator {
+	case "==", "~":
+		diff := actual - compareTo
+		return -threshold <= diff && diff <= threshold
+	case ">":
+		return actual > compareTo
+	case ">=":
+		return actual >= compareTo
+	case "<":
+		return actual < compareTo
+	case "<=":
+		return actual <= compareTo
+	}
+	return false
+}
+
+func isNumber(v any) bool {
+	rv := reflect.ValueOf(v)
+	return rv.CanInt() || rv.CanUint() || rv.CanFloat()
+}
+
+func toFloat(v reflect.Value) float64 {
+	switch {
+	case v.CanInt():
+		return float64(v.Int())
+	case v.CanUint():
+		return float64(v.Uint())
+	default:
+		return v.Float()
+	}
+}
+
+func toInt(v reflect.Value) int64 {
+	switch {
+	case v.CanInt():
+		return v.Int()
+	case v.CanUint():
+		return int64(v.Uint()) //nolint:gosec // same as gomega: out of range values wrap
+	default:
+		return int64(v.Float())
+	}
+}
+
+func toUint(v reflect.Value) uint64 {
+	switch {
+	case v.CanInt():
+		return uint64(v.Int()) //nolint:gosec // same as gomega: out of range values wrap
+	case v.CanUint():
+		return v.Uint()
+	default:
+		return uint64(v.Float())
+	}
+}
+
+func (matcher *NumericMatcher) message(actual any, to string) string {
+	if len(matcher.CompareTo) == 0 {
+		return beformat.Message(actual, fmt.Sprintf("%s be %s", to, matcher.Comparator))
+	}
+	if len(matcher.CompareTo) == 1 {
+		return beformat.Message(actual, fmt.Sprintf("%s be %s", to, matcher.Comparator), matcher.CompareTo[0])
+	}
+	return beformat.Message(
+		actual, fmt.Sprintf("%s be within %v of %s", to, matcher.CompareTo[1], matcher.Comparator), matcher.CompareTo[0],
+	)
+}
+
+func (matcher *NumericMatcher) Match(actual any) (bool, error) {
+	return matcher.match(actual)
+}
+
+func (matcher *NumericMatcher) FailureMessage(actual any) string {
+	return matcher.message(actual, "to")
+}
+
+func (matcher *NumericMatcher) NegatedFailureMessage(actual any) string {
+	return matcher.message(actual, "not to")
+}
+
+// String describes the matcher for gomock, e.g. "be > 5"
+func (matcher *NumericMatcher) String() string {
+	if len(matcher.CompareTo) == 2 {
+		return fmt.Sprintf("be within %v of %s %v", matcher.CompareTo[1], matcher.Comparator, matcher.CompareTo[0])
+	}
+	if len(matcher.CompareTo) == 1 {
+		return fmt.Sprintf("be %s %v", matcher.Comparator, matcher.CompareTo[0])
+	}
+	return "be " + matcher.Comparator
+}
@@ -0,0 +1,199 @@
+package psi_matchers
+
+import (
+	"fmt"
+	"reflect"
+	"runtime/debug"
+	"strings"
+
+	"github.com/expectto/be/internal/beformat"
+	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
+	"github.com/expectto/be/types"
+)
+
+type PanicMode string
+
+const (
+	PanicModeValue   PanicMode = "value"
+	PanicModeError   PanicMode = "error"
+	PanicModeMessage PanicMode = "message"
+)
+
+// PanicMatcher matches a func() that panics when invoked,
+// optionally with the recovered value matching the given value or matcher:
+// the value itself, the error it carries or its message.
+// A failure shows the recovered value along with the stack of the panic.
+type PanicMatcher struct {
+	*MixinMatcherGomock
+
+	mode     PanicMode
+	expected any
+	matching types.BeMatcher
+}
+
+var _ types.BeMatcher = &PanicMatcher{}
+
+func NewPanicMatcher() *PanicMatcher {
+	matcher := &PanicMatcher{}
+	matcher.MixinMatcherGomock = NewMixinMatcherGomock(matcher, "Panic")
+	return matcher
+}
+
+// NewPanicWithMatcher creates a PanicMatcher matching the recovered value:
+//   - the value itself (value mode),
+//   - the error it must be (error mode): an error target is compared via errors.Is,
+//     a string with err.Error() and a matcher is applied to the error itself
+//     (so be.MatchErrorAs works),
+//   - its message (message mode): the error's message, the string,
+//     or the value formatted with fmt.Sprint.
+func NewPanicWithMatcher(mode PanicMode, expected any) *PanicMatcher {
+	matcher := &PanicMatcher{mode: mode, expected: expected}
+	matcher.MixinMatcherGomock = NewMixinMatcherGomock(matcher, matcher.name())
+
+	if mode == PanicModeError && !IsMatcher(expected) {
+		matcher.matching = NewMatchErrorMatcher(expected)
+	} else {
+		matcher.matching = Psi(expected)
+	}
+	return matcher
+}
+
+func (matcher *PanicMatcher) Explain(actual any) types.Outcome {
+	fn := reflect.ValueOf(actual)
+	if fn.Kind() != reflect.Func || fn.IsNil() || fn.Type().NumIn() != 0 || fn.Type().NumOut() != 0 {
+		return Errored(fmt.Errorf(
+			"%s matcher expects a non-nil function with no arguments and no return values.  Got:\n%s",
+			matcher.name(), beformat.Object(actual, 1),
+		))
+	}
+
+	panicked, recovered, stack := call(fn)
+	if !panicked {
+		return Failed(beformat.Message(actual, "to "+matcher.String()))
+	}
+	if matcher.matching == nil {
+		return Succeeded(beformat.Message(actual, "not to panic, but panicked with", recovered) + stackDetails(stack))
+	}
+
+	var subject any
+	switch matcher.mode {
+	case PanicModeError:
+		err, ok := recovered.(error)
+		if !ok {
+			return Failed(matcher.message(actual, "to", recovered, "which is not an error", stack))
+		}
+		subject = err
+	case PanicModeMessage:
+		subject = panicMessage(recovered)
+	default:
+		subject = recovered
+	}
+
+	o := Explain(matcher.matching, subject)
+	switch {
+	case o.Err != nil:
+		return Errored(fmt.Errorf("panicked with %s: %w", beformat.Value(recovered), o.Err))
+	case o.Success:
+		return Succeeded(matcher.message(actual, "not to", recovered, "", stack))
+	}
+	return Failed(matcher.message(actual, "to", recovered, beformat.Compact(o.Message), stack))
+}
+
+// call invokes the function, reporting whether it panicked, with what and where
+func call(fn reflect.Value) (panicked bool, recovered any, stack []byte) {
+	defer func() {
+		if recovered = recover(); panicked {
+			stack = debug.Stack()
+		}
+	}()
+
+	// stays true only if the call doesn't return
+	panicked = true
+	fn.Call(nil)
+	return false, nil, nil
+}
+
+// panicMessage is the message of the recovered value
+func panicMessage(recovered any) string {
+	switch v := recovered.(type) {
+	case error:
+		return v.Error()
+	case string:
+		return v
+	case fmt.Stringer:
+		return v.String()
+	}
+	return fmt.Sprint(recovered)
+}
+
+// message shows the recovered value, why it doesn't match (if given) and the panic stack
+func (matcher *PanicMatcher) message(actual any, to string, recovered any, details string, stack []byte) string {
+	msg := beformat.Message(actual, to+" "+matcher.String()+", but panicked with", recovered)
+	if details != "" {
+		msg += "\n" + details
+	}
+	return msg + stackDetails(stack)
+}
+
+// stackDetails shows the stack of the panic, starting at the frame that panicked
+func stackDetails(stack []byte) string {
+	s := string(stack)
+	// skip the frames of debug.Stack, the deferred recover and the panic itself
+	if i := strings.Index(s, "\npanic("); i >= 0 {
+		if rest, ok := strings.CutPrefix(s[i+1:], "panic("); ok {
+			// the panic call line and its file:line
+			if _, after, found := strings.Cut(rest, "\n"); found {
+				if _, after, found = strings.Cut(after, "\n"); found {
+					s = after
+				}
+			}
+		}
+	}
+	// and the frames of the matcher invoking the function
+	if i := strings.Index(s, "\nreflect.Value.call("); i >= 0 {
+		s = s[:i]
+	}
+	s = strings.TrimRight(s, "\n")
+	if s == "" {
+		return ""
+	}
+	return "\nPanic stack:\n" + beformat.Indent + strings.ReplaceAll(s, "\n", "\n"+beformat.Indent)
+}
+
+func (matcher *PanicMatcher) name() string {
+	switch matcher.mode {
+	case PanicModeValue:
+		return "PanicWith"
+	case PanicModeError:
+		return "PanicWithError"
+	case PanicModeMessage:
+		return "PanicWithMessage"
+	}
+	return "Panic"
+}
+
+func (matcher *PanicMatcher) Match(actual any) (bool, error) {
+	o := matcher.Explain(actual)
+	return o.Success, o.Err
+}
+
+func (matcher *PanicMatcher) FailureMessage(actual any) string {
+	return matcher.Explain(actual).Message
+}
+
+func (matcher *PanicMatcher) NegatedFailureMessage(actual any) string {
+	return matcher.Explain(actual).Message
+}
+
+// String describes the matcher for gomock, e.g. `panic with a message matching "boom"`
+func (matcher *PanicMatcher) String() string {
+	switch matcher.mode {
+	case PanicModeValue:
+		return "panic with " + beformat.Value(describe(matcher.expected))
+	case PanicModeError:
+		return "panic with an error matching " + beformat.Value(describe(matcher.expected))
+	case PanicModeMessage:
+		return "panic with a message matching " + beformat.Value(describe(matcher.expected))
+	}
+	return "panic"
+}
@@ -0,0 +1,58 @@
+package psi_matchers_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/expectto/be"
+	. "github.com/expectto/be/internal/psi_matchers"
+)
+
+type stringer struct{}
+
+func (stringer) String() string { return "from String()" }
+
+func TestPanicMatcher(t *testing.T) {
+	errTarget := errors.New("target")
+
+	t.Run("matching the recovered value", func(t *testing.T) {
+		for _, tc := range []struct {
+			name     string
+			mode     PanicMode
+			expected any
+			fn       func()
+			success  bool
+		}{
+			{"value", PanicModeValue, 1, func() { panic(1) }, true},
+			{"value: other", PanicModeValue, 1, func() { panic(2) }, false},
+			{"value: matcher", PanicModeValue, be.Gt(1), func() { panic(2) }, true},
+			{"value: no panic", PanicModeValue, 1, func() {}, false},
+			{"error: errors.Is", PanicModeError, errTarget, func() { panic(fmt.Errorf("wrapped: %w", errTarget)) }, true},
+			{"error: message", PanicModeError, "boom", func() { panic(errors.New("boom")) }, true},
+			{"error: matcher on the error", PanicModeError, be.MatchError("boom"), func() { panic(errors.New("boom")) }, true},
+			{"error: not an error", PanicModeError, "boom", func() { panic("boom") }, false},
+			{"message: string", PanicModeMessage, "boom", func() { panic("boom") }, true},
+			{"message: error", PanicModeMessage, "boom", func() { panic(errors.New("boom")) }, true},
+			{"message: stringer", PanicModeMessage, "from String()", func() { panic(stringer{}) }, true},
+			{"message: other value", PanicModeMessage, "42", func() { panic(42) }, true},
+			{"message: matcher", PanicModeMessage, be.ContainSubstring("oo"), func() { panic("boom") }, true},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				be.ExpectValues(t)(NewPanicWithMatcher(tc.mode, tc.expected).Match(tc.fn)).To(be.Eq(tc.success))
+			})
+		}
+	})
+
+	t.Run("should show the recovered value and the panic stack", func(t *testing.T) {
+		msg := NewPanicWithMatcher(PanicModeMessage, "bang").FailureMessage(func() { panic("boom") })
+		be.Expect(t, msg).To(be.ContainSubstring("to panic with a message matching \"bang\", but panicked with\n    <string>: boom"))
+		be.Expect(t, msg).To(be.ContainSubstring("Panic stack:\n    github.com/expectto/be/internal/psi_matchers_test."))
+		be.Expect(t, msg).NotTo(be.ContainSubstring("reflect.Value.call"))
+	})
+
+	t.Run("should error on anything but a func()", func(t *testing.T) {
+		_, err := NewPanicWithMatcher(PanicModeValue, 1).Match(func() int { return 1 })
+		be.Expect(t, err).To(be.MatchError(be.ContainSubstring("PanicWith matcher expects a non-nil function")))
+	})
+}
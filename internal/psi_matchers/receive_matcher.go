@@ -0,0 +1,349 @@
+package psi_matchers
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/expectto/be/internal/beformat"
+	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
+	"github.com/expectto/be/types"
+)
+
+type ReceiveMode string
+
+const (
+	ReceiveModeOne     ReceiveMode = "one"
+	ReceiveModeAll     ReceiveMode = "all"
+	ReceiveModeInOrder ReceiveMode = "in order"
+)
+
+// ReceiveMatcher receives from a channel: a value matching the given element (one),
+// every value until the channel is closed, all of them matching the given element (all),
+// or values matching the given elements one after another (in order).
+// Receiving waits up to the timeout (with no timeout, only a value already sent is received).
+//
+// Receiving consumes the channel: each evaluation receives anew, while FailureMessage
+// explains the last evaluation of the channel. The matcher is meant to be evaluated once
+// (as be.Expect does) or polled by be.Eventually, which keeps what was received between the polls
+// (see NewPollSession).
+type ReceiveMatcher struct {
+	*MixinMatcherGomock
+
+	mode     ReceiveMode
+	timeout  time.Duration
+	elements []any
+	matchers []types.BeMatcher
+
+	// last is the outcome of the last evaluation: receiving consumes the channel
+	last Memo[types.Outcome]
+}
+
+var _ types.BeMatcher = &ReceiveMatcher{}
+var _ types.PollingMatcher = &ReceiveMatcher{}
+var _ types.RememberingMatcher = &ReceiveMatcher{}
+
+// NewReceiveMatcher creates a ReceiveMatcher. Elements are values or matchers:
+// at most one for the one/all modes (none means any value), one per value in order.
+func NewReceiveMatcher(mode ReceiveMode, timeout time.Duration, elements ...any) *ReceiveMatcher {
+	if mode != ReceiveModeInOrder && len(elements) > 1 {
+		panic("Receive expects a single value or matcher")
+	}
+
+	matcher := &ReceiveMatcher{mode: mode, timeout: timeout, elements: elements}
+	matcher.MixinMatcherGomock = NewMixinMatcherGomock(matcher, "Receive")
+	matcher.matchers = make([]types.BeMatcher, len(elements))
+	for i, el := range elements {
+		matcher.matchers[i] = Psi(el)
+	}
+	return matcher
+}
+
+// receiveState is what was received from the channel so far
+type receiveState struct {
+	received []any
+	closed   bool
+
+	// in order: the number of elements matched so far,
+	// and the failure of a value that didn't match its element (it's final)
+	matched int
+	failure *types.Outcome
+}
+
+func (matcher *ReceiveMatcher) Explain(actual any) types.Outcome {
+	return matcher.last.Remember(actual, matcher.explain(actual, &receiveState{}))
+}
+
+// LastOutcome returns the outcome of the last evaluation of actual, so explaining it doesn't receive anew
+func (matcher *ReceiveMatcher) LastOutcome(actual any) (types.Outcome, bool) {
+	return matcher.last.Recall(actual)
+}
+
+func (matcher *ReceiveMatcher) explain(actual any, st *receiveState) types.Outcome {
+	ch, err := receivingChan(actual, matcher.name())
+	if err != nil {
+		return Errored(err)
+	}
+
+	switch matcher.mode {
+	case ReceiveModeAll:
+		return matcher.all(actual, ch, st)
+	case ReceiveModeInOrder:
+		return matcher.inOrder(actual, ch, st)
+	}
+	return matcher.one(actual, ch, st)
+}
+
+func (matcher *ReceiveMatcher) one(actual any, ch reflect.Value, st *receiveState) types.Outcome {
+	v, status := receive(ch, matcher.timeout)
+	switch status {
+	case receivedValue:
+		st.received = append(st.received, v)
+	case receivedClosed:
+		st.closed = true
+		return Failed(matcher.message(actual, "to", ", but the channel is closed"))
+	default:
+		return Failed(matcher.message(actual, "to", ", but nothing was received"))
+	}
+
+	if len(matcher.matchers) == 0 {
+		return Succeeded(matcher.message(actual, "not to", ", but received "+beformat.Value(v)))
+	}
+	o := Explain(matcher.matchers[0], v)
+	switch {
+	case o.Err != nil:
+		return Errored(fmt.Errorf("received %s: %w", beformat.Value(v), o.Err))
+	case o.Success:
+		return Succeeded(matcher.message(actual, "not to", ", but received "+beformat.Value(v)))
+	}
+	return Failed(matcher.message(actual, "to", ", but received a value that doesn't match:\n  "+indentListed(beformat.Compact(o.Message))))
+}
+
+func (matcher *ReceiveMatcher) all(actual any, ch reflect.Value, st *receiveState) types.Outcome {
+	deadline := time.Now().Add(matcher.timeout)
+	for !st.closed {
+		v, status := receive(ch, time.Until(deadline))
+		switch status {
+		case receivedValue:
+			st.received = append(st.received, v)
+		case receivedClosed:
+			st.closed = true
+		default:
+			return Failed(matcher.message(actual, "to", ", but it's still open, received so far: "+beformat.Value(st.received)))
+		}
+	}
+
+	values := typedSlice(ch.Type().Elem(), st.received)
+	if len(matcher.matchers) == 0 {
+		return Succeeded(matcher.message(actual, "not to", ", but received "+beformat.Value(values)))
+	}
+	o := Explain(matcher.matchers[0], values)
+	switch {
+	case o.Err != nil:
+		return Errored(fmt.Errorf("received %s: %w", beformat.Value(values), o.Err))
+	case o.Success:
+		return Succeeded(matcher.message(actual, "not to", ", but received "+beformat.Value(values)))
+	}
+	return Failed(matcher.message(actual, "to", ", but received values that don't match:\n  "+indentListed(beformat.Compact(o.Message))))
+}
+
+func (matcher *ReceiveMatcher) inOrder(actual any, ch reflect.Value, st *receiveState) types.Outcome {
+	if st.failure != nil {
+		return *st.failure
+	}
+
+	deadline := time.Now().Add(matcher.timeout)
+	for st.matched < len(matcher.matchers) {
+		v, status := receive(ch, time.Until(deadline))
+		switch status {
+		case receivedValue:
+			st.received = append(st.received, v)
+		case receivedClosed:
+			st.closed = true
+			return Failed(matcher.message(actual, "to", matcher.progress(st)+", then the channel was closed"))
+		default:
+			return Failed(matcher.message(actual, "to", matcher.progress(st)+", then nothing was received"))
+		}
+
+		o := Explain(matcher.matchers[st.matched], v)
+		if o.Err != nil {
+			return Errored(fmt.Errorf("received %s: %w", beformat.Value(v), o.Err))
+		}
+		if !o.Success {
+			failure := Failed(matcher.message(actual, "to", fmt.Sprintf(
+				"%s, then a value that doesn't match %s:\n  %s",
+				matcher.progress(st), describeValue(matcher.elements[st.matched]),
+				indentListed(beformat.Compact(o.Message)),
+			)))
+			st.failure = &failure
+			return failure
+		}
+		st.matched++
+	}
+	return Succeeded(matcher.message(actual, "not to", ", but received "+beformat.Value(st.received)))
+}
+
+// progress tells how many of the elements were matched (in order)
+func (matcher *ReceiveMatcher) progress(st *receiveState) string {
+	if st.matched == 0 {
+		return ", but no value matched"
+	}
+	return fmt.Sprintf(", but only the first %d of %d matched: %s",
+		st.matched, len(matcher.matchers), beformat.Value(st.received[:st.matched]))
+}
+
+func (matcher *ReceiveMatcher) message(actual any, to, details string) string {
+	return beformat.Message(actual, to+" "+matcher.String()+details)
+}
+
+func (matcher *ReceiveMatcher) name() string {
+	switch matcher.mode {
+	case ReceiveModeAll:
+		return "ReceiveAll"
+	case ReceiveModeInOrder:
+		return "ReceiveInOrder"
+	}
+	return "Receive"
+}
+
+// String describes the matcher for gomock, e.g. `receive a value matching 5 (within 1s)`
+func (matcher *ReceiveMatcher) String() string {
+	var s string
+	switch {
+	case matcher.mode == ReceiveModeInOrder:
+		expected := make([]string, len(matcher.elements))
+		for i, el := range matcher.elements {
+			expected[i] = describeValue(el)
+		}
+		s = "receive values in order [" + strings.Join(expected, ", ") + "]"
+	case matcher.mode == ReceiveModeAll && len(matcher.elements) == 0:
+		s = "receive all values until closed"
+	case matcher.mode == ReceiveModeAll:
+		s = "receive all values until closed, matching " + describeValue(matcher.elements[0])
+	case len(matcher.elements) == 0:
+		s = "receive a value"
+	default:
+		s = "receive a value matching " + describeValue(matcher.elements[0])
+	}
+
+	if matcher.timeout > 0 {
+		s += fmt.Sprintf(" (within %s)", matcher.timeout)
+	}
+	return s
+}
+
+func (matcher *ReceiveMatcher) Match(actual any) (bool, error) {
+	o := matcher.Explain(actual)
+	return o.Success, o.Err
+}
+
+func (matcher *ReceiveMatcher) FailureMessage(actual any) string {
+	return Recall(matcher, actual).Message
+}
+
+func (matcher *ReceiveMatcher) NegatedFailureMessage(actual any) string {
+	return Recall(matcher, actual).Message
+}
+
+// NewPollSession starts a session keeping what was received across the polls
+// of be.Eventually / be.Consistently: e.g. ReceiveAll keeps the values received
+// before the channel got closed, ReceiveInOrder keeps the elements matched so far.
+func (matcher *ReceiveMatcher) NewPollSession() types.BeMatcher {
+	session := &receiveSession{matcher: matcher}
+	session.MixinMatcherGomock = NewMixinMatcherGomock(session, "Receive")
+	return session
+}
+
+// receiveSession is a ReceiveMatcher with its state kept across evaluations.
+// Its failure messages explain the last evaluation rather than receiving anew.
+type receiveSession struct {
+	*MixinMatcherGomock
+
+	matcher *ReceiveMatcher
+
+	mu    sync.Mutex
+	state receiveState
+	last  types.Outcome
+}
+
+func (s *receiveSession) Explain(actual any) types.Outcome {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.last = s.matcher.explain(actual, &s.state)
+	return s.last
+}
+
+func (s *receiveSession) Match(actual any) (bool, error) {
+	o := s.Explain(actual)
+	return o.Success, o.Err
+}
+
+func (s *receiveSession) FailureMessage(any) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.last.Message
+}
+
+func (s *receiveSession) NegatedFailureMessage(any) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.last.Message
+}
+
+func (s *receiveSession) String() string { return s.matcher.String() }
+
+// receivingChan returns actual as a channel to receive from
+func receivingChan(actual any, name string) (reflect.Value, error) {
+	ch := reflect.ValueOf(actual)
+	if ch.Kind() != reflect.Chan || ch.IsNil() {
+		return ch, fmt.Errorf("%s matcher expects a non-nil channel.  Got:\n%s", name, beformat.Object(actual, 1))
+	}
+	if ch.Type().ChanDir() == reflect.SendDir {
+		return ch, fmt.Errorf("%s matcher cannot receive from a send-only channel.  Got:\n%s", name, beformat.Object(actual, 1))
+	}
+	return ch, nil
+}
+
+type receiveStatus int
+
+const (
+	receivedNothing receiveStatus = iota
+	receivedValue
+	receivedClosed
+)
+
+// receive receives a value from the channel, waiting for it no longer than wait
+// (a non-positive wait only receives a value that is already there)
+func receive(ch reflect.Value, wait time.Duration) (any, receiveStatus) {
+	cases := []reflect.SelectCase{{Dir: reflect.SelectRecv, Chan: ch}}
+	if wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(timer.C)})
+	} else {
+		cases = append(cases, reflect.SelectCase{Dir: reflect.SelectDefault})
+	}
+
+	chosen, v, ok := reflect.Select(cases)
+	switch {
+	case chosen != 0:
+		return nil, receivedNothing
+	case !ok:
+		return nil, receivedClosed
+	}
+	return v.Interface(), receivedValue
+}
+
+// typedSlice makes a []T of the values (T is the element type of the channel),
+// so e.g. ReceiveAll(be.Eq([]int{1, 2})) matches a chan int
+func typedSlice(elem reflect.Type, values []any) any {
+	slice := reflect.MakeSlice(reflect.SliceOf(elem), len(values), len(values))
+	for i, v := range values {
+		if v != nil {
+			slice.Index(i).Set(reflect.ValueOf(v))
+		}
+	}
+	return slice.Interface()
+}
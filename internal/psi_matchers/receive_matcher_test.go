@@ -0,0 +1,142 @@
+package psi_matchers_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/expectto/be"
+	. "github.com/expectto/be/internal/psi_matchers"
+)
+
+func TestReceiveMatcher(t *testing.T) {
+	buffered := func(closed bool, values ...int) chan int {
+		ch := make(chan int, len(values))
+		for _, v := range values {
+			ch <- v
+		}
+		if closed {
+			close(ch)
+		}
+		return ch
+	}
+
+	t.Run("one", func(t *testing.T) {
+		t.Run("should receive a value already sent", func(t *testing.T) {
+			be.ExpectValues(t)(NewReceiveMatcher(ReceiveModeOne, 0).Match(buffered(false, 1))).To(be.True())
+			be.ExpectValues(t)(NewReceiveMatcher(ReceiveModeOne, 0).Match(buffered(false))).To(be.False())
+		})
+
+		t.Run("should match the received value", func(t *testing.T) {
+			be.ExpectValues(t)(NewReceiveMatcher(ReceiveModeOne, 0, 1).Match(buffered(false, 1))).To(be.True())
+
+			msg := NewReceiveMatcher(ReceiveModeOne, 0, 2).FailureMessage(buffered(false, 1))
+			be.Expect(t, msg).To(be.ContainSubstring("to receive a value matching 2, but received a value that doesn't match"))
+		})
+
+		t.Run("should describe a nested matcher unquoted", func(t *testing.T) {
+			be.Expect(t, NewReceiveMatcher(ReceiveModeOne, 0, be.Eq(5)).String()).To(be.Eq("receive a value matching to equal 5"))
+			be.Expect(t, NewReceiveMatcher(ReceiveModeInOrder, 0, be.Eq(5), "six").String()).
+				To(be.Eq(`receive values in order [to equal 5, "six"]`))
+		})
+
+		t.Run("should wait up to the timeout", func(t *testing.T) {
+			ch := make(chan int)
+			go func() {
+				time.Sleep(5 * time.Millisecond)
+				ch <- 1
+			}()
+			be.ExpectValues(t)(NewReceiveMatcher(ReceiveModeOne, time.Second, 1).Match(ch)).To(be.True())
+		})
+
+		t.Run("should fail on a closed channel", func(t *testing.T) {
+			msg := NewReceiveMatcher(ReceiveModeOne, 0).FailureMessage(buffered(true))
+			be.Expect(t, msg).To(be.ContainSubstring("but the channel is closed"))
+		})
+	})
+
+	t.Run("all", func(t *testing.T) {
+		t.Run("should drain the channel until it's closed", func(t *testing.T) {
+			be.ExpectValues(t)(NewReceiveMatcher(ReceiveModeAll, 0, []int{1, 2}).Match(buffered(true, 1, 2))).To(be.True())
+			be.ExpectValues(t)(NewReceiveMatcher(ReceiveModeAll, 0, []int{1}).Match(buffered(true, 1, 2))).To(be.False())
+		})
+
+		t.Run("should fail on a channel that's still open", func(t *testing.T) {
+			msg := NewReceiveMatcher(ReceiveModeAll, 0).FailureMessage(buffered(false, 1))
+			be.Expect(t, msg).To(be.ContainSubstring("but it's still open, received so far: [1]"))
+		})
+
+		t.Run("should keep the received values across the polls of a session", func(t *testing.T) {
+			ch := make(chan int, 2)
+			session := NewReceiveMatcher(ReceiveModeAll, 0, []int{1, 2}).NewPollSession()
+
+			ch <- 1
+			be.ExpectValues(t)(session.Match(ch)).To(be.False())
+			ch <- 2
+			close(ch)
+			be.ExpectValues(t)(session.Match(ch)).To(be.True())
+		})
+	})
+
+	t.Run("in order", func(t *testing.T) {
+		t.Run("should match the values one after another", func(t *testing.T) {
+			be.ExpectValues(t)(NewReceiveMatcher(ReceiveModeInOrder, 0, 1, 2).Match(buffered(false, 1, 2))).To(be.True())
+
+			msg := NewReceiveMatcher(ReceiveModeInOrder, 0, 1, 3).FailureMessage(buffered(false, 1, 2))
+			be.Expect(t, msg).To(be.ContainSubstring("but only the first 1 of 2 matched: [1], then a value that doesn't match 3"))
+		})
+
+		t.Run("should keep the matched elements across the polls of a session", func(t *testing.T) {
+			ch := make(chan int, 2)
+			session := NewReceiveMatcher(ReceiveModeInOrder, 0, 1, 2).NewPollSession()
+
+			ch <- 1
+			be.ExpectValues(t)(session.Match(ch)).To(be.False())
+			be.Expect(t, session.FailureMessage(ch)).To(be.ContainSubstring("then nothing was received"))
+			ch <- 2
+			be.ExpectValues(t)(session.Match(ch)).To(be.True())
+		})
+	})
+
+	t.Run("should error on anything but a channel to receive from", func(t *testing.T) {
+		_, err := NewReceiveMatcher(ReceiveModeOne, 0).Match([]int{1})
+		be.Expect(t, err).To(be.MatchError(be.ContainSubstring("Receive matcher expects a non-nil channel")))
+
+		_, err = NewReceiveMatcher(ReceiveModeOne, 0).Match(make(chan<- int))
+		be.Expect(t, err).To(be.MatchError(be.ContainSubstring("cannot receive from a send-only channel")))
+	})
+}
+
+func TestClosedMatcher(t *testing.T) {
+	t.Run("should match a closed channel", func(t *testing.T) {
+		ch := make(chan int, 1)
+		be.ExpectValues(t)(NewClosedMatcher().Match(ch)).To(be.False())
+
+		ch <- 1
+		be.Expect(t, NewClosedMatcher().FailureMessage(ch)).To(be.ContainSubstring("to be closed, but received 1"))
+
+		close(ch)
+		be.ExpectValues(t)(NewClosedMatcher().Match(ch)).To(be.True())
+	})
+}
+
+func TestSentMatcher(t *testing.T) {
+	t.Run("should send the value", func(t *testing.T) {
+		ch := make(chan int, 1)
+		be.ExpectValues(t)(NewSentMatcher(1, 0).Match(ch)).To(be.True())
+		be.Expect(t, <-ch).To(be.Eq(1))
+	})
+
+	t.Run("should fail if nothing receives it", func(t *testing.T) {
+		be.ExpectValues(t)(NewSentMatcher(1, time.Millisecond).Match(make(chan int))).To(be.False())
+	})
+
+	t.Run("should error on a value of another type and on a closed channel", func(t *testing.T) {
+		_, err := NewSentMatcher("a", 0).Match(make(chan int, 1))
+		be.Expect(t, err).To(be.MatchError(be.ContainSubstring("cannot send")))
+
+		ch := make(chan int, 1)
+		close(ch)
+		_, err = NewSentMatcher(1, 0).Match(ch)
+		be.Expect(t, err).To(be.MatchError("BeSent cannot send to a closed channel"))
+	})
+}
@@ -3,9 +3,8 @@ package psi_matchers
 import (
 	"fmt"
 	"net/http"
-	"strings"
 
-	"github.com/onsi/gomega/format"
+	"github.com/expectto/be/internal/beformat"
 
 	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
 	"github.com/expectto/be/types"
@@ -39,47 +38,61 @@ func NewReqPropertyMatcher(publicName, fieldName string, cb func(r *http.Request
 	return Psi(matcher)
 }
 
-func (matcher *ReqPropertyMatcher) Match(actual any) (bool, error) {
+func (matcher *ReqPropertyMatcher) Explain(actual any) types.Outcome {
 	if actual == nil {
-		return false, fmt.Errorf("%s() expects actual value not to be nil", matcher.publicName)
+		return Errored(fmt.Errorf("%s() expects actual value not to be nil", matcher.publicName))
 	}
 
 	actualReq, ok := actual.(*http.Request)
 	if !ok {
-		return false, fmt.Errorf(
+		return Errored(fmt.Errorf(
 			"%s() expects actual value mast be a <*http.Request> received <%T>",
 			matcher.publicName,
 			actual,
-		)
+		))
 	}
 
 	if matcher.cb == nil {
-		// we're just matching a valid URL
-		return true, nil
+		// we're just matching a valid request
+		return Succeeded(beformat.Message(actual, "not to be a valid *http.Request"))
 	}
 
 	v := matcher.cb(actualReq)
 
 	// If no inner matchers were given, then we simply validated if {field value} is not empty
 	if matcher.matching == nil {
-		return v != "" && v != nil && v != 0, nil
+		return AtPath(matcher.property, nonEmpty(v, matcher.property))
 	}
 
 	// simply allow underlying matchers to do their job
-	return matcher.matching.Match(v)
+	return AtPath(matcher.property, Explain(matcher.matching, v))
+}
+
+func (matcher *ReqPropertyMatcher) Match(actual any) (bool, error) {
+	o := matcher.Explain(actual)
+	return o.Success, o.Err
 }
 
 func (matcher *ReqPropertyMatcher) FailureMessage(actual any) string {
-	req, _ := actual.(*http.Request) // FailureMessage is only reached after Match saw a *http.Request
-	v := matcher.cb(req)
+	return matcher.Explain(actual).Message
+}
 
-	if matcher.matching == nil {
-		return format.Message(v, "to be a non-empty "+matcher.property)
+func (matcher *ReqPropertyMatcher) NegatedFailureMessage(actual any) string {
+	return matcher.Explain(actual).Message
+}
+
+// nonEmpty explains whether the property value v is non-empty
+func nonEmpty(v any, property string) types.Outcome {
+	if v != "" && v != nil && v != 0 {
+		return Succeeded(beformat.Message(v, "not to be a non-empty "+property))
 	}
-	return matcher.matching.FailureMessage(v)
+	return Failed(beformat.Message(v, "to be a non-empty "+property))
 }
 
-func (matcher *ReqPropertyMatcher) NegatedFailureMessage(actual any) string {
-	// todo: not so accurate
-	return strings.Replace(matcher.FailureMessage(actual), "\nto ", "\nnot to ", 1)
+func (matcher *ReqPropertyMatcher) Children(actual any) []types.Child {
+	req, ok := actual.(*http.Request)
+	if !ok || matcher.cb == nil || matcher.matching == nil {
+		return nil
+	}
+	return []types.Child{{Segment: matcher.property, Matcher: matcher.matching, Actual: matcher.cb(req)}}
 }
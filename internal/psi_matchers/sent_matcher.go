@@ -0,0 +1,114 @@
+package psi_matchers
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/expectto/be/internal/beformat"
+	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
+	"github.com/expectto/be/types"
+)
+
+// SentMatcher matches a channel the given value can be sent to,
+// waiting up to the timeout (with no timeout, the channel must accept it right away).
+// The value is sent (it's not a dry run), a closed channel is an error.
+type SentMatcher struct {
+	*MixinMatcherGomock
+
+	value   any
+	timeout time.Duration
+
+	// last is the outcome of the last evaluation: the value is really sent
+	last Memo[types.Outcome]
+}
+
+var _ types.BeMatcher = &SentMatcher{}
+var _ types.RememberingMatcher = &SentMatcher{}
+
+func NewSentMatcher(value any, timeout time.Duration) *SentMatcher {
+	matcher := &SentMatcher{value: value, timeout: timeout}
+	matcher.MixinMatcherGomock = NewMixinMatcherGomock(matcher, "BeSent")
+	return matcher
+}
+
+func (matcher *SentMatcher) Explain(actual any) types.Outcome {
+	return matcher.last.Remember(actual, matcher.explain(actual))
+}
+
+// LastOutcome returns the outcome of the last evaluation of actual, so explaining it doesn't send again
+func (matcher *SentMatcher) LastOutcome(actual any) (types.Outcome, bool) {
+	return matcher.last.Recall(actual)
+}
+
+func (matcher *SentMatcher) explain(actual any) types.Outcome {
+	ch := reflect.ValueOf(actual)
+	if ch.Kind() != reflect.Chan || ch.IsNil() {
+		return Errored(fmt.Errorf("BeSent matcher expects a non-nil channel.  Got:\n%s", beformat.Object(actual, 1)))
+	}
+	if ch.Type().ChanDir() == reflect.RecvDir {
+		return Errored(fmt.Errorf("BeSent matcher cannot send to a receive-only channel.  Got:\n%s", beformat.Object(actual, 1)))
+	}
+
+	v := reflect.ValueOf(matcher.value)
+	elem := ch.Type().Elem()
+	switch {
+	case !v.IsValid() && isNillable(elem):
+		v = reflect.Zero(elem)
+	case !v.IsValid() || !v.Type().AssignableTo(elem):
+		return Errored(fmt.Errorf("BeSent cannot send %s to a channel of %s", beformat.Value(matcher.value), elem))
+	}
+
+	sent, err := send(ch, v, matcher.timeout)
+	switch {
+	case err != nil:
+		return Errored(err)
+	case sent:
+		return Succeeded(beformat.Message(actual, "not to "+matcher.String()+", but it was"))
+	}
+	return Failed(beformat.Message(actual, "to "+matcher.String()+", but nothing received it"))
+}
+
+// send sends the value to the channel, waiting no longer than wait
+func send(ch, v reflect.Value, wait time.Duration) (sent bool, err error) {
+	defer func() {
+		// sending to a closed channel panics
+		if r := recover(); r != nil {
+			sent, err = false, fmt.Errorf("BeSent cannot send to a closed channel")
+		}
+	}()
+
+	cases := []reflect.SelectCase{{Dir: reflect.SelectSend, Chan: ch, Send: v}}
+	if wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(timer.C)})
+	} else {
+		cases = append(cases, reflect.SelectCase{Dir: reflect.SelectDefault})
+	}
+
+	chosen, _, _ := reflect.Select(cases)
+	return chosen == 0, nil
+}
+
+func (matcher *SentMatcher) Match(actual any) (bool, error) {
+	o := matcher.Explain(actual)
+	return o.Success, o.Err
+}
+
+func (matcher *SentMatcher) FailureMessage(actual any) string {
+	return Recall(matcher, actual).Message
+}
+
+func (matcher *SentMatcher) NegatedFailureMessage(actual any) string {
+	return Recall(matcher, actual).Message
+}
+
+// String describes the matcher for gomock, e.g. `have 5 sent to it (within 1s)`
+func (matcher *SentMatcher) String() string {
+	s := "have " + beformat.Value(matcher.value) + " sent to it"
+	if matcher.timeout > 0 {
+		s += fmt.Sprintf(" (within %s)", matcher.timeout)
+	}
+	return s
+}
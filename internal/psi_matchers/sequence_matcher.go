@@ -0,0 +1,217 @@
+package psi_matchers
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/expectto/be/internal/beformat"
+	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
+	"github.com/expectto/be/types"
+)
+
+type SequenceMode string
+
+const (
+	SequenceModeExact       SequenceMode = "exact"
+	SequenceModePrefix      SequenceMode = "prefix"
+	SequenceModeSuffix      SequenceMode = "suffix"
+	SequenceModeSubsequence SequenceMode = "subsequence"
+)
+
+// SequenceMatcher matches the items of a slice, array or iter.Seq in order, each item
+// against its own value or matcher: all of them (exact), the leading ones (prefix),
+// the trailing ones (suffix) or some of them, in order (subsequence).
+type SequenceMatcher struct {
+	*MixinMatcherGomock
+
+	elements []any
+	matchers []types.BeMatcher
+	mode     SequenceMode
+}
+
+var _ types.BeMatcher = &SequenceMatcher{}
+
+// NewSequenceMatcher creates a SequenceMatcher.
+// A single slice or array is taken as the list of elements.
+func NewSequenceMatcher(mode SequenceMode, elements ...any) *SequenceMatcher {
+	elements = flattenElements(elements)
+	matcher := &SequenceMatcher{elements: elements, matchers: make([]types.BeMatcher, len(elements)), mode: mode}
+	matcher.MixinMatcherGomock = NewMixinMatcherGomock(matcher, "Sequence")
+
+	for i, el := range elements {
+		matcher.matchers[i] = Psi(el)
+	}
+
+	return matcher
+}
+
+// sequenceItems returns the items of a slice, array or iterator (the values of an iter.Seq2):
+// a map has no order to match. shown is what a failure shows as actual (an iterator is
+// shown as the values or entries it yielded, the func itself tells nothing).
+func sequenceItems(actual any, name string) (items []any, shown any, err error) {
+	if entries, ok, err := SeqEntries(actual); ok {
+		items = make([]any, len(entries))
+		for i, e := range entries {
+			items[i] = e.Value
+		}
+		return items, entries, err
+	}
+	shown, err = Collect(actual)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rv := reflect.ValueOf(shown)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, nil, fmt.Errorf("%s matcher expects an array/slice/iterator.  Got:\n%s", name, beformat.Object(actual, 1))
+	}
+	items = make([]any, rv.Len())
+	for i := range items {
+		items[i] = rv.Index(i).Interface()
+	}
+	return items, shown, nil
+}
+
+func (matcher *SequenceMatcher) Explain(actual any) types.Outcome {
+	items, shown, err := sequenceItems(actual, matcher.name())
+	if err != nil {
+		return Errored(err)
+	}
+
+	var problems []string
+	if matcher.mode == SequenceModeSubsequence {
+		problems = matcher.subsequence(items)
+	} else {
+		problems = matcher.positions(items)
+	}
+
+	if len(problems) == 0 {
+		return Succeeded(beformat.Message(shown, "not to "+matcher.String()))
+	}
+	return Failed(beformat.Message(shown, "to "+matcher.String()+":\n  "+strings.Join(problems, "\n  ")))
+}
+
+// positions matches each element against the item at its position,
+// listing mismatched, missing and (for an exact match) extra positions
+func (matcher *SequenceMatcher) positions(items []any) []string {
+	offset := 0
+	if matcher.mode == SequenceModeSuffix {
+		offset = len(items) - len(matcher.matchers)
+	}
+
+	var problems []string
+	for i, m := range matcher.matchers {
+		pos := offset + i
+		switch {
+		case pos < 0:
+			problems = append(problems, fmt.Sprintf("missing element %s", beformat.Value(describe(matcher.elements[i]))))
+		case pos >= len(items):
+			problems = append(problems, fmt.Sprintf("%s: missing element %s", IndexSegment(pos), beformat.Value(describe(matcher.elements[i]))))
+		default:
+			if o := Explain(m, items[pos]); !o.Success {
+				problems = append(problems, IndexSegment(pos)+": "+indentListed(beformat.Compact(o.Message)))
+			}
+		}
+	}
+
+	if matcher.mode == SequenceModeExact {
+		for pos := len(matcher.matchers); pos < len(items); pos++ {
+			problems = append(problems, fmt.Sprintf("%s: extra element %s", IndexSegment(pos), beformat.Value(items[pos])))
+		}
+	}
+	return problems
+}
+
+// subsequence matches the elements in order, each one against the earliest
+// item after the previous match (which is optimal: an earlier match never hurts).
+// The listing shows where the matched elements were found and the first one that wasn't.
+func (matcher *SequenceMatcher) subsequence(items []any) []string {
+	var found []string
+	next := 0
+	for i, m := range matcher.matchers {
+		pos := next
+		for pos < len(items) && !Explain(m, items[pos]).Success {
+			pos++
+		}
+		el := beformat.Value(describe(matcher.elements[i]))
+		if pos == len(items) {
+			if next == 0 {
+				return append(found, el+" not found")
+			}
+			return append(found, fmt.Sprintf("%s not found after %s", el, IndexSegment(next-1)))
+		}
+		found = append(found, fmt.Sprintf("%s found at %s", el, IndexSegment(pos)))
+		next = pos + 1
+	}
+	return nil
+}
+
+// indentListed indents the continuation lines of a multi-line listing item
+func indentListed(s string) string {
+	return strings.ReplaceAll(s, "\n", "\n    ")
+}
+
+func (matcher *SequenceMatcher) name() string {
+	switch matcher.mode {
+	case SequenceModePrefix:
+		return "StartWith"
+	case SequenceModeSuffix:
+		return "EndWith"
+	case SequenceModeSubsequence:
+		return "HaveSubsequence"
+	}
+	return "HaveExactElements"
+}
+
+// String describes the matcher for gomock, e.g. `start with [1, 2]`
+func (matcher *SequenceMatcher) String() string {
+	expected := make([]any, len(matcher.elements))
+	for i, el := range matcher.elements {
+		expected[i] = describe(el)
+	}
+
+	switch matcher.mode {
+	case SequenceModePrefix:
+		return "start with " + beformat.Value(expected)
+	case SequenceModeSuffix:
+		return "end with " + beformat.Value(expected)
+	case SequenceModeSubsequence:
+		return "have subsequence " + beformat.Value(expected)
+	}
+	return "have exact elements " + beformat.Value(expected)
+}
+
+func (matcher *SequenceMatcher) Match(actual any) (bool, error) {
+	o := matcher.Explain(actual)
+	return o.Success, o.Err
+}
+
+func (matcher *SequenceMatcher) FailureMessage(actual any) string {
+	return matcher.Explain(actual).Message
+}
+
+func (matcher *SequenceMatcher) NegatedFailureMessage(actual any) string {
+	return matcher.Explain(actual).Message
+}
+
+// Children exposes the matchers applied to the items at their positions
+// (subsequence matchers have no fixed position, so they have no children)
+func (matcher *SequenceMatcher) Children(actual any) []types.Child {
+	items, _, err := sequenceItems(actual, matcher.name())
+	if err != nil || matcher.mode == SequenceModeSubsequence {
+		return nil
+	}
+	offset := 0
+	if matcher.mode == SequenceModeSuffix {
+		offset = len(items) - len(matcher.matchers)
+	}
+
+	var children []types.Child
+	for i, m := range matcher.matchers {
+		if pos := offset + i; pos >= 0 && pos < len(items) {
+			children = append(children, types.Child{Segment: IndexSegment(pos), Matcher: m, Actual: items[pos]})
+		}
+	}
+	return children
+}
@@ -0,0 +1,56 @@
+package psi_matchers_test
+
+import (
+	"testing"
+
+	"github.com/expectto/be"
+	. "github.com/expectto/be/internal/psi_matchers"
+)
+
+func TestSequenceMatcher(t *testing.T) {
+	t.Run("matching in order", func(t *testing.T) {
+		for _, tc := range []struct {
+			name     string
+			mode     SequenceMode
+			actual   any
+			elements []any
+			expected bool
+		}{
+			{"exact", SequenceModeExact, []int{1, 2}, []any{1, 2}, true},
+			{"exact: wrong order", SequenceModeExact, []int{2, 1}, []any{1, 2}, false},
+			{"exact: extra item", SequenceModeExact, []int{1, 2, 3}, []any{1, 2}, false},
+			{"exact: a single slice is the list of elements", SequenceModeExact, []int{1, 2}, []any{[]int{1, 2}}, true},
+			{"exact: matchers", SequenceModeExact, []int{1, 7}, []any{1, be.Gt(5)}, true},
+			{"prefix", SequenceModePrefix, []int{1, 2, 3}, []any{1, 2}, true},
+			{"prefix: too short", SequenceModePrefix, []int{1}, []any{1, 2}, false},
+			{"suffix", SequenceModeSuffix, []int{1, 2, 3}, []any{2, 3}, true},
+			{"suffix: too short", SequenceModeSuffix, []int{3}, []any{2, 3}, false},
+			{"subsequence", SequenceModeSubsequence, []int{1, 5, 2, 6, 3}, []any{1, 2, 3}, true},
+			{"subsequence: wrong order", SequenceModeSubsequence, []int{3, 2, 1}, []any{1, 2, 3}, false},
+			{"subsequence: empty", SequenceModeSubsequence, []int{}, []any{}, true},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				be.ExpectValues(t)(NewSequenceMatcher(tc.mode, tc.elements...).Match(tc.actual)).To(be.Eq(tc.expected))
+			})
+		}
+	})
+
+	t.Run("should list mismatched, missing and extra positions", func(t *testing.T) {
+		msg := NewSequenceMatcher(SequenceModeExact, 1, 2, 3).FailureMessage([]int{1, 5})
+		be.Expect(t, msg).To(be.ContainSubstring("[1]: Expected 5 to equal 2"))
+		be.Expect(t, msg).To(be.ContainSubstring("[2]: missing element 3"))
+
+		msg = NewSequenceMatcher(SequenceModeExact, 1).FailureMessage([]int{1, 5})
+		be.Expect(t, msg).To(be.ContainSubstring("[1]: extra element 5"))
+	})
+
+	t.Run("should show how far a subsequence was found", func(t *testing.T) {
+		msg := NewSequenceMatcher(SequenceModeSubsequence, 1, 3, 5).FailureMessage([]int{1, 5, 3})
+		be.Expect(t, msg).To(be.ContainSubstring("1 found at [0]\n  3 found at [2]\n  5 not found after [2]"))
+	})
+
+	t.Run("should error on maps", func(t *testing.T) {
+		_, err := NewSequenceMatcher(SequenceModeExact, 1).Match(map[string]int{"a": 1})
+		be.Expect(t, err).To(be.HaveOccurred())
+	})
+}
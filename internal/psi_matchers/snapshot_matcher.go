@@ -0,0 +1,307 @@
+package psi_matchers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/amberpixels/k1/cast"
+
+	"github.com/expectto/be/internal/beformat"
+	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
+	"github.com/expectto/be/types"
+)
+
+// UpdateSnapshotsEnv is the env variable that switches snapshot matchers into update mode:
+// instead of comparing, snapshots are (re)written from the actual values.
+// There's deliberately no test flag for it: `go test ./... -be.update-snapshots` would fail
+// in every package that doesn't import be, as test binaries reject unknown flags.
+const UpdateSnapshotsEnv = "BE_UPDATE_SNAPSHOTS"
+
+// DefaultSnapshotDir is where snapshots are stored, relative to the package under test
+const DefaultSnapshotDir = "testdata/__snapshots__"
+
+// SnapshotMask replaces every value matching Matcher by Placeholder before
+// the actual value is compared to (or stored as) a snapshot
+type SnapshotMask struct {
+	Placeholder string
+	Matcher     types.BeMatcher
+}
+
+// SnapshotMatcher matches the rendered actual value against a golden file
+type SnapshotMatcher struct {
+	*MixinMatcherGomock
+
+	Name      string
+	Dir       string
+	Masks     []SnapshotMask
+	Normalize func(string) string
+
+	// last remembers the last evaluation: a reader can't be read twice to explain it
+	last Memo[types.Outcome]
+}
+
+var _ types.BeMatcher = &SnapshotMatcher{}
+var _ types.RememberingMatcher = &SnapshotMatcher{}
+
+func NewSnapshotMatcher(name string) *SnapshotMatcher {
+	matcher := &SnapshotMatcher{Name: name, Dir: DefaultSnapshotDir}
+	matcher.MixinMatcherGomock = NewMixinMatcherGomock(matcher, "MatchSnapshot")
+	return matcher
+}
+
+var unsafeFileChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// Path returns the file the snapshot is stored in
+func (matcher *SnapshotMatcher) Path() string {
+	return filepath.Join(matcher.Dir, unsafeFileChars.ReplaceAllString(matcher.Name, "_")+".snap")
+}
+
+func updateSnapshots() bool {
+	v := os.Getenv(UpdateSnapshotsEnv)
+	return v != "" && v != "0" && v != "false"
+}
+
+func (matcher *SnapshotMatcher) Explain(actual any) types.Outcome {
+	return matcher.last.Remember(actual, matcher.explain(actual))
+}
+
+func (matcher *SnapshotMatcher) explain(actual any) types.Outcome {
+	rendered, err := matcher.render(actual)
+	if err != nil {
+		return Errored(err)
+	}
+
+	if updateSnapshots() {
+		if err := os.MkdirAll(matcher.Dir, 0o755); err != nil {
+			return Errored(fmt.Errorf("MatchSnapshot: %w", err))
+		}
+		if err := os.WriteFile(matcher.Path(), []byte(rendered+"\n"), 0o644); err != nil {
+			return Errored(fmt.Errorf("MatchSnapshot: %w", err))
+		}
+		return Succeeded(matcher.negatedFailureMessage())
+	}
+
+	snapshot, err := matcher.read()
+	if err != nil {
+		return Errored(err)
+	}
+	if snapshot == rendered {
+		return Succeeded(matcher.negatedFailureMessage())
+	}
+	return Failed(matcher.failureMessage(snapshot, rendered))
+}
+
+// LastOutcome returns the outcome of the last evaluation of actual
+func (matcher *SnapshotMatcher) LastOutcome(actual any) (types.Outcome, bool) {
+	return matcher.last.Recall(actual)
+}
+
+func (matcher *SnapshotMatcher) Match(actual any) (bool, error) {
+	o := matcher.Explain(actual)
+	return o.Success, o.Err
+}
+
+func (matcher *SnapshotMatcher) read() (string, error) {
+	contents, err := os.ReadFile(matcher.Path())
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", fmt.Errorf(
+			"snapshot %q does not exist (%s): run with %s=1 to create it",
+			matcher.Name, matcher.Path(), UpdateSnapshotsEnv,
+		)
+	}
+	if err != nil {
+		return "", fmt.Errorf("MatchSnapshot: %w", err)
+	}
+	return strings.TrimSuffix(string(contents), "\n"), nil
+}
+
+func (matcher *SnapshotMatcher) FailureMessage(actual any) string {
+	return Recall(matcher, actual).Message
+}
+
+func (matcher *SnapshotMatcher) NegatedFailureMessage(actual any) string {
+	return matcher.negatedFailureMessage()
+}
+
+func (matcher *SnapshotMatcher) failureMessage(snapshot, rendered string) string {
+	// short one-liners read best in the usual compact form
+	if !strings.Contains(snapshot+rendered, "\n") {
+		msg := fmt.Sprintf("Expected\n%s\nto match snapshot %q:\n%s", rendered, matcher.Name, snapshot)
+		if compact := beformat.Compact(msg); !strings.Contains(compact, "\n") {
+			return compact
+		}
+	}
+
+	return fmt.Sprintf(
+		"Expected value to match snapshot %q (%s):\n%s\n(run with %s=1 to update the snapshot)",
+		matcher.Name, matcher.Path(), beformat.UnifiedDiff("snapshot", "actual", snapshot, rendered), UpdateSnapshotsEnv,
+	)
+}
+
+func (matcher *SnapshotMatcher) negatedFailureMessage() string {
+	return fmt.Sprintf("Expected value not to match snapshot %q (%s)", matcher.Name, matcher.Path())
+}
+
+// render turns actual into the snapshot text:
+//   - *http.Request: request line, sorted headers, and the body (JSON bodies are pretty-printed)
+//   - io.Reader (e.g. a response body): its contents, as a []byte
+//   - string / []byte: as is, JSON documents are pretty-printed
+//   - anything else: indented JSON
+//
+// Masks are applied to JSON leaves (or to whitespace-separated words of plain text)
+// and then the Normalize hook (if any) is applied to the whole text.
+func (matcher *SnapshotMatcher) render(actual any) (string, error) {
+	var rendered string
+	switch v := actual.(type) {
+	case *http.Request:
+		var err error
+		if rendered, err = matcher.renderRequest(v); err != nil {
+			return "", err
+		}
+	case io.Reader:
+		contents, err := readAll(v)
+		if err != nil {
+			return "", fmt.Errorf("MatchSnapshot: reading %T: %w", v, err)
+		}
+		rendered = matcher.renderText(contents)
+	case []byte:
+		rendered = matcher.renderText(v)
+	default:
+		if cast.IsStringish(actual) {
+			rendered = matcher.renderText(cast.AsBytes(actual))
+			break
+		}
+
+		contents, err := json.Marshal(actual)
+		if err != nil {
+			return "", fmt.Errorf("MatchSnapshot expects a string, []byte, io.Reader, *http.Request or a JSON-able value: %w", err)
+		}
+		rendered = matcher.renderText(contents)
+	}
+
+	if matcher.Normalize != nil {
+		rendered = matcher.Normalize(rendered)
+	}
+	return rendered, nil
+}
+
+// readAll reads the reader through. A seekable reader is rewound afterward, so it can be read again.
+func readAll(reader io.Reader) ([]byte, error) {
+	seeker, ok := reader.(io.Seeker)
+	if !ok {
+		return io.ReadAll(reader)
+	}
+	start, err := seeker.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return io.ReadAll(reader)
+	}
+	defer func() { _, _ = seeker.Seek(start, io.SeekStart) }()
+	return io.ReadAll(reader)
+}
+
+func (matcher *SnapshotMatcher) renderRequest(req *http.Request) (string, error) {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s %s %s", req.Method, req.URL, req.Proto)
+
+	headers := &bytes.Buffer{}
+	if err := req.Header.Write(headers); err != nil { // writes headers in sorted order
+		return "", fmt.Errorf("MatchSnapshot: %w", err)
+	}
+	if h := strings.TrimSpace(strings.ReplaceAll(headers.String(), "\r\n", "\n")); h != "" {
+		sb.WriteString("\n" + matcher.maskWords(h))
+	}
+
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return "", fmt.Errorf("MatchSnapshot: reading request body: %w", err)
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body)) // keep the body readable after matching
+		if len(body) > 0 {
+			sb.WriteString("\n\n" + matcher.renderText(body))
+		}
+	}
+	return sb.String(), nil
+}
+
+// renderText pretty-prints (and masks) JSON documents, and masks words of any other text
+func (matcher *SnapshotMatcher) renderText(text []byte) string {
+	trimmed := bytes.TrimSpace(text)
+	if len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[') && json.Valid(trimmed) {
+		// json.Number keeps numbers as they are written: big integers must not be rounded via float64
+		dec := json.NewDecoder(bytes.NewReader(trimmed))
+		dec.UseNumber()
+		var data any
+		if err := dec.Decode(&data); err == nil {
+			// not json.MarshalIndent: placeholders like "<id>" must stay readable, not HTML-escaped
+			pretty := &bytes.Buffer{}
+			enc := json.NewEncoder(pretty)
+			enc.SetEscapeHTML(false)
+			enc.SetIndent("", "  ")
+			if err := enc.Encode(matcher.maskJSON(data)); err == nil {
+				return strings.TrimSuffix(pretty.String(), "\n")
+			}
+		}
+	}
+	return matcher.maskWords(string(text))
+}
+
+func (matcher *SnapshotMatcher) maskJSON(data any) any {
+	switch v := data.(type) {
+	case map[string]any:
+		for k, item := range v {
+			v[k] = matcher.maskJSON(item)
+		}
+		return v
+	case []any:
+		for i, item := range v {
+			v[i] = matcher.maskJSON(item)
+		}
+		return v
+	case json.Number:
+		// masks see numbers as float64, the way JSON numbers are usually decoded
+		if f, err := v.Float64(); err == nil {
+			if placeholder, ok := matcher.mask(f); ok {
+				return placeholder
+			}
+		}
+		return v
+	default:
+		if placeholder, ok := matcher.mask(v); ok {
+			return placeholder
+		}
+		return v
+	}
+}
+
+var words = regexp.MustCompile(`\S+`)
+
+func (matcher *SnapshotMatcher) maskWords(text string) string {
+	if len(matcher.Masks) == 0 {
+		return text
+	}
+	return words.ReplaceAllStringFunc(text, func(word string) string {
+		if placeholder, ok := matcher.mask(word); ok {
+			return placeholder
+		}
+		return word
+	})
+}
+
+func (matcher *SnapshotMatcher) mask(v any) (string, bool) {
+	for _, m := range matcher.Masks {
+		if ok, err := m.Matcher.Match(v); ok && err == nil {
+			return m.Placeholder, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,151 @@
+package psi_matchers
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+
+	"github.com/expectto/be/internal/beformat"
+	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
+	"github.com/expectto/be/options"
+	"github.com/expectto/be/types"
+)
+
+// SortedMatcher succeeds if the items of a slice, array or iter.Seq are sorted
+// (not strictly: equal neighbours are fine). Items are compared naturally,
+// by a field of theirs or by a given comparison function.
+// The failure points at the first pair of items that is out of order.
+type SortedMatcher struct {
+	*MixinMatcherGomock
+
+	name    string
+	order   options.SortOrder
+	field   string
+	compare func(a, b any) (int, error)
+}
+
+var _ types.BeMatcher = &SortedMatcher{}
+
+// NewSortedMatcher creates a SortedMatcher comparing items naturally:
+// numbers and strings by their value, other types by their Compare method (e.g. time.Time)
+func NewSortedMatcher(order options.SortOrder) *SortedMatcher {
+	matcher := &SortedMatcher{name: "Sorted", order: order, compare: compareNatural}
+	matcher.MixinMatcherGomock = NewMixinMatcherGomock(matcher, matcher.name)
+	return matcher
+}
+
+// NewSortedByMatcher creates a SortedMatcher comparing items by the given function
+// (negative when a < b, zero when a == b, positive when a > b)
+func NewSortedByMatcher(compare func(a, b any) (int, error)) *SortedMatcher {
+	if compare == nil {
+		panic("SortedBy expects a non-nil comparison function")
+	}
+	matcher := &SortedMatcher{name: "SortedBy", order: options.Asc, compare: compare}
+	matcher.MixinMatcherGomock = NewMixinMatcherGomock(matcher, matcher.name)
+	return matcher
+}
+
+// NewSortedByFieldMatcher creates a SortedMatcher comparing items naturally by the given field
+// (the field spec is the one of HaveField: a name, a "Method()" or a dotted path)
+func NewSortedByFieldMatcher(field string, order options.SortOrder) *SortedMatcher {
+	matcher := &SortedMatcher{name: "SortedByField", order: order, field: field, compare: compareNatural}
+	matcher.MixinMatcherGomock = NewMixinMatcherGomock(matcher, matcher.name)
+	return matcher
+}
+
+func (matcher *SortedMatcher) Explain(actual any) types.Outcome {
+	items, shown, err := sequenceItems(actual, matcher.name)
+	if err != nil {
+		return Errored(err)
+	}
+
+	keys := items
+	if matcher.field != "" {
+		keys = make([]any, len(items))
+		for i, item := range items {
+			if keys[i], err = extractField(item, matcher.field); err != nil {
+				return Errored(fmt.Errorf("%s: %w", IndexSegment(i), err))
+			}
+		}
+	}
+
+	for i := 1; i < len(keys); i++ {
+		c, err := matcher.compare(keys[i-1], keys[i])
+		if err != nil {
+			return Errored(fmt.Errorf("%s: %w", IndexSegment(i), err))
+		}
+		if matcher.order == options.Desc {
+			c = -c
+		}
+		if c > 0 {
+			return Failed(beformat.Message(shown, fmt.Sprintf(
+				"to %s, but %s and %s are not:\n  %s: %s\n  %s: %s",
+				matcher.String(), IndexSegment(i-1), IndexSegment(i),
+				matcher.label(i-1), beformat.Value(keys[i-1]),
+				matcher.label(i), beformat.Value(keys[i]),
+			)))
+		}
+	}
+
+	return Succeeded(beformat.Message(shown, "not to "+matcher.String()))
+}
+
+// label names the compared part of the i-th item, e.g. `[1]` or `[1].CreatedAt`
+func (matcher *SortedMatcher) label(i int) string {
+	if matcher.field != "" {
+		return IndexSegment(i) + "." + matcher.field
+	}
+	return IndexSegment(i)
+}
+
+// compareNatural compares numbers and strings by their value,
+// and values having a `Compare(T) int` method (e.g. time.Time) by that method
+func compareNatural(a, b any) (int, error) {
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if !va.IsValid() || !vb.IsValid() || va.Type() != vb.Type() {
+		return 0, fmt.Errorf("cannot compare %T with %T", a, b)
+	}
+
+	switch va.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cmp.Compare(va.Int(), vb.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return cmp.Compare(va.Uint(), vb.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return cmp.Compare(va.Float(), vb.Float()), nil
+	case reflect.String:
+		return cmp.Compare(va.String(), vb.String()), nil
+	}
+
+	if method := va.MethodByName("Compare"); method.IsValid() {
+		t := method.Type()
+		if t.NumIn() == 1 && t.In(0) == va.Type() && t.NumOut() == 1 && t.Out(0).Kind() == reflect.Int {
+			return int(method.Call([]reflect.Value{vb})[0].Int()), nil
+		}
+	}
+	return 0, fmt.Errorf("cannot compare values of type %T: it's neither a number, a string, nor has a Compare method (use SortedBy)", a)
+}
+
+// String describes the matcher for gomock, e.g. "be sorted by CreatedAt in descending order"
+func (matcher *SortedMatcher) String() string {
+	switch {
+	case matcher.field != "":
+		return fmt.Sprintf("be sorted by %s in %s order", matcher.field, matcher.order)
+	case matcher.name == "SortedBy":
+		return "be sorted by the given comparison"
+	}
+	return fmt.Sprintf("be sorted in %s order", matcher.order)
+}
+
+func (matcher *SortedMatcher) Match(actual any) (bool, error) {
+	o := matcher.Explain(actual)
+	return o.Success, o.Err
+}
+
+func (matcher *SortedMatcher) FailureMessage(actual any) string {
+	return matcher.Explain(actual).Message
+}
+
+func (matcher *SortedMatcher) NegatedFailureMessage(actual any) string {
+	return matcher.Explain(actual).Message
+}
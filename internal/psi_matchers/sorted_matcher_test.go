@@ -0,0 +1,63 @@
+package psi_matchers_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/expectto/be"
+	. "github.com/expectto/be/internal/psi_matchers"
+	. "github.com/expectto/be/options"
+)
+
+func TestSortedMatcher(t *testing.T) {
+	t.Run("natural order", func(t *testing.T) {
+		for _, tc := range []struct {
+			name     string
+			order    SortOrder
+			actual   any
+			expected bool
+		}{
+			{"ascending", Asc, []int{1, 2, 2, 3}, true},
+			{"ascending: out of order", Asc, []int{1, 3, 2}, false},
+			{"descending", Desc, []string{"c", "b", "a"}, true},
+			{"descending: out of order", Desc, []float64{3, 1, 2}, false},
+			{"empty", Asc, []int{}, true},
+			{"array", Asc, [3]uint{1, 2, 3}, true},
+			{"iter.Seq", Asc, slices.Values([]int{1, 2, 3}), true},
+			{"iter.Seq: out of order", Asc, slices.Values([]int{2, 1}), false},
+			{"Compare method", Asc, []time.Time{time.Unix(1, 0), time.Unix(2, 0)}, true},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				be.ExpectValues(t)(NewSortedMatcher(tc.order).Match(tc.actual)).To(be.Eq(tc.expected))
+			})
+		}
+	})
+
+	t.Run("should point at the first pair out of order", func(t *testing.T) {
+		msg := NewSortedMatcher(Asc).FailureMessage([]int{1, 7, 5, 3})
+		be.Expect(t, msg).To(be.ContainSubstring("to be sorted in ascending order, but [1] and [2] are not:\n  [1]: 7\n  [2]: 5"))
+	})
+
+	t.Run("should show the items of an iter.Seq", func(t *testing.T) {
+		msg := NewSortedMatcher(Asc).FailureMessage(slices.Values([]int{2, 1}))
+		be.Expect(t, msg).To(be.ContainSubstring("[2, 1]"))
+	})
+
+	t.Run("should compare by field", func(t *testing.T) {
+		type event struct{ CreatedAt time.Time }
+		events := []event{{time.Unix(3, 0)}, {time.Unix(1, 0)}, {time.Unix(2, 0)}}
+
+		matcher := NewSortedByFieldMatcher("CreatedAt", Desc)
+		be.ExpectValues(t)(matcher.Match(events)).To(be.False())
+		be.Expect(t, matcher.FailureMessage(events)).To(be.ContainSubstring("[1] and [2] are not:\n  [1].CreatedAt: "))
+	})
+
+	t.Run("should error on items it can't compare", func(t *testing.T) {
+		_, err := NewSortedMatcher(Asc).Match([]struct{}{{}, {}})
+		be.Expect(t, err).To(be.MatchError(be.ContainSubstring("[1]: cannot compare")))
+
+		_, err = NewSortedMatcher(Asc).Match(map[int]int{1: 1})
+		be.Expect(t, err).To(be.HaveOccurred())
+	})
+}
@@ -6,7 +6,7 @@ import (
 	"strings"
 
 	"github.com/amberpixels/k1/cast"
-	"github.com/onsi/gomega/format"
+	"github.com/expectto/be/internal/beformat"
 
 	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
 	"github.com/expectto/be/types"
@@ -29,10 +29,6 @@ type StringTemplateMatcher struct {
 
 	regex  *regexp.Regexp
 	values Values
-
-	lastValue     *Value
-	lastActual    any
-	failedMessage string
 }
 
 var _ types.BeMatcher = &StringTemplateMatcher{}
@@ -67,20 +63,20 @@ func NewStringTemplateMatcher(template string, values ...*Value) *StringTemplate
 		panic("invalid template: could not compile a regex from it: " + err.Error())
 	}
 
-	return &StringTemplateMatcher{
-		regex: regex, values: values,
-	}
+	matcher := &StringTemplateMatcher{regex: regex, values: values}
+	matcher.MixinMatcherGomock = NewMixinMatcherGomock(matcher, "StringAsTemplate")
+	return matcher
 }
 
-func (matcher *StringTemplateMatcher) Match(actual any) (bool, error) {
+func (matcher *StringTemplateMatcher) Explain(actual any) types.Outcome {
 	match := matcher.regex.FindStringSubmatch(cast.AsString(actual))
 	if len(match) != len(matcher.regex.SubexpNames()) {
-		matcher.failedMessage = fmt.Sprintf(
+		failedMessage := fmt.Sprintf(
 			"initial mismatch: number of groups expected to be %d but not %d",
 			len(match),
 			len(matcher.regex.SubexpNames()),
 		)
-		return false, nil
+		return Failed(beformat.Message(actual, "to match template:\n %s", failedMessage))
 	}
 
 	results := make(map[string]string)
@@ -92,7 +88,7 @@ func (matcher *StringTemplateMatcher) Match(actual any) (bool, error) {
 
 		if savedResult, ok := results[name]; ok {
 			if savedResult != match[i] {
-				return false, fmt.Errorf("var %s has multiple values: %s != %s", name, savedResult, match[i])
+				return Errored(fmt.Errorf("var %s has multiple values: %s != %s", name, savedResult, match[i]))
 			}
 		}
 
@@ -102,57 +98,46 @@ func (matcher *StringTemplateMatcher) Match(actual any) (bool, error) {
 	// if no vars are given: we simply verified that whole string matches template
 	// without matching specifically templates variables
 	if len(matcher.values) == 0 {
-		return true, nil
+		return Succeeded(beformat.Message(actual, "not to match template"))
 	}
 
+	var last types.Outcome
+	var lastValue *Value
 	for _, v := range matcher.values {
 		name := strings.ToLower(v.Name)
 		result, ok := results[name]
 		if !ok {
-			return false, fmt.Errorf("var %s given but not met in actual value", name)
+			return Errored(fmt.Errorf("var %s given but not met in actual value", name))
 		}
 
-		matched, err := v.Matcher.Match(result)
-		if err != nil {
-			return false, fmt.Errorf("var %s failed: %w", name, err)
+		o := Explain(v.Matcher, result)
+		if o.Err != nil {
+			return Errored(fmt.Errorf("var %s failed: %w", name, o.Err))
 		}
-		matcher.lastValue = v
-		matcher.lastActual = result
-
-		if !matched {
-			return false, nil
+		if !o.Success {
+			return Failed(beformat.Message(
+				actual,
+				fmt.Sprintf("to match template on value %s:\n %s", v.Name, o.Message),
+			))
 		}
+		last, lastValue = o, v
 	}
 
-	return true, nil
+	return Succeeded(beformat.Message(
+		actual,
+		fmt.Sprintf("not to match template on value: %s:\n %s", lastValue.Name, last.Message),
+	))
 }
 
-func (matcher *StringTemplateMatcher) FailureMessage(actual any) string {
-	if matcher.lastValue == nil {
-		return format.Message(actual, "to match template:\n %s", matcher.failedMessage)
-	}
+func (matcher *StringTemplateMatcher) Match(actual any) (bool, error) {
+	o := matcher.Explain(actual)
+	return o.Success, o.Err
+}
 
-	return format.Message(
-		actual,
-		fmt.Sprintf(
-			"to match template on value %s:\n %s",
-			matcher.lastValue.Name,
-			matcher.lastValue.Matcher.FailureMessage(matcher.lastActual),
-		),
-	)
+func (matcher *StringTemplateMatcher) FailureMessage(actual any) string {
+	return matcher.Explain(actual).Message
 }
 
 func (matcher *StringTemplateMatcher) NegatedFailureMessage(actual any) string {
-	if matcher.lastValue == nil {
-		return format.Message(actual, "not to match template:\n %s", matcher.failedMessage)
-	}
-
-	return format.Message(
-		actual,
-		fmt.Sprintf(
-			"not to match template on value: %s:\n %s",
-			matcher.lastValue.Name,
-			matcher.lastValue.Matcher.NegatedFailureMessage(actual),
-		),
-	)
+	return matcher.Explain(actual).Message
 }
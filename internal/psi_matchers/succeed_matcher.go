@@ -0,0 +1,50 @@
+package psi_matchers
+
+import (
+	"fmt"
+
+	"github.com/expectto/be/internal/beformat"
+	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
+	"github.com/expectto/be/types"
+)
+
+// SucceedMatcher matches a nil error.
+// Its negation is the "error has occurred" matcher, as a non-error actual is an error either way.
+type SucceedMatcher struct {
+	*MixinMatcherGomock
+}
+
+var _ types.BeMatcher = &SucceedMatcher{}
+
+func NewSucceedMatcher() *SucceedMatcher {
+	matcher := &SucceedMatcher{}
+	matcher.MixinMatcherGomock = NewMixinMatcherGomock(matcher, "Succeed")
+	return matcher
+}
+
+func (matcher *SucceedMatcher) Explain(actual any) types.Outcome {
+	if actual == nil {
+		return Succeeded("Expected an error to have occurred.  Got:\n" + beformat.Object(actual, 1))
+	}
+	if _, ok := actual.(error); !ok {
+		return Errored(fmt.Errorf("Expected an error-type.  Got:\n%s", beformat.Object(actual, 1)))
+	}
+	return Failed("Expected success, but got an error:\n" + beformat.Object(actual, 1))
+}
+
+func (matcher *SucceedMatcher) Match(actual any) (bool, error) {
+	o := matcher.Explain(actual)
+	return o.Success, o.Err
+}
+
+func (matcher *SucceedMatcher) FailureMessage(actual any) string {
+	return matcher.Explain(actual).Message
+}
+
+func (matcher *SucceedMatcher) NegatedFailureMessage(actual any) string {
+	return matcher.Explain(actual).Message
+}
+
+func (matcher *SucceedMatcher) String() string {
+	return "succeed"
+}
@@ -0,0 +1,81 @@
+package psi_matchers
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/expectto/be/internal/beformat"
+	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
+	"github.com/expectto/be/types"
+)
+
+// TemporalMatcher is a port of gomega's BeTemporallyMatcher: it compares time.Time values
+// via the given comparator ("==", "~", ">", ">=", "<", "<=").
+// "~" takes an optional threshold (a millisecond by default).
+type TemporalMatcher struct {
+	*MixinMatcherGomock
+
+	Comparator string
+	CompareTo  time.Time
+	Threshold  []time.Duration
+}
+
+var _ types.BeMatcher = &TemporalMatcher{}
+
+func NewTemporalMatcher(comparator string, compareTo time.Time, threshold ...time.Duration) *TemporalMatcher {
+	matcher := &TemporalMatcher{Comparator: comparator, CompareTo: compareTo, Threshold: threshold}
+	matcher.MixinMatcherGomock = NewMixinMatcherGomock(matcher, "BeTemporally")
+	return matcher
+}
+
+func (matcher *TemporalMatcher) Explain(actual any) types.Outcome {
+	success, err := matcher.Match(actual)
+	return Outcome(success, err, func() string {
+		if success {
+			return matcher.NegatedFailureMessage(actual)
+		}
+		return matcher.FailureMessage(actual)
+	})
+}
+
+func (matcher *TemporalMatcher) Match(actual any) (bool, error) {
+	t, ok := actual.(time.Time)
+	if !ok {
+		return false, fmt.Errorf("Expected a time.Time.  Got:\n%s", beformat.Object(actual, 1))
+	}
+
+	switch matcher.Comparator {
+	case "==":
+		return t.Equal(matcher.CompareTo), nil
+	case "~":
+		threshold := time.Millisecond
+		if len(matcher.Threshold) > 0 {
+			threshold = matcher.Threshold[0]
+		}
+		diff := t.Sub(matcher.CompareTo)
+		return -threshold <= diff && diff <= threshold, nil
+	case ">":
+		return t.After(matcher.CompareTo), nil
+	case ">=":
+		return !t.Before(matcher.CompareTo), nil
+	case "<":
+		return t.Before(matcher.CompareTo), nil
+	case "<=":
+		return !t.After(matcher.CompareTo), nil
+	default:
+		return false, fmt.Errorf("Unknown comparator: %s", matcher.Comparator)
+	}
+}
+
+func (matcher *TemporalMatcher) FailureMessage(actual any) string {
+	return beformat.Message(actual, "to be "+matcher.Comparator, matcher.CompareTo)
+}
+
+func (matcher *TemporalMatcher) NegatedFailureMessage(actual any) string {
+	return beformat.Message(actual, "not to be "+matcher.Comparator, matcher.CompareTo)
+}
+
+// String describes the matcher for gomock, e.g. "be > 2024-01-01 00:00:00 +0000 UTC"
+func (matcher *TemporalMatcher) String() string {
+	return fmt.Sprintf("be %s %v", matcher.Comparator, matcher.CompareTo)
+}
@@ -0,0 +1,119 @@
+package psi_matchers
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/expectto/be/internal/beformat"
+	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
+	"github.com/expectto/be/types"
+)
+
+// UniqueMatcher succeeds if no two items of a slice, array or iter.Seq are equal
+// (or, given a key function, if no two items share the same key).
+// The failure points at the first duplicate along with the item it duplicates.
+type UniqueMatcher struct {
+	*MixinMatcherGomock
+
+	key func(item any) (any, error)
+}
+
+var _ types.BeMatcher = &UniqueMatcher{}
+
+func NewUniqueMatcher() *UniqueMatcher {
+	matcher := &UniqueMatcher{}
+	matcher.MixinMatcherGomock = NewMixinMatcherGomock(matcher, "Unique")
+	return matcher
+}
+
+// NewUniqueByMatcher creates a UniqueMatcher comparing the keys the given function returns
+func NewUniqueByMatcher(key func(item any) (any, error)) *UniqueMatcher {
+	if key == nil {
+		panic("UniqueBy expects a non-nil key function")
+	}
+	matcher := &UniqueMatcher{key: key}
+	matcher.MixinMatcherGomock = NewMixinMatcherGomock(matcher, "UniqueBy")
+	return matcher
+}
+
+func (matcher *UniqueMatcher) Explain(actual any) types.Outcome {
+	items, shown, err := sequenceItems(actual, matcher.name())
+	if err != nil {
+		return Errored(err)
+	}
+
+	keys := items
+	if matcher.key != nil {
+		keys = make([]any, len(items))
+		for i, item := range items {
+			if keys[i], err = matcher.key(item); err != nil {
+				return Errored(fmt.Errorf("%s: %w", IndexSegment(i), err))
+			}
+		}
+	}
+
+	first, dup, found := firstDuplicate(keys)
+	if !found {
+		return Succeeded(beformat.Message(shown, "not to "+matcher.String()))
+	}
+
+	what := "are duplicates"
+	if matcher.key != nil {
+		what = "share the key " + beformat.Value(keys[dup])
+	}
+	return Failed(beformat.Message(shown, fmt.Sprintf(
+		"to %s, but %s and %s %s:\n  %s: %s\n  %s: %s",
+		matcher.String(), IndexSegment(first), IndexSegment(dup), what,
+		IndexSegment(first), beformat.Value(items[first]),
+		IndexSegment(dup), beformat.Value(items[dup]),
+	)))
+}
+
+// firstDuplicate finds the first key equal to an earlier one, returning both indices.
+// Comparable keys are looked up in a map, others are compared one by one (reflect.DeepEqual).
+func firstDuplicate(keys []any) (first, dup int, found bool) {
+	seen := make(map[any]int, len(keys))
+	for i, k := range keys {
+		if k != nil && !reflect.ValueOf(k).Comparable() {
+			for j := range i {
+				if reflect.DeepEqual(keys[j], k) {
+					return j, i, true
+				}
+			}
+			continue
+		}
+		if j, ok := seen[k]; ok {
+			return j, i, true
+		}
+		seen[k] = i
+	}
+	return 0, 0, false
+}
+
+func (matcher *UniqueMatcher) name() string {
+	if matcher.key != nil {
+		return "UniqueBy"
+	}
+	return "Unique"
+}
+
+// String describes the matcher for gomock, e.g. "have unique elements"
+func (matcher *UniqueMatcher) String() string {
+	if matcher.key != nil {
+		return "have unique keys"
+	}
+	return "have unique elements"
+}
+
+func (matcher *UniqueMatcher) Match(actual any) (bool, error) {
+	o := matcher.Explain(actual)
+	return o.Success, o.Err
+}
+
+func (matcher *UniqueMatcher) FailureMessage(actual any) string {
+	return matcher.Explain(actual).Message
+}
+
+func (matcher *UniqueMatcher) NegatedFailureMessage(actual any) string {
+	return matcher.Explain(actual).Message
+}
@@ -0,0 +1,40 @@
+package psi_matchers_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/expectto/be"
+	. "github.com/expectto/be/internal/psi_matchers"
+)
+
+func TestUniqueMatcher(t *testing.T) {
+	t.Run("unique items", func(t *testing.T) {
+		for _, tc := range []struct {
+			name     string
+			actual   any
+			expected bool
+		}{
+			{"unique", []int{1, 2, 3}, true},
+			{"duplicates", []int{1, 2, 1}, false},
+			{"non-comparable items", [][]int{{1}, {2}}, true},
+			{"non-comparable duplicates", [][]int{{1}, {1}}, false},
+			{"iter.Seq", slices.Values([]string{"a", "a"}), false},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				be.ExpectValues(t)(NewUniqueMatcher().Match(tc.actual)).To(be.Eq(tc.expected))
+			})
+		}
+	})
+
+	t.Run("should point at the duplicate pair", func(t *testing.T) {
+		msg := NewUniqueMatcher().FailureMessage([]string{"x", "y", "z", "x"})
+		be.Expect(t, msg).To(be.ContainSubstring(`to have unique elements, but [0] and [3] are duplicates:` + "\n" + `  [0]: "x"` + "\n" + `  [3]: "x"`))
+	})
+
+	t.Run("should compare keys", func(t *testing.T) {
+		matcher := NewUniqueByMatcher(func(item any) (any, error) { return item.(int) % 10, nil })
+		be.ExpectValues(t)(matcher.Match([]int{1, 2, 3})).To(be.True())
+		be.Expect(t, matcher.FailureMessage([]int{1, 2, 11})).To(be.ContainSubstring("[0] and [2] share the key 1"))
+	})
+}
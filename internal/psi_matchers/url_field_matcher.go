@@ -3,9 +3,8 @@ package psi_matchers
 import (
 	"fmt"
 	"net/url"
-	"strings"
 
-	"github.com/onsi/gomega/format"
+	"github.com/expectto/be/internal/beformat"
 
 	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
 	"github.com/expectto/be/types"
@@ -47,47 +46,53 @@ func NewUrlFieldMatcher(publicName, fieldName string, cb func(*url.URL) any, arg
 	return matcher
 }
 
-func (matcher *UrlFieldMatcher) Match(actual any) (bool, error) {
+func (matcher *UrlFieldMatcher) Explain(actual any) types.Outcome {
 	if actual == nil {
-		return false, fmt.Errorf("%s() expects actual value not to be nil", matcher.publicName)
+		return Errored(fmt.Errorf("%s() expects actual value not to be nil", matcher.publicName))
 	}
 
 	actualUrl, ok := actual.(*url.URL)
 	if !ok {
-		return false, fmt.Errorf(
+		return Errored(fmt.Errorf(
 			"%s() expects actual value mast be a <*url.URL> received <%T>",
 			matcher.publicName,
 			actual,
-		)
+		))
 	}
 
 	if matcher.cb == nil {
 		// we're just matching a valid URL
-		return true, nil
+		return Succeeded(beformat.Message(actual, "not to be a valid url"))
 	}
 
 	v := matcher.cb(actualUrl)
 
 	// If no inner matchers were given, then we simply validated if {field value} is not empty
 	if matcher.matching == nil {
-		return v != "" && v != nil && v != 0, nil
+		return AtPath(matcher.fieldName, nonEmpty(v, matcher.fieldName))
 	}
 
 	// simply allow underlying matchers to do their job
-	return matcher.matching.Match(v)
+	return AtPath(matcher.fieldName, Explain(matcher.matching, v))
 }
 
-func (matcher *UrlFieldMatcher) FailureMessage(actual any) string {
-	u, _ := actual.(*url.URL) // FailureMessage is only reached after Match saw a *url.URL
-	v := matcher.cb(u)
+func (matcher *UrlFieldMatcher) Match(actual any) (bool, error) {
+	o := matcher.Explain(actual)
+	return o.Success, o.Err
+}
 
-	if matcher.matching == nil {
-		return format.Message(v, "to be a non-empty "+matcher.fieldName)
-	}
-	return matcher.matching.FailureMessage(v)
+func (matcher *UrlFieldMatcher) FailureMessage(actual any) string {
+	return matcher.Explain(actual).Message
 }
 
 func (matcher *UrlFieldMatcher) NegatedFailureMessage(actual any) string {
-	// todo: not so accurate
-	return strings.Replace(matcher.FailureMessage(actual), "\nto ", "\nnot to ", 1)
+	return matcher.Explain(actual).Message
+}
+
+func (matcher *UrlFieldMatcher) Children(actual any) []types.Child {
+	u, ok := actual.(*url.URL)
+	if !ok || matcher.cb == nil || matcher.matching == nil {
+		return nil
+	}
+	return []types.Child{{Segment: matcher.fieldName, Matcher: matcher.matching, Actual: matcher.cb(u)}}
 }
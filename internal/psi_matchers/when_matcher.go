@@ -0,0 +1,103 @@
+package psi_matchers
+
+import (
+	"github.com/expectto/be/internal/beformat"
+
+	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // should be moved to lintignore
+	"github.com/expectto/be/types"
+)
+
+// WhenMatcher is a conditional matcher: when actual matches the condition,
+// it must match Then, otherwise it must match Else.
+// A missing Else means there's nothing to require: it always succeeds.
+type WhenMatcher struct {
+	Cond, Then, Else types.BeMatcher
+}
+
+var _ types.BeMatcher = &WhenMatcher{}
+
+func NewWhenMatcher(cond, then any, otherwise ...any) *WhenMatcher {
+	m := &WhenMatcher{Cond: AsMatcher(cond), Then: AsMatcher(then)}
+	if len(otherwise) > 0 {
+		m.Else = NewAllMatcher(otherwise...)
+	}
+	return m
+}
+
+// Explain applies the branch picked by the condition,
+// its message tells which branch it was
+func (m *WhenMatcher) Explain(actual any) types.Outcome {
+	cond := Explain(m.Cond, actual)
+	if cond.Err != nil {
+		return cond
+	}
+
+	if cond.Success {
+		return m.branch(Explain(m.Then, actual), "condition matched: "+m.describeCond())
+	}
+	if m.Else == nil {
+		return Succeeded(withReason(
+			beformat.Message(actual, "not to satisfy "+m.String()),
+			"condition did not match: "+m.describeCond()+", so nothing else was required",
+		))
+	}
+	return m.branch(Explain(m.Else, actual), "condition did not match: "+m.describeCond())
+}
+
+func (m *WhenMatcher) branch(o types.Outcome, reason string) types.Outcome {
+	if o.Err != nil {
+		return o
+	}
+	o.Message = withReason(o.Message, reason)
+	return o
+}
+
+// withReason appends the reason (which branch was taken) to the message
+func withReason(message, reason string) string {
+	return message + "\n(" + reason + ")"
+}
+
+func (m *WhenMatcher) describeCond() string {
+	if s := m.Cond.String(); s != "" {
+		return s
+	}
+	return "matcher"
+}
+
+func (m *WhenMatcher) Match(actual any) (bool, error) {
+	o := m.Explain(actual)
+	return o.Success, o.Err
+}
+
+func (m *WhenMatcher) FailureMessage(actual any) string {
+	return m.Explain(actual).Message
+}
+
+func (m *WhenMatcher) NegatedFailureMessage(actual any) string {
+	return m.Explain(actual).Message
+}
+
+func (m *WhenMatcher) Matches(actual any) bool {
+	success, _ := m.Match(actual)
+	return success
+}
+
+// String describes both branches, e.g. "if be > 5 then be even else be odd"
+func (m *WhenMatcher) String() string {
+	s := "if " + m.describeCond() + " then " + m.Then.String()
+	if m.Else != nil {
+		s += " else " + m.Else.String()
+	}
+	return s
+}
+
+// Children exposes the branch picked by the condition
+func (m *WhenMatcher) Children(actual any) []types.Child {
+	if Explain(m.Cond, actual).Success {
+		return ChildrenOf(actual, m.Then)
+	}
+	if m.Else != nil {
+		return ChildrenOf(actual, m.Else)
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package be
+
+// iter.go configures how collection matchers consume Go iterators.
+
+import "github.com/expectto/be/internal/psi"
+
+// DefaultSeqLimit is the number of values an iterator may yield to a matcher
+// unless SetSeqLimit changes it.
+const DefaultSeqLimit = psi.DefaultIteratorLimit
+
+// SetSeqLimit sets the number of values an iterator (iter.Seq or iter.Seq2) may
+// yield to a collection matcher. Matchers collect iterators before matching, so
+// an infinite one would hang the test: once it yields more than the limit,
+// the match is an error instead. A non-positive n restores DefaultSeqLimit.
+// The returned func restores the previous limit:
+//
+//	defer be.SetSeqLimit(1_000_000)()
+//
+// Iterators are collected on each evaluation, so they should be reusable
+// (as slices.Values or maps.All are).
+func SetSeqLimit(n int) (restore func()) { return psi.SetIteratorLimit(n) }
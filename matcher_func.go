@@ -0,0 +1,84 @@
+package be
+
+// matcher_func.go provides the public builder for custom matchers, so
+// domain-specific matchers look and behave like the built-in ones: composable,
+// with native failure messages and a proper gomock description.
+
+import (
+	"fmt"
+	"reflect"
+	"text/template"
+
+	"github.com/expectto/be/internal/psi"
+	"github.com/expectto/be/types"
+)
+
+// MatcherFuncOption configures a matcher built via MatcherFunc.
+type MatcherFuncOption func(*psi.FuncMatcher)
+
+// WithExpected attaches the expected value to the matcher: it's shown in the
+// default failure message and in the gomock description, and is available to
+// the failure template as {{.Expected}}.
+func WithExpected(expected any) MatcherFuncOption {
+	return func(m *psi.FuncMatcher) {
+		m.Expected = expected
+		m.HasExpected = true
+	}
+}
+
+// WithFailureTemplate sets a text/template for failure messages. The template
+// is executed with these fields:
+//
+//	{{.Name}}              the matcher name
+//	{{.Actual}}            the actual value
+//	{{.Expected}}          the value given via WithExpected
+//	{{.To}}                "to", or "not to" for a negated failure
+//	{{.FormattedActual}}   the actual value, formatted as in built-in messages
+//	{{.FormattedExpected}} the expected value, formatted as in built-in messages
+//
+// Panics if the template can't be parsed.
+func WithFailureTemplate(tpl string) MatcherFuncOption {
+	t := template.Must(template.New("failure").Parse(tpl))
+	return func(m *psi.FuncMatcher) { m.Template = t }
+}
+
+// MatcherFunc builds a custom matcher from a typed match function. The name
+// reads as the rest of the sentence "expected <actual> to <name>":
+//
+//	func DivisibleBy(n int) types.BeMatcher {
+//		return be.MatcherFunc("be divisible by", func(actual int) (bool, error) {
+//			return actual%n == 0, nil
+//		}, be.WithExpected(n))
+//	}
+//
+//	be.Expect(t, 7).To(DivisibleBy(3)) // "Expected 7 to be divisible by 3"
+//
+// An actual value that is not a T is reported as an error (not a mismatch),
+// like in any built-in matcher. The match function's error is reported as is.
+// The result is a full types.BeMatcher: it works with be.Expect, gomega and as
+// a gomock argument matcher (described as "<name> <expected>").
+func MatcherFunc[T any](name string, match func(actual T) (bool, error), opts ...MatcherFuncOption) types.BeMatcher {
+	m := psi.NewFuncMatcher(name, func(actual any) (bool, error) {
+		v, ok := actual.(T)
+		if !ok {
+			// a nil actual is accepted as the zero value of a nillable T (pointer, interface, ...)
+			if actual != nil || !isNillable(reflect.TypeFor[T]()) {
+				return false, fmt.Errorf("%s matcher expects %s, got %T", name, reflect.TypeFor[T](), actual)
+			}
+		}
+		return match(v)
+	})
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+func isNillable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,66 @@
+package be_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/expectto/be"
+	"github.com/expectto/be/types"
+)
+
+func divisibleBy(n int) types.BeMatcher {
+	return be.MatcherFunc("be divisible by", func(actual int) (bool, error) {
+		return actual%n == 0, nil
+	}, be.WithExpected(n))
+}
+
+func TestMatcherFunc(t *testing.T) {
+	be.Expect(t, 9).To(divisibleBy(3))
+	be.Expect(t, 7).NotTo(divisibleBy(3))
+	be.Expect(t, 9).To(be.All(divisibleBy(3), be.Gt(5))) // composes like any other matcher
+
+	rt := &recT{}
+	be.Expect(rt, 7).To(divisibleBy(3))
+	be.Expect(rt, 9).NotTo(divisibleBy(3))
+	if len(rt.errs) != 2 || rt.errs[0] != "Expected 7 to be divisible by 3" || rt.errs[1] != "Expected 9 not to be divisible by 3" {
+		t.Fatalf("unexpected failure messages: %v", rt.errs)
+	}
+}
+
+func TestMatcherFuncTypeMismatchIsAnError(t *testing.T) {
+	ok, err := divisibleBy(3).Match("nine")
+	if ok || err == nil || !strings.Contains(err.Error(), "be divisible by matcher expects int, got string") {
+		t.Fatalf("a wrong actual type must be an error, got ok=%v err=%v", ok, err)
+	}
+
+	// a nil actual is a zero value for nillable types
+	isNilErr := be.MatcherFunc("be a nil error", func(err error) (bool, error) { return err == nil, nil })
+	be.Expect(t, nil).To(isNilErr)
+	be.Expect(t, errors.New("boom")).NotTo(isNilErr)
+}
+
+func TestMatcherFuncTemplate(t *testing.T) {
+	m := be.MatcherFunc("be divisible by", func(actual int) (bool, error) {
+		return actual%3 == 0, nil
+	}, be.WithExpected(3), be.WithFailureTemplate("{{.Actual}} is {{if eq .To \"to\"}}not {{end}}a multiple of {{.Expected}}"))
+
+	rt := &recT{}
+	be.Expect(rt, 7).To(m)
+	be.Expect(rt, 9).NotTo(m)
+	if len(rt.errs) != 2 || rt.errs[0] != "7 is not a multiple of 3" || rt.errs[1] != "9 is a multiple of 3" {
+		t.Fatalf("unexpected templated failure messages: %v", rt.errs)
+	}
+}
+
+func TestMatcherFuncGomockDescription(t *testing.T) {
+	var m gomock.Matcher = divisibleBy(3)
+	if !m.Matches(6) || m.Matches(7) {
+		t.Fatalf("gomock matching is broken")
+	}
+	if m.String() != "be divisible by 3" {
+		t.Fatalf("unexpected gomock description: %q", m.String())
+	}
+}
@@ -14,7 +14,7 @@ func Always() types.BeMatcher {
 	return psi_matchers.NewAlwaysMatcher()
 }
 
-// Never does never succeed (does always fail)
+// Never does never succeed: it fails with the given error, even when negated
 func Never(err error) types.BeMatcher {
 	return psi_matchers.NewNeverMatcher(err)
 }
@@ -29,6 +29,47 @@ func Any(ms ...any) types.BeMatcher {
 	return psi_matchers.NewAnyMatcher(ms...)
 }
 
+// AtLeast succeeds if at least n of the given matchers succeed.
+// Every matcher is evaluated, the failure lists which ones matched:
+//
+//	be.Expect(t, user).To(be.AtLeast(2, hasEmail, hasPhone, hasAddress))
+func AtLeast(n int, ms ...any) types.BeMatcher {
+	return psi_matchers.NewCountMatcher(n, max(n, len(ms)), ms...)
+}
+
+// AtMost succeeds if at most n of the given matchers succeed.
+func AtMost(n int, ms ...any) types.BeMatcher {
+	return psi_matchers.NewCountMatcher(0, n, ms...)
+}
+
+// ExactlyOneOf succeeds if exactly one of the given matchers succeeds (XOR for two).
+func ExactlyOneOf(ms ...any) types.BeMatcher {
+	return psi_matchers.NewCountMatcher(1, 1, ms...)
+}
+
+// When applies then if actual matches cond, otherwise it applies otherwise
+// (if given, else it succeeds). The failure tells which branch was taken:
+//
+//	be.Expect(t, job).To(be.When(
+//		be.HaveField("Status", "failed"),
+//		be.HaveField("Error", be.NotEmpty()),
+//	))
+func When(cond, then any, otherwise ...any) types.BeMatcher {
+	return psi_matchers.NewWhenMatcher(cond, then, otherwise...)
+}
+
+// Lazy defers building the matcher until it's first used, so a matcher can
+// refer to itself to validate recursive data (trees, comment threads, ...):
+//
+//	var node types.BeMatcher
+//	node = be.HaveFields(map[string]any{
+//		"Name":     be.NotEmpty(),
+//		"Children": be.Any(be.Empty(), be.Dive(be.Lazy(func() types.BeMatcher { return node }))),
+//	})
+func Lazy(build func() types.BeMatcher) types.BeMatcher {
+	return psi_matchers.NewLazyMatcher(build)
+}
+
 // Eq succeeds if actual equals expected by VALUE (deep equality, like
 // gomega.Equal):
 //
@@ -38,7 +79,7 @@ func Any(ms ...any) types.BeMatcher {
 // When you mean "the same instance" (pointer identity, Go's ==), use
 // be.Identical instead. For "unset / zero value" prefer be.Zero over be.Eq(0).
 func Eq(expected any) types.BeMatcher {
-	return psi_matchers.NewEqMatcher(expected)
+	return NewEqMatcher(expected)
 }
 
 // Not is like gomega.Not()
@@ -46,8 +87,8 @@ func Not(expected any) types.BeMatcher {
 	return psi_matchers.NewNotMatcher(Psi(expected))
 }
 
-// HaveLength succeeds if the actual value (string, slice, array, map or
-// channel) has a length matching the provided condition — either an exact
+// HaveLength succeeds if the actual value (string, slice, array, map,
+// channel or iterator) has a length matching the provided condition — either an exact
 // count, or one or more matchers applied to the length (unlike gomega.HaveLen,
 // which only takes a count):
 //
@@ -61,8 +102,8 @@ func HaveLength(args ...any) types.BeMatcher {
 	return psi_matchers.NewHaveLengthMatcher(args...)
 }
 
-// Dive applies the given matcher to each (every) element of a slice or array,
-// or to each value of a map.
+// Dive applies the given matcher to each (every) element of a slice, array or
+// iter.Seq, or to each value of a map or iter.Seq2.
 // Note: Dive is very close to gomega.HaveEach
 func Dive(matcher any) types.BeMatcher { return NewDiveMatcher(matcher, DiveModeEvery) }
 
@@ -72,5 +113,48 @@ func DiveAny(matcher any) types.BeMatcher { return NewDiveMatcher(matcher, DiveM
 // DiveFirst applies the given matcher to the first element of the given slice
 func DiveFirst(matcher any) types.BeMatcher { return NewDiveMatcher(matcher, DiveModeFirst) }
 
-// DiveNth applies the given matcher to the nth element of the given slice
+// DiveLast applies the given matcher to the last element of the given slice
+func DiveLast(matcher any) types.BeMatcher { return NewDiveMatcher(matcher, DiveModeLast) }
+
+// DiveNth applies the given matcher to the nth element of the given slice.
+// A negative n counts from the end: DiveNth(-1, m) is DiveLast(m)
 func DiveNth(n int, matcher any) types.BeMatcher { return NewDiveMatcher(matcher, DiveModeNth, n) }
+
+// DiveNone succeeds if none of the elements (or map values) matches the given matcher.
+// The failure points at the first matching element.
+func DiveNone(matcher any) types.BeMatcher { return NewDiveMatcher(matcher, DiveModeNone) }
+
+// DiveCount succeeds if the number of elements (or map values) matching the given matcher
+// matches count (a number or a matcher for the number):
+//
+//	be.Expect(t, orders).To(be.DiveCount(be.Gte(2), be.HaveField("Status", "paid")))
+func DiveCount(count, matcher any) types.BeMatcher {
+	return NewDiveMatcher(matcher, DiveModeCount, count)
+}
+
+// DiveKeys applies the given matcher to each key of a map (or iter.Seq2)
+func DiveKeys(matcher any) types.BeMatcher { return NewDiveKeysMatcher(matcher) }
+
+// DiveEntries succeeds if each entry of a map (or iter.Seq2) has its key matching keyMatcher
+// and its value matching valueMatcher:
+//
+//	be.Expect(t, headers).To(be.DiveEntries(be_string.LowerCaseOnly(), be.NotEmpty()))
+func DiveEntries(keyMatcher, valueMatcher any) types.BeMatcher {
+	return NewDiveEntriesMatcher(keyMatcher, valueMatcher)
+}
+
+// Capture succeeds if actual matches the given args (any value if none are
+// given) and stores actual into dst, so it can be asserted on afterwards.
+// Handy for arguments a mock received:
+//
+//	var req *http.Request
+//	client.EXPECT().Do(be.Capture(&req, be_http.HavingMethod("POST"))).Return(resp, nil)
+//	...
+//	be.Expect(t, req).To(be_http.HavingHeader("X-Trace"))
+//
+// dst is assigned every time the match succeeds (the last matched value wins)
+// and is left untouched on failure. Actual must be assignable to T, otherwise
+// it's an error (not a mismatch). Sharing dst across goroutines is up to you.
+func Capture[T any](dst *T, args ...any) types.BeMatcher {
+	return psi_matchers.NewCaptureMatcher(dst, args...)
+}
@@ -0,0 +1,86 @@
+package be
+
+// matchers_be_chan.go provides channel matchers: receiving values (waiting for
+// them up to a timeout), closing and sending. Under be.Eventually a channel is
+// polled without losing the values received by the previous polls.
+
+import (
+	"time"
+
+	"github.com/expectto/be/internal/psi_matchers"
+	"github.com/expectto/be/types"
+)
+
+// Receive succeeds if a value is received from actual (a channel) and matches
+// the given value or matcher (if any). A time.Duration argument is the timeout
+// to wait for the value, with no timeout only a value already sent is received:
+//
+//	be.Expect(t, events).To(be.Receive(be.HaveField("Type", "created"), time.Second))
+//
+// Receiving consumes the value. Under be.Eventually, each poll receives the next
+// value, so values not matching are skipped until one does:
+//
+//	be.Eventually(t, events, be.Receive(be.HaveField("Type", "done")))
+//
+// To expect a time.Duration value use be.Eq(d).
+func Receive(args ...any) types.BeMatcher {
+	elements, timeout := splitTimeout(args)
+	return psi_matchers.NewReceiveMatcher(psi_matchers.ReceiveModeOne, timeout, elements...)
+}
+
+// ReceiveAll drains actual (a channel) until it's closed and succeeds if the
+// received values (as a []T) match the given value or matcher (if any).
+// A time.Duration argument is the timeout to wait for the channel to be closed:
+//
+//	be.Expect(t, results).To(be.ReceiveAll(be.HaveLength(3), time.Second))
+//
+// Under be.Eventually the values received by each poll are kept until the channel is closed.
+func ReceiveAll(args ...any) types.BeMatcher {
+	elements, timeout := splitTimeout(args)
+	return psi_matchers.NewReceiveMatcher(psi_matchers.ReceiveModeAll, timeout, elements...)
+}
+
+// ReceiveInOrder succeeds if the values received from actual (a channel) match
+// the given values or matchers one after another: the first value matches the
+// first element, the next one matches the second, ... A value that doesn't match
+// its element is a failure. A time.Duration argument is the timeout to wait
+// for all of them:
+//
+//	be.Eventually(t, bus.Events(), be.ReceiveInOrder(
+//		be.HaveField("Type", "created"),
+//		be.HaveField("Type", "updated"),
+//	))
+//
+// Under be.Eventually the elements matched by each poll are kept.
+func ReceiveInOrder(elements ...any) types.BeMatcher {
+	elements, timeout := splitTimeout(elements)
+	return psi_matchers.NewReceiveMatcher(psi_matchers.ReceiveModeInOrder, timeout, elements...)
+}
+
+// BeClosed succeeds if actual (a channel) is closed. Telling it requires a receive:
+// a value that is in the channel is consumed.
+func BeClosed() types.BeMatcher { return psi_matchers.NewClosedMatcher() }
+
+// BeSent succeeds if the value is sent to actual (a channel), waiting up to the
+// timeout (if given) for the channel to accept it. Sending to a closed channel is an error.
+//
+//	be.Expect(t, jobs).To(be.BeSent(job, 100*time.Millisecond))
+func BeSent(value any, timeout ...time.Duration) types.BeMatcher {
+	var wait time.Duration
+	if len(timeout) > 0 {
+		wait = timeout[0]
+	}
+	return psi_matchers.NewSentMatcher(value, wait)
+}
+
+// splitTimeout separates the timeout (a time.Duration argument) from the elements
+func splitTimeout(args []any) (elements []any, timeout time.Duration) {
+	for _, arg := range args {
+		if d, ok := arg.(time.Duration); ok {
+			timeout = d
+			continue
+		}
+		elements = append(elements, arg)
+	}
+	return elements, timeout
+}
@@ -0,0 +1,133 @@
+package be_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/expectto/be"
+)
+
+func TestChannelMatchers(t *testing.T) {
+	ch := make(chan int, 1)
+	be.Expect(t, ch).To(be.BeSent(1))
+	be.Expect(t, ch).To(be.Receive(1))
+	be.Expect(t, ch).NotTo(be.Receive())
+
+	go func() {
+		time.Sleep(5 * time.Millisecond)
+		ch <- 2
+	}()
+	be.Expect(t, ch).To(be.Receive(be.Gt(1), time.Second))
+
+	results := make(chan string, 3)
+	results <- "a"
+	results <- "b"
+	close(results)
+	be.Expect(t, results).To(be.ReceiveAll([]string{"a", "b"}))
+	be.Expect(t, results).To(be.BeClosed())
+}
+
+func TestReceiveUnderEventually(t *testing.T) {
+	events := make(chan int)
+	go func() {
+		for i := 1; i <= 5; i++ {
+			events <- i
+		}
+		close(events)
+	}()
+
+	// each poll receives the next value: the ones not matching are skipped
+	be.Eventually(t, events, be.Receive(3), be.WithPolling(time.Millisecond))
+	// the values left are received by the next polls, none of them is lost
+	be.Eventually(t, events, be.ReceiveInOrder(4, 5), be.WithPolling(time.Millisecond))
+	be.Eventually(t, events, be.BeClosed(), be.WithPolling(time.Millisecond))
+}
+
+func TestReceiveAllUnderEventually(t *testing.T) {
+	events := make(chan int)
+	go func() {
+		for i := 1; i <= 3; i++ {
+			time.Sleep(time.Millisecond)
+			events <- i
+		}
+		close(events)
+	}()
+
+	be.Eventually(t, events, be.ReceiveAll([]int{1, 2, 3}), be.WithPolling(time.Millisecond))
+}
+
+func TestReceiveNestedUnderEventually(t *testing.T) {
+	events := make(chan int)
+	go func() {
+		for i := 1; i <= 3; i++ {
+			time.Sleep(time.Millisecond)
+			events <- i
+		}
+		close(events)
+	}()
+
+	// the composite starts the poll sessions of its children: no value is lost between the polls
+	be.Eventually(t, events, be.All(be.Not(be.Nil()), be.ReceiveAll([]int{1, 2, 3})), be.WithPolling(time.Millisecond))
+
+	values := make(chan int, 2)
+	values <- 1
+	go func() {
+		time.Sleep(5 * time.Millisecond)
+		values <- 2
+	}()
+	be.Eventually(t, values, be.Any(be.Nil(), be.ReceiveInOrder(1, 2)), be.WithPolling(time.Millisecond))
+}
+
+func TestReceiveInOrderFailure(t *testing.T) {
+	ch := make(chan int, 2)
+	ch <- 1
+	ch <- 3
+
+	rt := &recT{}
+	be.Expect(rt, ch).To(be.ReceiveInOrder(1, 2))
+	if len(rt.errs)+len(rt.fatals) != 1 {
+		t.Fatalf("expected exactly one failure, got errs=%v fatals=%v", rt.errs, rt.fatals)
+	}
+	msg := strings.Join(append(rt.errs, rt.fatals...), "")
+	if !strings.Contains(msg, "then a value that doesn't match 2") {
+		t.Fatalf("unexpected failure message: %q", msg)
+	}
+}
+
+// TestChannelMatchersExplainTheirEvaluation guards that a failure message following Match
+// (as gomega and gomock ask for it) explains that evaluation instead of receiving or sending again
+func TestChannelMatchersExplainTheirEvaluation(t *testing.T) {
+	buffered := func() chan int {
+		ch := make(chan int, 2)
+		ch <- 1
+		ch <- 2
+		return ch
+	}
+
+	ch := buffered()
+	receive := be.Receive(5)
+	be.ExpectValues(t)(receive.Match(ch)).To(be.False())
+	be.Expect(t, receive.FailureMessage(ch)).To(be.ContainSubstring("received a value that doesn't match"))
+	be.Expect(t, receive.FailureMessage(ch)).To(be.ContainSubstring("Expected 1 to equal 5"))
+	be.Expect(t, len(ch)).To(be.Eq(1))
+
+	ch = buffered()
+	closed := be.BeClosed()
+	be.ExpectValues(t)(closed.Match(ch)).To(be.False())
+	be.Expect(t, closed.FailureMessage(ch)).To(be.ContainSubstring("but received 1"))
+	be.Expect(t, len(ch)).To(be.Eq(1))
+
+	ch = make(chan int, 2)
+	sent := be.BeSent(1)
+	be.ExpectValues(t)(sent.Match(ch)).To(be.True())
+	be.Expect(t, sent.NegatedFailureMessage(ch)).To(be.ContainSubstring("but it was"))
+	be.Expect(t, len(ch)).To(be.Eq(1))
+
+	// be.Check walks the sub-matchers evaluated by their parent: they are not evaluated again
+	ch = buffered()
+	res := be.Check(ch, be.All(be.Receive(5)))
+	be.Expect(t, res.Success).To(be.False())
+	be.Expect(t, res.Message).To(be.ContainSubstring("Expected 1 to equal 5"))
+	be.Expect(t, len(ch)).To(be.Eq(1))
+}
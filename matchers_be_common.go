@@ -2,45 +2,44 @@ package be
 
 // matchers_be_common.go provides the everyday matchers that idiomatic Go unit
 // tests reach for most — nil, booleans, errors, panics and collection membership.
-// They wrap gomega's battle-tested implementations via Psi, exactly like Eq/Not.
+// They are native ports of gomega's matchers (same semantics and failure messages),
+// so the core doesn't depend on gomega.
 
 import (
 	"errors"
 	"fmt"
-	"maps"
 	"reflect"
-	"slices"
-
-	"github.com/onsi/gomega"
 
 	. "github.com/expectto/be/internal/psi" //nolint:staticcheck // dot-import is the established style here
+	"github.com/expectto/be/internal/psi_matchers"
+	"github.com/expectto/be/options"
 	"github.com/expectto/be/types"
 )
 
 // Nil succeeds if actual is nil. It is typed-nil aware (a nil *T inside an
 // interface matches), unlike a bare `== nil` comparison.
-func Nil() types.BeMatcher { return Psi(gomega.BeNil()) }
+func Nil() types.BeMatcher { return psi_matchers.NewNilMatcher() }
 
 // NotNil succeeds if actual is not nil:
 //
 //	be.Expect(t, user).To(be.NotNil())
 //
 // Prefer this over be.Not(be.Nil()).
-func NotNil() types.BeMatcher { return Psi(gomega.Not(gomega.BeNil())) }
+func NotNil() types.BeMatcher { return Not(Nil()) }
 
 // True succeeds if actual is the boolean true.
-func True() types.BeMatcher { return Psi(gomega.BeTrue()) }
+func True() types.BeMatcher { return psi_matchers.NewBoolMatcher(true) }
 
 // False succeeds if actual is the boolean false.
-func False() types.BeMatcher { return Psi(gomega.BeFalse()) }
+func False() types.BeMatcher { return psi_matchers.NewBoolMatcher(false) }
 
 // Succeed succeeds if actual is a nil error. Intended for error values:
 //
 //	be.Expect(t, err).To(be.Succeed())
-func Succeed() types.BeMatcher { return Psi(gomega.Succeed()) }
+func Succeed() types.BeMatcher { return psi_matchers.NewSucceedMatcher() }
 
 // HaveOccurred succeeds if actual is a non-nil error.
-func HaveOccurred() types.BeMatcher { return Psi(gomega.HaveOccurred()) }
+func HaveOccurred() types.BeMatcher { return Not(Succeed()) }
 
 // MatchError succeeds if actual is an error matching expected. It is tri-mode —
 // expected may be:
@@ -54,19 +53,38 @@ func HaveOccurred() types.BeMatcher { return Psi(gomega.HaveOccurred()) }
 // Prefer be.MatchError(target) over be.True(errors.Is(err, target)), and
 // be.Not(be.MatchError(target)) over be.False(errors.Is(err, target)).
 // To match by error TYPE (errors.As), use MatchErrorAs.
-func MatchError(expected any) types.BeMatcher { return Psi(gomega.MatchError(expected)) }
+func MatchError(expected any) types.BeMatcher { return psi_matchers.NewMatchErrorMatcher(expected) }
 
 // MatchErrorAs succeeds if actual is an error that matches type T via
 // errors.As — the matcher spelling of `var target T; errors.As(err, &target)`:
 //
 //	be.Expect(t, err).To(be.MatchErrorAs[*fs.PathError]())
 //
-// Prefer this over projecting through errors.As into be.True() — but only when
-// the target goes unused afterward: unlike errors.As, the matcher does NOT
-// bind the concrete error value, so keep errors.As when you need target later.
+// Prefer this over projecting through errors.As into be.True(). When the
+// target is needed afterward, use MatchErrorInto.
 // A nil or non-matching error fails; a non-error actual is an error (not a
 // mismatch). To match by errors.Is target, message or matcher, use MatchError.
 func MatchErrorAs[T error]() types.BeMatcher {
+	return matchErrorAs[T]("MatchErrorAs", nil)
+}
+
+// MatchErrorInto is MatchErrorAs binding the matched error: on success the
+// errors.As target is stored into target, as `errors.As(err, target)` does:
+//
+//	var pathErr *fs.PathError
+//	be.Expect(t, err).To(be.MatchErrorInto(&pathErr))
+//	be.Expect(t, pathErr.Op).To(be.Eq("open"))
+//
+// On failure target is left untouched.
+func MatchErrorInto[T error](target *T) types.BeMatcher {
+	if target == nil {
+		panic("MatchErrorInto expects a non-nil target pointer")
+	}
+	return matchErrorAs("MatchErrorInto", func(matched T) { *target = matched })
+}
+
+// matchErrorAs matches errors via errors.As into a T, handing the match over to bind (if given)
+func matchErrorAs[T error](name string, bind func(T)) types.BeMatcher {
 	typeName := reflect.TypeFor[T]().String()
 	return Psi(func(actual any) (bool, error) {
 		if actual == nil {
@@ -74,20 +92,59 @@ func MatchErrorAs[T error]() types.BeMatcher {
 		}
 		err, ok := actual.(error)
 		if !ok {
-			return false, fmt.Errorf("MatchErrorAs matcher expects an error, got %T", actual)
+			return false, fmt.Errorf("%s matcher expects an error, got %T", name, actual)
 		}
 		var target T
-		return errors.As(err, &target), nil
+		if !errors.As(err, &target) {
+			return false, nil
+		}
+		if bind != nil {
+			bind(target)
+		}
+		return true, nil
 	}, fmt.Sprintf("match error as %s (via errors.As)", typeName))
 }
 
 // Panic succeeds if actual is a func() that panics when invoked.
-func Panic() types.BeMatcher { return Psi(gomega.Panic()) }
+func Panic() types.BeMatcher { return psi_matchers.NewPanicMatcher() }
 
 // NotPanic succeeds if actual is a func() that does not panic when invoked.
-func NotPanic() types.BeMatcher { return Psi(gomega.Not(gomega.Panic())) }
+func NotPanic() types.BeMatcher { return Not(Panic()) }
+
+// PanicWith succeeds if actual is a func() that panics with a value matching
+// the given value or matcher:
+//
+//	be.Expect(t, func() { mustPositive(-1) }).To(be.PanicWith(ErrNegative))
+//
+// The failure shows the recovered value and the stack of the panic.
+func PanicWith(value any) types.BeMatcher {
+	return psi_matchers.NewPanicWithMatcher(psi_matchers.PanicModeValue, value)
+}
+
+// PanicWithError succeeds if actual is a func() that panics with an error
+// matching expected, which may be:
+//   - a target error, compared with errors.Is:
+//     be.Expect(t, fn).To(be.PanicWithError(ErrInvariant))
+//   - a string, compared against err.Error(),
+//   - a matcher, applied to the error itself:
+//     be.Expect(t, fn).To(be.PanicWithError(be.MatchErrorAs[*InvariantError]()))
+//
+// Panicking with anything but an error is a failure.
+func PanicWithError(expected any) types.BeMatcher {
+	return psi_matchers.NewPanicWithMatcher(psi_matchers.PanicModeError, expected)
+}
+
+// PanicWithMessage succeeds if actual is a func() that panics with a message
+// matching the given string or matcher. The message of an error is err.Error(),
+// of a fmt.Stringer its String(), any other value is formatted with fmt.Sprint:
+//
+//	be.Expect(t, func() { q.Pop() }).To(be.PanicWithMessage("pop from an empty queue"))
+//	be.Expect(t, fn).To(be.PanicWithMessage(be.ContainSubstring("invariant")))
+func PanicWithMessage(expected any) types.BeMatcher {
+	return psi_matchers.NewPanicWithMatcher(psi_matchers.PanicModeMessage, expected)
+}
 
-// ContainElement succeeds if actual (a slice, array or map) contains an element
+// ContainElement succeeds if actual (a slice, array, map or iterator) contains an element
 // that matches the given value or matcher:
 //
 //	be.Expect(t, ids).To(be.ContainElement(42))
@@ -95,24 +152,198 @@ func NotPanic() types.BeMatcher { return Psi(gomega.Not(gomega.Panic())) }
 //
 // Prefer this over be.True(slices.Contains(xs, v)) — the failure message shows
 // the collection. For substrings of a string use ContainSubstring.
-func ContainElement(element any) types.BeMatcher { return Psi(gomega.ContainElement(element)) }
+func ContainElement(element any) types.BeMatcher {
+	return psi_matchers.NewContainElementMatcher(element)
+}
 
 // ContainElements succeeds if actual contains all of the given elements (each may
-// be a value or a matcher), in any order.
+// be a value or a matcher), in any order. A single slice given is taken as the list of elements:
+//
+//	be.Expect(t, ids).To(be.ContainElements(wantIDs))
 func ContainElements(elements ...any) types.BeMatcher {
-	return Psi(gomega.ContainElements(elements...))
+	return psi_matchers.NewContainElementsMatcher(elements...)
+}
+
+// ConsistOf succeeds if actual (a slice, array, map or iterator) consists of exactly the
+// given elements (each may be a value or a matcher), in any order: every element
+// is matched by a distinct item and there are no other items. A single slice
+// given is taken as the list of elements:
+//
+//	be.Expect(t, tags).To(be.ConsistOf("b", "a"))
+//	be.Expect(t, ids).To(be.ConsistOf(wantIDs))
+//
+// The failure lists the missing elements and the extra items.
+// When the order matters use HaveExactElements.
+func ConsistOf(elements ...any) types.BeMatcher {
+	return psi_matchers.NewConsistOfMatcher(elements...)
 }
 
-// HaveKey succeeds if actual (a map) has a key matching the given value or matcher:
+// HaveExactElements succeeds if actual (a slice, array or iterator) has exactly the given
+// elements (each may be a value or a matcher), in this order:
+//
+//	be.Expect(t, users).To(be.HaveExactElements(
+//		be.HaveField("Name", "Alice"),
+//		be.HaveField("Name", "Bob"),
+//	))
+//
+// The failure lists the mismatched positions along with their mismatch,
+// the missing elements and the extra items.
+func HaveExactElements(elements ...any) types.BeMatcher {
+	return psi_matchers.NewSequenceMatcher(psi_matchers.SequenceModeExact, elements...)
+}
+
+// HaveSubsequence succeeds if actual (a slice, array or iterator) contains the given
+// elements (each may be a value or a matcher) in this order, not necessarily
+// next to each other. The failure shows where the elements were found
+// and the first one that wasn't.
+func HaveSubsequence(elements ...any) types.BeMatcher {
+	return psi_matchers.NewSequenceMatcher(psi_matchers.SequenceModeSubsequence, elements...)
+}
+
+// StartWith succeeds if the leading items of actual (a slice, array or iterator) match
+// the given elements (each may be a value or a matcher), in this order.
+// For strings use be_string.HavingPrefix.
+func StartWith(elements ...any) types.BeMatcher {
+	return psi_matchers.NewSequenceMatcher(psi_matchers.SequenceModePrefix, elements...)
+}
+
+// EndWith succeeds if the trailing items of actual (a slice, array or iterator) match
+// the given elements (each may be a value or a matcher), in this order.
+// For strings use be_string.HavingSuffix.
+func EndWith(elements ...any) types.BeMatcher {
+	return psi_matchers.NewSequenceMatcher(psi_matchers.SequenceModeSuffix, elements...)
+}
+
+// SortOrder is the order Sorted and SortedByField expect: Asc (the default) or Desc
+type SortOrder = options.SortOrder
+
+const (
+	Asc  = options.Asc
+	Desc = options.Desc
+)
+
+// Sorted succeeds if the items of actual (a slice, array or iterator) are sorted,
+// ascending unless Desc is given. Equal neighbours are fine. Numbers and strings
+// are compared by their value, other types by their Compare method (e.g. time.Time):
+//
+//	be.Expect(t, scores).To(be.Sorted(be.Desc))
+//
+// Prefer this over be.True(slices.IsSorted(xs)) — the failure points at the first
+// pair of items that is out of order.
+func Sorted(order ...SortOrder) types.BeMatcher {
+	return psi_matchers.NewSortedMatcher(sortOrder(order))
+}
+
+// SortedBy succeeds if the items of actual (a slice, array or iterator) are sorted
+// according to the given comparison (as in slices.SortFunc):
+//
+//	be.Expect(t, users).To(be.SortedBy(func(a, b User) int { return cmp.Compare(a.Age, b.Age) }))
+//
+// An item that is not a T is an error.
+func SortedBy[T any](cmp func(a, b T) int) types.BeMatcher {
+	return psi_matchers.NewSortedByMatcher(func(a, b any) (int, error) {
+		ta, ok := a.(T)
+		if !ok {
+			return 0, fmt.Errorf("SortedBy expects items of type %s, got %T", reflect.TypeFor[T](), a)
+		}
+		tb, ok := b.(T)
+		if !ok {
+			return 0, fmt.Errorf("SortedBy expects items of type %s, got %T", reflect.TypeFor[T](), b)
+		}
+		return cmp(ta, tb), nil
+	})
+}
+
+// SortedByField succeeds if the items of actual (a slice, array or iterator of structs)
+// are sorted by the given field (a name, a "Method()" or a dotted path, as in HaveField),
+// ascending unless Desc is given:
+//
+//	be.Expect(t, events).To(be.SortedByField("CreatedAt", be.Desc))
+func SortedByField(field string, order ...SortOrder) types.BeMatcher {
+	return psi_matchers.NewSortedByFieldMatcher(field, sortOrder(order))
+}
+
+func sortOrder(order []SortOrder) SortOrder {
+	if len(order) == 0 {
+		return Asc
+	}
+	return order[0]
+}
+
+// Unique succeeds if no two items of actual (a slice, array or iterator) are equal.
+// The failure points at the first duplicate along with the item it duplicates.
+func Unique() types.BeMatcher { return psi_matchers.NewUniqueMatcher() }
+
+// UniqueBy succeeds if no two items of actual (a slice, array or iterator) share
+// the same key:
+//
+//	be.Expect(t, users).To(be.UniqueBy(func(u User) string { return u.Email }))
+//
+// An item that is not a T is an error.
+func UniqueBy[T, K any](key func(T) K) types.BeMatcher {
+	return psi_matchers.NewUniqueByMatcher(func(item any) (any, error) {
+		t, ok := item.(T)
+		if !ok {
+			return nil, fmt.Errorf("UniqueBy expects items of type %s, got %T", reflect.TypeFor[T](), item)
+		}
+		return key(t), nil
+	})
+}
+
+// HaveKey succeeds if actual (a map or an iter.Seq2) has a key matching the given
+// value or matcher:
 //
 //	be.Expect(t, headers).To(be.HaveKey("Authorization"))
 //
 // Prefer this over `_, ok := m[k]` followed by be.True(ok).
-func HaveKey(key any) types.BeMatcher { return Psi(gomega.HaveKey(key)) }
+func HaveKey(key any) types.BeMatcher { return psi_matchers.NewHaveKeyValueMatcher(key) }
 
-// HaveKeyWithValue succeeds if actual (a map) has the given key with a matching value.
+// HaveKeyWithValue succeeds if actual (a map or an iter.Seq2) has the given key
+// with a matching value.
 func HaveKeyWithValue(key, value any) types.BeMatcher {
-	return Psi(gomega.HaveKeyWithValue(key, value))
+	return psi_matchers.NewHaveKeyValueMatcher(key, value)
+}
+
+// MapContaining succeeds if actual (a map) has all the given keys, each one with
+// a value matching the given value or matcher. Other keys are ignored:
+//
+//	be.Expect(t, claims).To(be.MapContaining(map[string]any{
+//		"sub":   "alice",
+//		"roles": be.ContainElement("admin"),
+//	}))
+//
+// All the differences are reported at once, in sorted-key order: a missing key,
+// raw values as a diff, matchers by their own failure message:
+//
+//	Expected map[string]interface {} to contain map entries ["roles", "sub"], differences (- expected, + actual):
+//	  - ["sub"]: "alice"
+//	  + ["sub"]: "bob"
+//
+// It works for a decoded JSON object (a map[string]any) as well.
+func MapContaining[K comparable](expected map[K]any) types.BeMatcher {
+	return psi_matchers.NewMapMatcher(psi_matchers.MapModeContaining, anyKeys(expected))
+}
+
+// MapExactly is MapContaining with every key of actual accounted for:
+// a key that's not given is a failure (reported as `+ ["key"]: value`).
+func MapExactly[K comparable](expected map[K]any) types.BeMatcher {
+	return psi_matchers.NewMapMatcher(psi_matchers.MapModeExactly, anyKeys(expected))
+}
+
+// HaveOnlyKeys succeeds if every key of actual (a map) is one of the given keys
+// (values or matchers). The given keys don't have to be there:
+//
+//	be.Expect(t, payload).To(be.HaveOnlyKeys("id", "name", be_string.HavingPrefix("x-")))
+func HaveOnlyKeys(keys ...any) types.BeMatcher {
+	return psi_matchers.NewHaveOnlyKeysMatcher(keys...)
+}
+
+func anyKeys[K comparable](m map[K]any) map[any]any {
+	converted := make(map[any]any, len(m))
+	for k, v := range m {
+		converted[k] = v
+	}
+	return converted
 }
 
 // Ne succeeds if actual is NOT equal to expected (the negation of Eq):
@@ -121,7 +352,7 @@ func HaveKeyWithValue(key, value any) types.BeMatcher {
 //
 // Prefer this over be.Not(be.Eq(x)). To assert "not the zero value" use
 // be.NonZero() instead of be.Ne(0).
-func Ne(expected any) types.BeMatcher { return Psi(gomega.Not(gomega.Equal(expected))) }
+func Ne(expected any) types.BeMatcher { return Not(Eq(expected)) }
 
 // Zero succeeds if actual is the zero value for its type: 0, "", nil, false,
 // a zero struct, etc. (reflect-based, works for any type — like gomega.BeZero):
@@ -131,34 +362,34 @@ func Ne(expected any) types.BeMatcher { return Psi(gomega.Not(gomega.Equal(expec
 //
 // Prefer this over be.Eq(0) when you mean "unset". For the numeric-only
 // spelling (where a non-number is an error, not a mismatch) use be_math.Zero.
-func Zero() types.BeMatcher { return Psi(gomega.BeZero()) }
+func Zero() types.BeMatcher { return psi_matchers.NewZeroMatcher() }
 
 // NonZero succeeds if actual is NOT the zero value for its type:
 //
 //	be.Expect(t, userID).To(be.NonZero())
 //
 // Prefer this over be.Not(be.Eq(0)) or be.Ne(0).
-func NonZero() types.BeMatcher { return Psi(gomega.Not(gomega.BeZero())) }
+func NonZero() types.BeMatcher { return Not(Zero()) }
 
 // Empty succeeds if actual is empty: a zero-length string, slice, array, map or
-// channel (like gomega.BeEmpty):
+// channel (like gomega.BeEmpty), or an iterator yielding nothing:
 //
 //	be.Expect(t, errsList).To(be.Empty())
 //
 // Prefer this over be.HaveLength(0) or be.True(len(xs) == 0).
-func Empty() types.BeMatcher { return Psi(gomega.BeEmpty()) }
+func Empty() types.BeMatcher { return psi_matchers.NewEmptyMatcher() }
 
 // NotEmpty succeeds if actual is not empty:
 //
 //	be.Expect(t, results).To(be.NotEmpty())
 //
 // Prefer this over be.Not(be.HaveLength(0)) or be.True(len(xs) > 0).
-func NotEmpty() types.BeMatcher { return Psi(gomega.Not(gomega.BeEmpty())) }
+func NotEmpty() types.BeMatcher { return Not(Empty()) }
 
 // ContainSubstring succeeds if actual is a string containing the given substring.
 // (For slices/arrays/maps use ContainElement.)
 func ContainSubstring(substr string) types.BeMatcher {
-	return Psi(gomega.ContainSubstring(substr))
+	return psi_matchers.NewContainSubstringMatcher(substr)
 }
 
 // Identical succeeds if actual is identical to expected using Go's == operator
@@ -169,12 +400,12 @@ func ContainSubstring(substr string) types.BeMatcher {
 // Footgun to know: be.Eq compares by VALUE (deep equality) — two different
 // pointers to equal structs satisfy be.Eq but not be.Identical. Use Identical
 // when "the same instance" is what you mean.
-func Identical(expected any) types.BeMatcher { return Psi(gomega.BeIdenticalTo(expected)) }
+func Identical(expected any) types.BeMatcher { return psi_matchers.NewIdenticalMatcher(expected) }
 
 // NotIdentical succeeds if actual is NOT identical to expected (the negation of
 // Identical). Like testify's NotSame.
 func NotIdentical(expected any) types.BeMatcher {
-	return Psi(gomega.Not(gomega.BeIdenticalTo(expected)))
+	return Not(Identical(expected))
 }
 
 // HaveField succeeds if actual is a struct (or pointer to one) whose field —
@@ -189,7 +420,7 @@ func NotIdentical(expected any) types.BeMatcher {
 // be_struct.HavingField is deliberate: HavingField[T] is the generic,
 // compile-time-checked variant for when you want the struct type enforced.
 func HaveField(field string, value any) types.BeMatcher {
-	return Psi(gomega.HaveField(field, Psi(value)))
+	return psi_matchers.NewHaveFieldsMatcher(map[string]any{field: value})
 }
 
 // HaveFields succeeds if actual matches HaveField for every entry of the given
@@ -200,14 +431,15 @@ func HaveField(field string, value any) types.BeMatcher {
 //		"Email": be_string.ValidEmail(),
 //	}))
 //
-// Fields are checked in sorted-key order, so failure output is deterministic.
+// All mismatching fields are reported at once, in sorted-key order: raw values as
+// a field-level diff, matchers by their own failure message:
+//
+//	Expected main.User to have fields, differences (- expected, + actual):
+//	  - .Address.City: "Berlin"
+//	  + .Address.City: "Bonn"
+//	    .Email: Expected "bob" to be a valid email
 func HaveFields(fields map[string]any) types.BeMatcher {
-	keys := slices.Sorted(maps.Keys(fields))
-	ms := make([]any, len(keys))
-	for i, k := range keys {
-		ms[i] = HaveField(k, fields[k])
-	}
-	return All(ms...)
+	return psi_matchers.NewHaveFieldsMatcher(fields)
 }
 
 // Via applies the transform function to the actual value and matches the result
@@ -219,5 +451,8 @@ func HaveFields(fields map[string]any) types.BeMatcher {
 // transform must be a function of one argument returning one value (and
 // optionally an error).
 func Via(transform, matcher any) types.BeMatcher {
-	return Psi(gomega.WithTransform(transform, Psi(matcher)))
+	if !IsTransformFunc(transform) {
+		panic("be.Via expects a function of one argument returning one value (and optionally an error)")
+	}
+	return WithFallibleTransform(transform, Psi(matcher))
 }
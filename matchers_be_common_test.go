@@ -1,10 +1,17 @@
 package be_test
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"iter"
+	"maps"
+	"slices"
 	"strings"
 	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
 
 	"github.com/expectto/be"
 )
@@ -41,15 +48,100 @@ func TestPanicMatchers(t *testing.T) {
 	be.Expect(t, func() {}).To(be.NotPanic())
 }
 
+type invariantError struct{ what string }
+
+func (e *invariantError) Error() string { return "invariant violated: " + e.what }
+
+func TestPanicWithMatchers(t *testing.T) {
+	errInvariant := errors.New("invariant violated")
+	wrapped := func() { panic(fmt.Errorf("pop: %w", errInvariant)) }
+	typed := func() { panic(&invariantError{what: "empty queue"}) }
+
+	be.Expect(t, func() { panic(42) }).To(be.PanicWith(42))
+	be.Expect(t, func() { panic(42) }).To(be.PanicWith(be.Gt(40)))
+	be.Expect(t, wrapped).To(be.PanicWithError(errInvariant))
+	be.Expect(t, typed).To(be.PanicWithError(be.MatchErrorAs[*invariantError]()))
+	be.Expect(t, typed).To(be.PanicWithMessage("invariant violated: empty queue"))
+	be.Expect(t, func() { panic("kaboom") }).To(be.PanicWithMessage(be.ContainSubstring("boom")))
+	be.Expect(t, func() { panic("kaboom") }).NotTo(be.PanicWithError(errInvariant))
+	be.Expect(t, func() {}).NotTo(be.PanicWith(42))
+
+	rt := &recT{}
+	be.Expect(rt, func() { mustPositive(-1) }).To(be.PanicWithMessage("must be positive"))
+	if len(rt.errs) != 1 {
+		t.Fatalf("expected exactly one failure, got %v", rt.errs)
+	}
+	for _, want := range []string{"panicked with", "must be positive, got -1", "Panic stack:", "mustPositive("} {
+		if !strings.Contains(rt.errs[0], want) {
+			t.Fatalf("expected the failure to contain %q, got %q", want, rt.errs[0])
+		}
+	}
+}
+
+func mustPositive(n int) {
+	if n <= 0 {
+		panic(fmt.Sprintf("must be positive, got %d", n))
+	}
+}
+
 func TestCollectionMatchers(t *testing.T) {
 	be.Expect(t, []int{1, 2, 3}).To(be.ContainElement(2))
 	be.Expect(t, []int{1, 2, 3}).To(be.ContainElements(3, 1))
+	be.Expect(t, []int{1, 2, 3}).To(be.ContainElements([]int{1, 2}))
+	be.Expect(t, []int{1, 2, 3}).NotTo(be.ContainElements([]int{1, 4}))
+	be.Expect(t, [][]int{{1, 2}, {3}}).To(be.ContainElement([]int{1, 2}))
 	be.Expect(t, []int{1, 2, 3}).NotTo(be.ContainElement(9))
 
 	m := map[string]int{"a": 1, "b": 2}
 	be.Expect(t, m).To(be.HaveKey("a"))
 	be.Expect(t, m).To(be.HaveKeyWithValue("b", 2))
 	be.Expect(t, m).NotTo(be.HaveKey("z"))
+
+	// keys may be matchers too: any matching key may hold the value
+	be.Expect(t, m).To(be.HaveKey(be.ContainSubstring("b")))
+	be.Expect(t, m).To(be.HaveKeyWithValue(be.Ne("a"), 2))
+	be.Expect(t, m).NotTo(be.HaveKeyWithValue(be.Ne("b"), 2))
+}
+
+func TestOrderedAndExactCollectionMatchers(t *testing.T) {
+	type user struct{ Name string }
+	users := []user{{"Alice"}, {"Bob"}, {"Carol"}}
+
+	be.Expect(t, users).To(be.HaveExactElements(
+		be.HaveField("Name", "Alice"),
+		be.HaveField("Name", "Bob"),
+		user{"Carol"},
+	))
+	be.Expect(t, users).NotTo(be.HaveExactElements(user{"Alice"}, user{"Bob"}))
+	be.Expect(t, []int{3, 1, 2}).To(be.ConsistOf(1, 2, 3))
+	be.Expect(t, []int{3, 1, 2}).To(be.ConsistOf([]int{1, 2, 3}))
+	be.Expect(t, []int{3, 1, 2, 2}).NotTo(be.ConsistOf(1, 2, 3))
+	be.Expect(t, []int{1, 9, 2, 3}).To(be.HaveSubsequence(1, 2, 3))
+	be.Expect(t, []int{1, 2, 3}).To(be.StartWith(1, be.Gt(1)))
+	be.Expect(t, []int{1, 2, 3}).To(be.EndWith(2, 3))
+	be.Expect(t, []int{1, 2, 3}).NotTo(be.EndWith(1, 2))
+
+	rt := &recT{}
+	be.Expect(rt, users).To(be.HaveExactElements(user{"Alice"}, user{"Eve"}))
+	if len(rt.errs) != 1 ||
+		!strings.Contains(rt.errs[0], "[1]: ") ||
+		!strings.Contains(rt.errs[0], "[2]: extra element {Name: \"Carol\"}") {
+		t.Fatalf("expected mismatched and extra positions to be listed, got %q", rt.errs)
+	}
+}
+
+func TestCollectionMatchersFailureMessages(t *testing.T) {
+	rt := &recT{}
+	be.Expect(rt, []int{1, 2}).To(be.ContainElements(1, 4))
+	if len(rt.errs) != 1 || !strings.Contains(rt.errs[0], "missing element 4") {
+		t.Fatalf("expected the missing elements to be reported, got %q", rt.errs)
+	}
+
+	rt = &recT{}
+	be.Expect(rt, map[string]int{"a": 1}).To(be.HaveKeyWithValue("a", 2))
+	if len(rt.errs) != 1 || !strings.Contains(rt.errs[0], `at ["a"]:`) {
+		t.Fatalf("expected the value mismatch to point at the key, got %q", rt.errs)
+	}
 }
 
 func TestEmptyAndNe(t *testing.T) {
@@ -93,6 +185,62 @@ func TestMatchErrorAs(t *testing.T) {
 	}
 }
 
+func TestMatchErrorInto(t *testing.T) {
+	sentinel := &pathError{path: "/etc/passwd"}
+
+	var target *pathError
+	be.Expect(t, fmt.Errorf("opening: %w", sentinel)).To(be.MatchErrorInto(&target))
+	if target != sentinel {
+		t.Fatalf("target should be bound to the matched error, got %v", target)
+	}
+
+	// a failed match leaves the target untouched
+	rt := &recT{}
+	be.Expect(rt, errors.New("plain")).To(be.MatchErrorInto(&target))
+	if len(rt.errs) != 1 || target != sentinel {
+		t.Fatalf("expected a failure keeping the target, got %v (target %v)", rt.errs, target)
+	}
+}
+
+func TestCapture(t *testing.T) {
+	var n int
+	be.Expect(t, 42).To(be.Capture(&n, be.Gt(10)))
+	be.Expect(t, n).To(be.Eq(42))
+
+	// a failed match leaves dst untouched
+	rt := &recT{}
+	be.Expect(rt, 5).To(be.Capture(&n, be.Gt(10)))
+	if len(rt.errs) != 1 || n != 42 {
+		t.Fatalf("expected a failure keeping dst, got %v (dst %d)", rt.errs, n)
+	}
+
+	// with no matcher any assignable value is captured, including nil
+	var err error = errors.New("boom")
+	be.Expect(t, nil).To(be.Capture(&err))
+	be.Expect(t, err).To(be.Nil())
+
+	// a value not assignable to dst is an error, not a mismatch
+	rt = &recT{}
+	be.Expect(rt, "42").To(be.Capture(&n))
+	if len(rt.errs) != 1 || !strings.Contains(rt.errs[0], "assignable to int") {
+		t.Fatalf("non-assignable actual should produce a clear error, got: %v", rt.errs)
+	}
+}
+
+func TestCaptureAsGomockMatcher(t *testing.T) {
+	var got string
+	var m gomock.Matcher = be.Capture(&got, be.ContainSubstring("user-"))
+	if m.Matches("order-1") || got != "" {
+		t.Fatalf("mismatching argument must not be captured, got %q", got)
+	}
+	if !m.Matches("user-7") || got != "user-7" {
+		t.Fatalf("matching argument should be captured, got %q", got)
+	}
+	if m.String() != be.ContainSubstring("user-").String() {
+		t.Fatalf("capture should describe itself as the wrapped matcher, got %q", m.String())
+	}
+}
+
 type pathError struct{ path string }
 
 func (e *pathError) Error() string { return "path error: " + e.path }
@@ -139,18 +287,54 @@ func TestHaveFields(t *testing.T) {
 		"Age":  be.Gt(50),
 	}))
 
-	// deterministic failure output: with two mismatching fields, the first
-	// (sorted) key is always the one reported
+	// every mismatching field is reported, in sorted-key order
+	want := "Expected be_test.user to have fields, differences (- expected, + actual):\n" +
+		"  - .Age: 99\n" +
+		"  + .Age: 30\n" +
+		"  - .Name: \"Bob\"\n" +
+		"  + .Name: \"Alice\""
 	for range 10 {
 		rt := &recT{}
 		be.Expect(rt, u).To(be.HaveFields(map[string]any{
 			"Name": "Bob",
 			"Age":  99,
 		}))
-		if len(rt.errs) != 1 || !strings.Contains(rt.errs[0], "Age") {
-			t.Fatalf("sorted-key order should make Age fail first, got: %v", rt.errs)
+		if len(rt.errs) != 1 || rt.errs[0] != want {
+			t.Fatalf("unexpected failure output: %q", rt.errs)
 		}
 	}
+
+	// matcher values are reported by their own failure message
+	rt := &recT{}
+	be.Expect(rt, u).To(be.HaveFields(map[string]any{"Age": be.Gt(50)}))
+	if len(rt.errs) != 1 || !strings.Contains(rt.errs[0], "    .Age: Expected 30 to be > 50") {
+		t.Fatalf("matcher field should report its failure, got: %q", rt.errs)
+	}
+}
+
+func TestEqReportsFieldDiff(t *testing.T) {
+	type address struct{ City, Zip string }
+	type user struct {
+		Name    string
+		Address address
+	}
+
+	rt := &recT{}
+	be.Expect(rt, user{Name: "Alice", Address: address{City: "Bonn", Zip: "53111"}}).
+		To(be.Eq(user{Name: "Alice", Address: address{City: "Berlin", Zip: "53111"}}))
+	want := "Expected be_test.user to equal, differences (- expected, + actual):\n" +
+		"  - .Address.City: \"Berlin\"\n" +
+		"  + .Address.City: \"Bonn\""
+	if len(rt.errs) != 1 || rt.errs[0] != want {
+		t.Fatalf("unexpected failure output: %q", rt.errs)
+	}
+
+	// scalars keep the compact one-liner
+	rt = &recT{}
+	be.Expect(rt, 3).To(be.Eq(5))
+	if len(rt.errs) != 1 || rt.errs[0] != "Expected 3 to equal 5" {
+		t.Fatalf("scalar failure should stay compact, got: %q", rt.errs)
+	}
 }
 
 func TestHaveLengthComposable(t *testing.T) {
@@ -173,3 +357,112 @@ func TestIdenticalAndVia(t *testing.T) {
 	be.Expect(t, box{n: 42}).To(be.Via(get, be.Eq(42)))
 	be.Expect(t, box{n: 42}).NotTo(be.Via(get, be.Eq(0)))
 }
+
+func TestSortedAndUnique(t *testing.T) {
+	type event struct {
+		Name      string
+		CreatedAt time.Time
+	}
+	now := time.Now()
+	events := []event{{"c", now}, {"b", now.Add(-time.Hour)}, {"a", now.Add(-2 * time.Hour)}}
+
+	be.Expect(t, []int{1, 2, 2, 3}).To(be.Sorted())
+	be.Expect(t, slices.Values([]string{"c", "b"})).To(be.Sorted(be.Desc))
+	be.Expect(t, events).To(be.SortedByField("CreatedAt", be.Desc))
+	be.Expect(t, events).NotTo(be.SortedByField("CreatedAt"))
+	be.Expect(t, events).To(be.SortedBy(func(a, b event) int { return strings.Compare(b.Name, a.Name) }))
+
+	be.Expect(t, []int{1, 2, 3}).To(be.Unique())
+	be.Expect(t, events).To(be.UniqueBy(func(e event) string { return e.Name }))
+	be.Expect(t, []string{"a", "b", "a"}).NotTo(be.Unique())
+
+	rt := &recT{}
+	be.Expect(rt, []int{1, 7, 5}).To(be.Sorted())
+	if len(rt.errs) != 1 || !strings.Contains(rt.errs[0], "[1] and [2] are not") {
+		t.Fatalf("expected the failure to point at the pair out of order, got %v", rt.errs)
+	}
+
+	// items of another type than the comparison expects are an error
+	rt = &recT{}
+	be.Expect(rt, []int{1, 2}).To(be.SortedBy(strings.Compare))
+	if len(rt.errs) != 1 || !strings.Contains(rt.errs[0], "SortedBy expects items of type string, got int") {
+		t.Fatalf("expected a type error, got %v", rt.errs)
+	}
+}
+
+func TestCollectionMatchersOnIterators(t *testing.T) {
+	ids := slices.Values([]int{1, 2, 3})
+	be.Expect(t, ids).To(be.HaveLength(3))
+	be.Expect(t, ids).To(be.NotEmpty())
+	be.Expect(t, ids).To(be.ContainElement(2))
+	be.Expect(t, ids).To(be.HaveExactElements(1, 2, 3))
+	be.Expect(t, ids).To(be.Dive(be.Gt(0)))
+	be.Expect(t, slices.Values([]int{})).To(be.Empty())
+
+	headers := maps.All(map[string]string{"Accept": "*/*"})
+	be.Expect(t, headers).To(be.HaveKey("Accept"))
+	be.Expect(t, headers).To(be.HaveKeyWithValue("Accept", "*/*"))
+	be.Expect(t, headers).NotTo(be.HaveKey("Host"))
+	be.Expect(t, headers).To(be.DiveKeys(be.Ne("")))
+
+	// a failure shows what the iterator yielded
+	rt := &recT{}
+	be.Expect(rt, ids).To(be.ContainElement(4))
+	if len(rt.errs) != 1 || !strings.Contains(rt.errs[0], "[1, 2, 3]") {
+		t.Fatalf("expected the failure to show the yielded values, got %v", rt.errs)
+	}
+
+	// an iter.Seq2 is shown like a map
+	rt = &recT{}
+	be.Expect(rt, headers).To(be.HaveLength(2))
+	if len(rt.errs) != 1 || !strings.Contains(rt.errs[0], `<iter.Seq2>: {"Accept": "*/*"}`) {
+		t.Fatalf("expected the failure to show the yielded entries, got %v", rt.errs)
+	}
+}
+
+func TestSetSeqLimit(t *testing.T) {
+	defer be.SetSeqLimit(3)()
+
+	naturals := func(yield func(int) bool) {
+		for i := 0; yield(i); i++ {
+		}
+	}
+
+	rt := &recT{}
+	be.Expect(rt, iter.Seq[int](naturals)).To(be.ContainElement(-1))
+	if len(rt.errs) != 1 || !strings.Contains(rt.errs[0], "yielded more than 3 values") {
+		t.Fatalf("expected the infinite iterator to be an error, got %v", rt.errs)
+	}
+}
+
+func TestMapMatchers(t *testing.T) {
+	var claims map[string]any
+	if err := json.Unmarshal([]byte(`{"sub": "alice", "roles": ["admin"], "iat": 1700000000}`), &claims); err != nil {
+		t.Fatal(err)
+	}
+
+	be.Expect(t, claims).To(be.MapContaining(map[string]any{
+		"sub":   "alice",
+		"roles": be.ContainElement("admin"),
+	}))
+	be.Expect(t, claims).NotTo(be.MapExactly(map[string]any{"sub": "alice"}))
+	be.Expect(t, claims).To(be.HaveOnlyKeys("sub", "roles", "iat", "exp"))
+	be.Expect(t, map[string]int{"a": 1, "b": 2}).To(be.MapExactly(map[string]any{"a": 1, "b": be.Gt(1)}))
+
+	rt := &recT{}
+	be.Expect(rt, map[string]int{"a": 1, "c": 3, "d": 4}).To(be.MapExactly(map[string]any{"a": 2, "b": 2, "c": 3}))
+	want := `differences (- expected, + actual):
+  - ["a"]: 2
+  + ["a"]: 1
+  - ["b"]: 2
+  + ["d"]: 4`
+	if len(rt.errs) != 1 || !strings.Contains(rt.errs[0], want) {
+		t.Fatalf("expected missing, extra and mismatching keys in sorted order, got %v", rt.errs)
+	}
+
+	rt = &recT{}
+	be.Expect(rt, claims).To(be.MapContaining(map[string]any{"exp": be.Gt(1700000000)}))
+	if len(rt.errs) != 1 || !strings.Contains(rt.errs[0], `- ["exp"]: be > 1700000000`) {
+		t.Fatalf("a missing key should show the description of its matcher, got %v", rt.errs)
+	}
+}
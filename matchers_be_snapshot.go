@@ -0,0 +1,68 @@
+package be
+
+// matchers_be_snapshot.go provides snapshot (golden-file) matching: the actual
+// value is rendered to text and compared with a file committed next to the test.
+
+import (
+	"github.com/expectto/be/internal/psi"
+	"github.com/expectto/be/internal/psi_matchers"
+	"github.com/expectto/be/types"
+)
+
+// UpdateSnapshotsEnv is the env variable that switches MatchSnapshot into
+// update mode: `BE_UPDATE_SNAPSHOTS=1 go test ./...` (re)writes every snapshot
+// from the actual values instead of comparing against them. It's an env
+// variable rather than a test flag, as a flag would be rejected by the test
+// binaries of packages that don't import be.
+const UpdateSnapshotsEnv = psi_matchers.UpdateSnapshotsEnv
+
+// SnapshotOption configures MatchSnapshot.
+type SnapshotOption func(*psi_matchers.SnapshotMatcher)
+
+// SnapshotDir overrides where snapshots are stored (default
+// "testdata/__snapshots__", relative to the package under test).
+func SnapshotDir(dir string) SnapshotOption {
+	return func(m *psi_matchers.SnapshotMatcher) { m.Dir = dir }
+}
+
+// SnapshotMask replaces every value matching the matcher by the placeholder
+// before comparing, so volatile values don't break the snapshot:
+//
+//	be.MatchSnapshot("signup-response",
+//		be.SnapshotMask("<email>", be_string.ValidEmail()),
+//		be.SnapshotMask("<id>", be_string.UUID()),
+//	)
+//
+// For JSON documents the mask is applied to every leaf value (strings,
+// numbers, booleans); for plain text — to every whitespace-separated word.
+func SnapshotMask(placeholder string, matcher any) SnapshotOption {
+	return func(m *psi_matchers.SnapshotMatcher) {
+		m.Masks = append(m.Masks, psi_matchers.SnapshotMask{Placeholder: placeholder, Matcher: psi.Psi(matcher)})
+	}
+}
+
+// SnapshotNormalize sets a hook applied to the rendered text (after masks)
+// right before it's compared with or stored as a snapshot.
+func SnapshotNormalize(normalize func(string) string) SnapshotOption {
+	return func(m *psi_matchers.SnapshotMatcher) { m.Normalize = normalize }
+}
+
+// MatchSnapshot succeeds if actual, rendered to text, equals the snapshot
+// stored in testdata/__snapshots__/<name>.snap:
+//
+//	be.Expect(t, resp.Body).To(be.MatchSnapshot("get-user"))
+//
+// Actual may be a string or []byte (JSON documents are pretty-printed), an
+// io.Reader (its contents), an *http.Request (request line, sorted headers and body),
+// or any JSON-able value (rendered as indented JSON). A mismatch is reported as a unified diff.
+//
+// Run the tests with BE_UPDATE_SNAPSHOTS=1 to create or update snapshots; a
+// missing snapshot is an error otherwise. Volatile values can be masked via
+// SnapshotMask or SnapshotNormalize.
+func MatchSnapshot(name string, opts ...SnapshotOption) types.BeMatcher {
+	m := psi_matchers.NewSnapshotMatcher(name)
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
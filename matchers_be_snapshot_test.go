@@ -0,0 +1,130 @@
+package be_test
+
+import (
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/expectto/be"
+	"github.com/expectto/be/be_string"
+)
+
+func TestMatchSnapshotUpdateAndCompare(t *testing.T) {
+	dir := t.TempDir()
+	user := map[string]any{"name": "Alice", "email": "alice@example.com", "age": 30}
+
+	// update mode writes the snapshot
+	t.Setenv(be.UpdateSnapshotsEnv, "1")
+	be.Expect(t, user).To(be.MatchSnapshot("user", be.SnapshotDir(dir)))
+
+	contents, err := os.ReadFile(filepath.Join(dir, "user.snap"))
+	be.NoError(t, err)
+	be.Expect(t, string(contents)).To(be.Eq("{\n  \"age\": 30,\n  \"email\": \"alice@example.com\",\n  \"name\": \"Alice\"\n}\n"))
+
+	// compare mode matches the stored snapshot, whatever the input form
+	t.Setenv(be.UpdateSnapshotsEnv, "")
+	be.Expect(t, user).To(be.MatchSnapshot("user", be.SnapshotDir(dir)))
+	be.Expect(t, `{"name":"Alice","age":30,"email":"alice@example.com"}`).To(be.MatchSnapshot("user", be.SnapshotDir(dir)))
+
+	// a mismatch is shown as a unified diff
+	rt := &recT{}
+	user["name"] = "Bob"
+	be.Expect(rt, user).To(be.MatchSnapshot("user", be.SnapshotDir(dir)))
+	if len(rt.errs) != 1 || !strings.Contains(rt.errs[0], "-  \"name\": \"Alice\"\n+  \"name\": \"Bob\"") {
+		t.Fatalf("expected a unified diff, got %v", rt.errs)
+	}
+}
+
+func TestMatchSnapshotMissing(t *testing.T) {
+	t.Setenv(be.UpdateSnapshotsEnv, "")
+
+	rt := &recT{}
+	be.Expect(rt, "hello").To(be.MatchSnapshot("missing", be.SnapshotDir(t.TempDir())))
+	if len(rt.errs) != 1 || !strings.Contains(rt.errs[0], "BE_UPDATE_SNAPSHOTS=1") {
+		t.Fatalf("a missing snapshot should tell how to create it, got %v", rt.errs)
+	}
+}
+
+func TestMatchSnapshotMasksAndRequests(t *testing.T) {
+	dir := t.TempDir()
+	opts := []be.SnapshotOption{
+		be.SnapshotDir(dir),
+		be.SnapshotMask("<email>", be_string.ValidEmail()),
+	}
+
+	newRequest := func(email string) *http.Request {
+		req, _ := http.NewRequest(http.MethodPost, "https://example.com/users", strings.NewReader(
+			`{"email":"`+email+`"}`,
+		))
+		req.Header.Set("Content-Type", "application/json")
+		return req
+	}
+
+	t.Setenv(be.UpdateSnapshotsEnv, "1")
+	be.Expect(t, newRequest("alice@example.com")).To(be.MatchSnapshot("create user", opts...))
+
+	contents, err := os.ReadFile(filepath.Join(dir, "create_user.snap"))
+	be.NoError(t, err)
+	be.Expect(t, string(contents)).To(be.Eq(
+		"POST https://example.com/users HTTP/1.1\nContent-Type: application/json\n\n{\n  \"email\": \"<email>\"\n}\n",
+	))
+
+	// a different (masked) email still matches, and the body stays readable
+	t.Setenv(be.UpdateSnapshotsEnv, "")
+	req := newRequest("bob@example.com")
+	be.Expect(t, req).To(be.MatchSnapshot("create user", opts...))
+	body, err := io.ReadAll(req.Body)
+	be.NoError(t, err)
+	be.Expect(t, string(body)).To(be.ContainSubstring("bob@example.com"))
+}
+
+func TestMatchSnapshotCompactOneLiner(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv(be.UpdateSnapshotsEnv, "1")
+	be.Expect(t, "hello").To(be.MatchSnapshot("greeting", be.SnapshotDir(dir)))
+
+	t.Setenv(be.UpdateSnapshotsEnv, "")
+	rt := &recT{}
+	be.Expect(rt, "bye").To(be.MatchSnapshot("greeting", be.SnapshotDir(dir)))
+	if len(rt.errs) != 1 || rt.errs[0] != `Expected bye to match snapshot "greeting": hello` {
+		t.Fatalf("one-liners should use the compact form, got %v", rt.errs)
+	}
+}
+
+func TestMatchSnapshotKeepsBigIntegers(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv(be.UpdateSnapshotsEnv, "1")
+	be.Expect(t, `{"id": 9007199254740993, "ratio": 0.5}`).To(be.MatchSnapshot("ids", be.SnapshotDir(dir)))
+
+	contents, err := os.ReadFile(filepath.Join(dir, "ids.snap"))
+	be.NoError(t, err)
+	be.Expect(t, string(contents)).To(be.Eq("{\n  \"id\": 9007199254740993,\n  \"ratio\": 0.5\n}\n"))
+
+	// a neighbouring id (the same float64) is a mismatch
+	t.Setenv(be.UpdateSnapshotsEnv, "")
+	be.Expect(t, `{"id": 9007199254740992, "ratio": 0.5}`).NotTo(be.MatchSnapshot("ids", be.SnapshotDir(dir)))
+	be.Expect(t, map[string]any{"id": int64(9007199254740993), "ratio": 0.5}).To(be.MatchSnapshot("ids", be.SnapshotDir(dir)))
+}
+
+func TestMatchSnapshotReadsReaders(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv(be.UpdateSnapshotsEnv, "1")
+	be.Expect(t, strings.NewReader(`{"name": "Alice"}`)).To(be.MatchSnapshot("body", be.SnapshotDir(dir)))
+
+	contents, err := os.ReadFile(filepath.Join(dir, "body.snap"))
+	be.NoError(t, err)
+	be.Expect(t, string(contents)).To(be.Eq("{\n  \"name\": \"Alice\"\n}\n"))
+
+	// a reader is compared by its contents, and is explained without being read again
+	t.Setenv(be.UpdateSnapshotsEnv, "")
+	be.Expect(t, io.NopCloser(strings.NewReader(`{"name":"Alice"}`))).To(be.MatchSnapshot("body", be.SnapshotDir(dir)))
+
+	rt := &recT{}
+	be.Expect(rt, io.NopCloser(strings.NewReader(`{"name": "Bob"}`))).To(be.MatchSnapshot("body", be.SnapshotDir(dir)))
+	if len(rt.errs) != 1 || !strings.Contains(rt.errs[0], "+  \"name\": \"Bob\"") {
+		t.Fatalf("expected a unified diff of the read contents, got %v", rt.errs)
+	}
+}
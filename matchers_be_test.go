@@ -0,0 +1,129 @@
+package be_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/expectto/be"
+	"github.com/expectto/be/types"
+)
+
+func TestCountingCombinators(t *testing.T) {
+	be.Expect(t, 6).To(be.AtLeast(2, be.Gt(5), be.Lt(10), be.Eq(0)))
+	be.Expect(t, 6).NotTo(be.AtLeast(2, be.Gt(5), be.Eq(0)))
+	be.Expect(t, 6).To(be.AtMost(1, be.Gt(5), be.Eq(0)))
+	be.Expect(t, 6).NotTo(be.AtMost(1, be.Gt(5), be.Lt(10)))
+	be.Expect(t, 6).To(be.ExactlyOneOf(be.Gt(5), be.Gt(10)))
+	be.Expect(t, 11).NotTo(be.ExactlyOneOf(be.Gt(5), be.Gt(10)))
+	be.Expect(t, 1).NotTo(be.ExactlyOneOf(be.Gt(5), be.Gt(10)))
+}
+
+func TestCountingCombinatorsListBranches(t *testing.T) {
+	rt := &recT{}
+	be.Expect(rt, 6).To(be.AtLeast(2, be.Gt(5), be.Eq(0), be.Lt(3)))
+	if len(rt.errs) != 1 {
+		t.Fatalf("expected a single failure, got %v", rt.errs)
+	}
+	for _, want := range []string{
+		"satisfy at least 2 of 3 matchers, 1 matched:",
+		"matched: be > 5",
+		"failed:  Expected 6 to equal 0",
+		"failed:  Expected 6 to be < 3",
+	} {
+		if !strings.Contains(rt.errs[0], want) {
+			t.Errorf("failure should contain %q, got:\n%s", want, rt.errs[0])
+		}
+	}
+
+	rt = &recT{}
+	be.Expect(rt, 11).To(be.ExactlyOneOf(be.Gt(5), be.Gt(10)))
+	if len(rt.errs) != 1 || !strings.Contains(rt.errs[0], "satisfy exactly 1 of 2 matchers, 2 matched:") {
+		t.Fatalf("expected the matched count to be reported, got %v", rt.errs)
+	}
+
+	if got := be.ExactlyOneOf(be.Gt(5)).String(); got != "satisfy exactly 1 of 1 matchers" {
+		t.Fatalf("unexpected description: %q", got)
+	}
+}
+
+type job struct {
+	Status string
+	Error  string
+}
+
+func TestWhen(t *testing.T) {
+	failed := be.HaveField("Status", "failed")
+	hasError := be.HaveField("Error", be.NotEmpty())
+
+	be.Expect(t, job{Status: "failed", Error: "boom"}).To(be.When(failed, hasError))
+	be.Expect(t, job{Status: "done"}).To(be.When(failed, hasError)) // nothing required
+	be.Expect(t, job{Status: "failed"}).NotTo(be.When(failed, hasError))
+
+	// the else branch applies when the condition doesn't match
+	noError := be.HaveField("Error", be.Empty())
+	be.Expect(t, job{Status: "done"}).To(be.When(failed, hasError, noError))
+	be.Expect(t, job{Status: "done", Error: "stale"}).NotTo(be.When(failed, hasError, noError))
+
+	rt := &recT{}
+	be.Expect(rt, job{Status: "failed"}).To(be.When(failed, hasError))
+	if len(rt.errs) != 1 || !strings.Contains(rt.errs[0], "(condition matched: have field Status)") {
+		t.Fatalf("failure should tell the then branch was taken, got %v", rt.errs)
+	}
+
+	rt = &recT{}
+	be.Expect(rt, job{Status: "done", Error: "stale"}).To(be.When(failed, hasError, noError))
+	if len(rt.errs) != 1 || !strings.Contains(rt.errs[0], "(condition did not match: have field Status)") {
+		t.Fatalf("failure should tell the else branch was taken, got %v", rt.errs)
+	}
+}
+
+type comment struct {
+	Text    string
+	Replies []comment
+}
+
+func TestLazyValidatesRecursiveData(t *testing.T) {
+	var thread types.BeMatcher
+	thread = be.HaveFields(map[string]any{
+		"Text":    be.NotEmpty(),
+		"Replies": be.Any(be.Empty(), be.Dive(be.Lazy(func() types.BeMatcher { return thread }))),
+	})
+
+	valid := comment{Text: "a", Replies: []comment{{Text: "b", Replies: []comment{{Text: "c"}}}, {Text: "d"}}}
+	be.Expect(t, valid).To(thread)
+
+	invalid := comment{Text: "a", Replies: []comment{{Text: "b", Replies: []comment{{Text: ""}}}}}
+	be.Expect(t, invalid).NotTo(thread)
+}
+
+func TestDiveVariants(t *testing.T) {
+	be.Expect(t, []int{1, 2, 9}).To(be.DiveLast(be.Gt(5)))
+	be.Expect(t, []int{1, 9, 2}).To(be.DiveNth(-2, be.Gt(5)))
+	be.Expect(t, []int{1, 2}).To(be.DiveNone(be.Gt(5)))
+	be.Expect(t, []int{1, 7, 9}).To(be.DiveCount(2, be.Gt(5)))
+	be.Expect(t, []int{1, 7, 9}).To(be.DiveCount(be.Gte(1), be.Gt(5)))
+
+	m := map[string]int{"x-a": 1, "x-b": 2}
+	be.Expect(t, m).To(be.DiveKeys(be.ContainSubstring("x-")))
+	be.Expect(t, m).To(be.DiveEntries(be.ContainSubstring("x-"), be.Lt(3)))
+	be.Expect(t, m).NotTo(be.DiveEntries(be.ContainSubstring("x-"), be.Lt(2)))
+}
+
+func TestDiveFailuresNameTheElement(t *testing.T) {
+	rt := &recT{}
+	be.Expect(rt, []int{1, 7}).To(be.DiveNone(be.Gt(5)))
+	be.Expect(rt, []int{1, 2}).To(be.DiveAny(be.Gt(5)))
+	be.Expect(rt, map[string]int{"a": 1, "b": 9}).To(be.DiveEntries(be.Always(), be.Lt(5)))
+	if len(rt.errs) != 3 {
+		t.Fatalf("expected 3 failures, got %v", rt.errs)
+	}
+	for i, want := range []string{
+		"at [1]: Expected 7 not to be > 5",
+		"[0]: Expected 1 to be > 5\n  [1]: Expected 2 to be > 5",
+		`at ["b"]: Expected 9 to be < 5`,
+	} {
+		if !strings.Contains(rt.errs[i], want) {
+			t.Errorf("failure #%d should contain %q, got:\n%s", i, want, rt.errs[i])
+		}
+	}
+}
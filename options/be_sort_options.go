@@ -0,0 +1,19 @@
+package options
+
+// SortOrder is the order a collection is expected to be sorted in (see be.Sorted)
+type SortOrder Option
+
+const (
+	// Asc is the ascending order: each element is not less than the previous one.
+	Asc SortOrder = iota
+
+	// Desc is the descending order: each element is not greater than the previous one.
+	Desc
+)
+
+func (o SortOrder) String() string {
+	if o == Desc {
+		return "descending"
+	}
+	return "ascending"
+}
@@ -0,0 +1,147 @@
+package be
+
+// reporter.go makes failure reporting pluggable: every failed assertion is
+// described as a structured Failure and handed to a Reporter. The default
+// reporter renders it the way be always did (t.Errorf / t.Fatalf with a
+// compact message); custom ones can emit JSON lines for CI dashboards, attach
+// Ginkgo report entries, colourize terminal output, ...
+
+import (
+	"runtime"
+	"slices"
+	"strings"
+	"sync/atomic"
+)
+
+// Failure is the structured description of a failed assertion.
+type Failure struct {
+	// Actual is the value under test (the last polled value for async assertions).
+	Actual any
+	// Matcher describes the failed matcher (its gomock description). It's empty
+	// for failures that are not about a single matcher (e.g. a be.Group report).
+	Matcher string
+	// Message is the compact failure message, without the path.
+	Message string
+	// Path is the path to the nested value that failed,
+	// e.g. `request.body.json["details"][1]`. Empty for the actual value itself.
+	Path string
+	// Context is the optional assertion message given via msgAndArgs.
+	Context string
+	// File and Line locate the assertion in the test code.
+	File string
+	Line int
+	// Negated is true for NotTo assertions.
+	Negated bool
+	// Fatal is true for hard assertions (be.Require, be.NoError, ...):
+	// the reporter is expected to stop the test (t.Fatalf).
+	Fatal bool
+	// Err is set when the matcher could not evaluate the actual value at all.
+	Err error
+}
+
+// String renders the failure the way the default reporter does:
+//
+//	<context>: at <path>: <message>
+func (f Failure) String() string {
+	msg := f.Message
+	if f.Path != "" {
+		msg = "at " + f.Path + ": " + msg
+	}
+	if f.Context != "" {
+		msg = f.Context + ": " + msg
+	}
+	return msg
+}
+
+// Reporter reports failed assertions into the test. A reporter is responsible
+// for failing the test: one that only logs the failure makes assertions pass.
+// To add an output on top of the default behaviour, delegate to DefaultReporter:
+//
+//	be.SetReporter(be.ReporterFunc(func(t be.TestingT, f be.Failure) {
+//		t.Helper()
+//		_ = json.NewEncoder(dashboard).Encode(f)
+//		be.DefaultReporter().Report(t, f)
+//	}))
+type Reporter interface {
+	Report(t TestingT, f Failure)
+}
+
+// ReporterFunc is a function that is a Reporter.
+type ReporterFunc func(t TestingT, f Failure)
+
+// Report calls fn(t, f).
+func (fn ReporterFunc) Report(t TestingT, f Failure) {
+	t.Helper()
+	fn(t, f)
+}
+
+// testingReporter is the default reporter: t.Errorf or t.Fatalf with the rendered failure.
+type testingReporter struct{}
+
+func (testingReporter) Report(t TestingT, f Failure) {
+	t.Helper()
+	if f.Fatal {
+		t.Fatalf("%s", f)
+	} else {
+		t.Errorf("%s", f)
+	}
+}
+
+// DefaultReporter returns the reporter be uses unless another one is set:
+// it fails the test via t.Errorf (or t.Fatalf for hard assertions) with the
+// failure rendered by Failure.String.
+func DefaultReporter() Reporter { return testingReporter{} }
+
+var globalReporter atomic.Pointer[Reporter]
+
+// SetReporter sets the reporter used by every assertion that has no reporter
+// of its own (see Expectation.WithReporter). A nil reporter restores the
+// default one. The returned func restores the previous reporter:
+//
+//	func TestMain(m *testing.M) {
+//		be.SetReporter(jsonLinesReporter)
+//		os.Exit(m.Run())
+//	}
+func SetReporter(r Reporter) (restore func()) {
+	var p *Reporter
+	if r != nil {
+		p = &r
+	}
+	prev := globalReporter.Swap(p)
+	return func() { globalReporter.Store(prev) }
+}
+
+func currentReporter() Reporter {
+	if r := globalReporter.Load(); r != nil {
+		return *r
+	}
+	return DefaultReporter()
+}
+
+// caller locates the assertion in the test code: the first frame outside be itself.
+func caller() (file string, line int) {
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+	for {
+		frame, more := frames.Next()
+		if !isBeFrame(frame.Function) {
+			return frame.File, frame.Line
+		}
+		if !more {
+			return "", 0
+		}
+	}
+}
+
+// bePackages are the packages whose frames are skipped when locating an assertion
+var bePackages = []string{"github.com/expectto/be", "github.com/expectto/be/typed"}
+
+func isBeFrame(function string) bool {
+	// function is e.g. "github.com/expectto/be.(*Expectation).To"
+	lastSlash := strings.LastIndex(function, "/")
+	dot := strings.Index(function[lastSlash+1:], ".")
+	if dot < 0 {
+		return false
+	}
+	return slices.Contains(bePackages, function[:lastSlash+1+dot])
+}
@@ -0,0 +1,92 @@
+package be_test
+
+import (
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/expectto/be"
+	"github.com/expectto/be/be_json"
+	"github.com/expectto/be/typed"
+)
+
+// recReporter records reported failures instead of failing the test
+type recReporter struct {
+	failures []be.Failure
+}
+
+func (r *recReporter) Report(_ be.TestingT, f be.Failure) {
+	r.failures = append(r.failures, f)
+}
+
+// line returns the line it's called at
+func line() int {
+	_, _, l, _ := runtime.Caller(1)
+	return l
+}
+
+func TestReporterReceivesStructuredFailure(t *testing.T) {
+	r := &recReporter{}
+	actual := map[string]any{"user": map[string]any{"age": 16.0}}
+
+	l := line() + 1
+	be.Require(t, actual).WithReporter(r).To(be_json.HaveKeyValue("user", be_json.HaveKeyValue("age", be.Gte(18))), "user %d", 1)
+
+	if len(r.failures) != 1 {
+		t.Fatalf("expected a single failure, got %d", len(r.failures))
+	}
+	f := r.failures[0]
+	be.Expect(t, f.Actual).To(be.Eq(actual))
+	be.Expect(t, f.Message).To(be.Eq("Expected 16 to be >= 18"))
+	be.Expect(t, f.Path).To(be.Eq(`["user"]["age"]`))
+	be.Expect(t, f.Context).To(be.Eq("user 1"))
+	be.Expect(t, f.Matcher).To(be.NotEmpty())
+	be.Expect(t, filepath.Base(f.File)).To(be.Eq("reporter_test.go"))
+	be.Expect(t, f.Line).To(be.Eq(l))
+	be.Expect(t, f.Fatal).To(be.True())
+	be.Expect(t, f.Negated).To(be.False())
+	be.Expect(t, f.String()).To(be.Eq(`user 1: at ["user"]["age"]: Expected 16 to be >= 18`))
+}
+
+func TestSetReporter(t *testing.T) {
+	r := &recReporter{}
+	restore := be.SetReporter(r)
+
+	be.Expect(t, 1).NotTo(be.Eq(1))
+	l := line() + 1
+	typed.Expect(t, 1).To(typed.Eq(2))
+	be.Group(t, func(g *be.G) {}) // nothing to report
+
+	restore()
+
+	if len(r.failures) != 2 {
+		t.Fatalf("expected 2 failures, got %d", len(r.failures))
+	}
+	be.Expect(t, r.failures[0].Negated).To(be.True())
+	be.Expect(t, r.failures[0].String()).To(be.Eq("Expected 1 not to equal 1"))
+	// typed assertions are located at the call site as well
+	be.Expect(t, filepath.Base(r.failures[1].File)).To(be.Eq("reporter_test.go"))
+	be.Expect(t, r.failures[1].Line).To(be.Eq(l))
+
+	// the default reporter is back
+	rt := &recT{}
+	be.Expect(rt, 1).To(be.Eq(2))
+	if len(rt.errs) != 1 || rt.errs[0] != "Expected 1 to equal 2" {
+		t.Fatalf("default reporter is not restored: %v", rt.errs)
+	}
+}
+
+func TestReporterFuncDelegatingToDefault(t *testing.T) {
+	var seen []be.Failure
+	r := be.ReporterFunc(func(t be.TestingT, f be.Failure) {
+		t.Helper()
+		seen = append(seen, f)
+		be.DefaultReporter().Report(t, f)
+	})
+
+	rt := &recT{}
+	be.Expect(rt, "a").WithReporter(r).To(be.Eq("b"))
+	if len(seen) != 1 || len(rt.errs) != 1 || rt.errs[0] != seen[0].String() {
+		t.Fatalf("unexpected reports: seen=%v errs=%v", seen, rt.errs)
+	}
+}
@@ -0,0 +1,56 @@
+// Package typed is the compile-time typed layer over be: the actual value and
+// the matchers share a type parameter, so a type mismatch is a compile error
+// instead of a runtime failure:
+//
+//	var n int
+//	typed.Expect(t, n).To(typed.Eq(1))        // ok
+//	typed.Expect(t, n).To(typed.Eq(int64(1))) // does not compile
+//
+// Typed matchers are regular types.BeMatcher values, so they work with
+// be.Expect, gomega and gomock as well. Any untyped matcher is brought into
+// the typed world via Of:
+//
+//	typed.Expect(t, name).To(typed.Of[string](be_string.NonEmptyString()))
+package typed
+
+import (
+	"github.com/expectto/be"
+)
+
+// Expectation is an assertion on a value of type T, produced by Expect or Require.
+type Expectation[T any] struct {
+	t TestingT
+	e *be.Expectation
+}
+
+// TestingT is the minimal subset of *testing.T the assertions need (see be.TestingT).
+type TestingT = be.TestingT
+
+// Expect begins a soft assertion on a value of type T (see be.Expect).
+func Expect[T any](t TestingT, actual T) *Expectation[T] {
+	return &Expectation[T]{t: t, e: be.Expect(t, actual)}
+}
+
+// Require begins a hard assertion on a value of type T (see be.Require).
+func Require[T any](t TestingT, actual T) *Expectation[T] {
+	return &Expectation[T]{t: t, e: be.Require(t, actual)}
+}
+
+// To asserts that actual satisfies the matcher. An optional message provides
+// failure context (see be.Expectation.To). Returns true on success.
+func (e *Expectation[T]) To(matcher Matcher[T], msgAndArgs ...any) bool {
+	e.t.Helper()
+	return e.e.To(matcher, msgAndArgs...)
+}
+
+// NotTo asserts that actual does NOT satisfy the matcher.
+func (e *Expectation[T]) NotTo(matcher Matcher[T], msgAndArgs ...any) bool {
+	e.t.Helper()
+	return e.e.NotTo(matcher, msgAndArgs...)
+}
+
+// ToNot is an alias for NotTo.
+func (e *Expectation[T]) ToNot(matcher Matcher[T], msgAndArgs ...any) bool {
+	e.t.Helper()
+	return e.e.NotTo(matcher, msgAndArgs...)
+}
@@ -0,0 +1,135 @@
+package typed
+
+import (
+	"cmp"
+
+	"github.com/expectto/be"
+	"github.com/expectto/be/internal/psi"
+	"github.com/expectto/be/types"
+)
+
+// Matcher is a matcher of values of type T. It's a full types.BeMatcher, so
+// typed matchers can be passed wherever untyped ones are accepted.
+type Matcher[T any] interface {
+	types.BeMatcher
+
+	// matches binds the matcher to T: Matcher[int] and Matcher[int64] are different types
+	matches(T)
+}
+
+// matcher binds an untyped matcher to T
+type matcher[T any] struct {
+	types.BeMatcher
+}
+
+func (matcher[T]) matches(T) {}
+
+// Explain forwards to the wrapped matcher, so the binding keeps it evaluated in a single step
+func (m matcher[T]) Explain(actual any) types.Outcome {
+	return psi.Explain(m.BeMatcher, actual)
+}
+
+// LastOutcome recalls the last evaluation of the wrapped matcher (if it remembers one)
+func (m matcher[T]) LastOutcome(actual any) (types.Outcome, bool) {
+	return psi.LastOutcome(m.BeMatcher, actual)
+}
+
+// Children exposes the children of the wrapped matcher (if it's a composite one),
+// so be.Check sees through the binding
+func (m matcher[T]) Children(actual any) []types.Child {
+	if cm, ok := m.BeMatcher.(types.CompositeMatcher); ok {
+		return cm.Children(actual)
+	}
+	return nil
+}
+
+// NewPollSession starts the poll session of the wrapped matcher (if it consumes actual),
+// so be.Eventually sees through the binding
+func (m matcher[T]) NewPollSession() types.BeMatcher {
+	return matcher[T]{psi.PollSession(m.BeMatcher)}
+}
+
+// Of adapts an untyped matcher (a be/gomega/gomock matcher or a raw value,
+// as anywhere in be) into a matcher of T:
+//
+//	typed.Expect(t, age).To(typed.Of[int](be_math.Odd()))
+//
+// The type is not checked at compile time here: it's the caller who states
+// that the untyped matcher is meant for T.
+func Of[T any](m any) Matcher[T] {
+	if tm, ok := m.(Matcher[T]); ok {
+		return tm
+	}
+	return matcher[T]{psi.Psi(m)}
+}
+
+// Func builds a matcher of T from a match function (see be.MatcherFunc).
+func Func[T any](name string, match func(actual T) (bool, error), opts ...be.MatcherFuncOption) Matcher[T] {
+	return matcher[T]{be.MatcherFunc(name, match, opts...)}
+}
+
+// Eq succeeds if actual equals expected by value (deep equality, see be.Eq).
+func Eq[T any](expected T) Matcher[T] { return matcher[T]{be.Eq(expected)} }
+
+// Ne succeeds if actual does not equal expected by value.
+func Ne[T any](expected T) Matcher[T] { return Not(Eq(expected)) }
+
+// Gt succeeds if actual is greater than arg.
+// Unlike be.Gt, it works for any ordered type, strings included.
+func Gt[T cmp.Ordered](arg T) Matcher[T] { return compare(">", arg, 1) }
+
+// Gte succeeds if actual is greater than or equal to arg.
+func Gte[T cmp.Ordered](arg T) Matcher[T] { return compare(">=", arg, 1, 0) }
+
+// Lt succeeds if actual is less than arg.
+func Lt[T cmp.Ordered](arg T) Matcher[T] { return compare("<", arg, -1) }
+
+// Lte succeeds if actual is less than or equal to arg.
+func Lte[T cmp.Ordered](arg T) Matcher[T] { return compare("<=", arg, -1, 0) }
+
+// compare builds an ordering matcher that succeeds if cmp.Compare(actual, arg) is one of given results
+func compare[T cmp.Ordered](op string, arg T, results ...int) Matcher[T] {
+	return Func("be "+op, func(actual T) (bool, error) {
+		c := cmp.Compare(actual, arg)
+		for _, r := range results {
+			if c == r {
+				return true, nil
+			}
+		}
+		return false, nil
+	}, be.WithExpected(arg))
+}
+
+// Not succeeds if the given matcher does not.
+func Not[T any](m Matcher[T]) Matcher[T] { return matcher[T]{be.Not(m)} }
+
+// All succeeds if all the given matchers succeed.
+func All[T any](ms ...Matcher[T]) Matcher[T] { return matcher[T]{be.All(untyped(ms)...)} }
+
+// Any succeeds if at least one of the given matchers succeeds.
+func Any[T any](ms ...Matcher[T]) Matcher[T] { return matcher[T]{be.Any(untyped(ms)...)} }
+
+// SliceOf succeeds if actual has exactly one element per given matcher, and
+// each element matches its matcher (in order):
+//
+//	typed.Expect(t, ids).To(typed.SliceOf(typed.Eq(1), typed.Gt(1)))
+//
+// A failure points at the failing element, e.g. `at [1]: Expected 0 to be > 1`.
+func SliceOf[T any](ms ...Matcher[T]) Matcher[[]T] {
+	group := []any{be.HaveLength(len(ms))}
+	for i, m := range ms {
+		group = append(group, be.DiveNth(i, m))
+	}
+	return matcher[[]T]{be.All(group...)}
+}
+
+// Each succeeds if every element of actual matches the given matcher (see be.Dive).
+func Each[T any](m Matcher[T]) Matcher[[]T] { return matcher[[]T]{be.Dive(m)} }
+
+func untyped[T any](ms []Matcher[T]) []any {
+	result := make([]any, len(ms))
+	for i, m := range ms {
+		result[i] = m
+	}
+	return result
+}
@@ -0,0 +1,93 @@
+package typed_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/expectto/be"
+	"github.com/expectto/be/be_math"
+	"github.com/expectto/be/typed"
+	"github.com/expectto/be/types"
+)
+
+// recT records failures instead of failing the test (see be's expect_test.go)
+type recT struct {
+	errs   []string
+	fatals []string
+}
+
+func (r *recT) Helper() {}
+func (r *recT) Errorf(format string, args ...any) {
+	r.errs = append(r.errs, fmt.Sprintf(format, args...))
+}
+func (r *recT) Fatalf(format string, args ...any) {
+	r.fatals = append(r.fatals, fmt.Sprintf(format, args...))
+}
+
+func TestTypedExpect(t *testing.T) {
+	typed.Expect(t, 5).To(typed.Eq(5))
+	typed.Expect(t, 5).NotTo(typed.Ne(5))
+	typed.Expect(t, "b").To(typed.All(typed.Gt("a"), typed.Lte("b")))
+	typed.Expect(t, 3.5).To(typed.Any(typed.Lt(1.0), typed.Gte(3.5)))
+	typed.Expect(t, []int{1, 2}).To(typed.Eq([]int{1, 2}))
+
+	rt := &recT{}
+	typed.Expect(rt, 3).To(typed.Gt(5))
+	typed.Require(rt, "x").To(typed.Eq("y"))
+	if len(rt.errs) != 1 || rt.errs[0] != "Expected 3 to be > 5" {
+		t.Fatalf("unexpected soft failures: %v", rt.errs)
+	}
+	if len(rt.fatals) != 1 || rt.fatals[0] != "Expected x to equal y" {
+		t.Fatalf("unexpected hard failures: %v", rt.fatals)
+	}
+}
+
+func TestTypedOf(t *testing.T) {
+	// untyped matchers are adapted into the typed world...
+	typed.Expect(t, 7).To(typed.Of[int](be_math.Odd()))
+	typed.Expect(t, 7).To(typed.All(typed.Of[int](be.Gt(5)), typed.Lt(10)))
+
+	// ...and typed matchers are usable wherever untyped ones are
+	be.Expect(t, 7).To(be.All(typed.Gt(5), be_math.Odd()))
+
+	// untyped callers still get the actual type checked, at runtime
+	if _, err := typed.Gt(5).Match("a"); err == nil {
+		t.Fatalf("a mismatching actual type must be an error")
+	}
+}
+
+func TestTypedSliceOf(t *testing.T) {
+	typed.Expect(t, []int{1, 2, 3}).To(typed.SliceOf(typed.Eq(1), typed.Gt(1), typed.Of[int](be_math.Odd())))
+	typed.Expect(t, []int{1, 2, 3}).NotTo(typed.SliceOf(typed.Eq(1)))
+	typed.Expect(t, []int{2, 4}).To(typed.Each(typed.Of[int](be_math.Even())))
+
+	rt := &recT{}
+	typed.Expect(rt, []int{1, 0}).To(typed.SliceOf(typed.Eq(1), typed.Gt(1)))
+	if len(rt.errs) != 1 || rt.errs[0] != "at [1]: Expected 0 to be > 1" {
+		t.Fatalf("failure must point at the failing element: %v", rt.errs)
+	}
+}
+
+func TestTypedCheckSeesThroughBinding(t *testing.T) {
+	res := be.Check([]int{1, 0}, typed.SliceOf(typed.Eq(1), typed.Gt(1)))
+	failures := res.Failures()
+	if len(failures) != 1 || failures[0].Path != "[1]" {
+		t.Fatalf("unexpected failures: %+v", failures)
+	}
+}
+
+func TestTypedBindingIsTransparent(t *testing.T) {
+	m := typed.Of[[]int](be.All(be.HaveLength(2), be.Dive(be.Gt(0))))
+	if _, ok := m.(types.ExplainingMatcher); !ok {
+		t.Fatalf("a typed matcher must explain via the wrapped matcher")
+	}
+
+	res := be.Check([]int{1, 0}, m)
+	if len(res.Children) != 2 {
+		t.Fatalf("the children of the wrapped matcher must be forwarded: %+v", res.Children)
+	}
+	failures := res.Failures()
+	if len(failures) != 1 || failures[0].Path != "[1]" {
+		t.Fatalf("unexpected failures: %+v", failures)
+	}
+}
@@ -10,9 +10,8 @@ type GomockMatcher interface {
 }
 
 // GomegaMatcher represents a matcher compatible with the Gomega library.
-// We intentionally refrain from using the GomegaMatcher interface from the Gomega library.
-// Although the Gomega library is still utilized as a dependency in other parts of `be`,
-// and is likely to remain as a dependency for the time being.
+// We intentionally refrain from using the GomegaMatcher interface from the Gomega library
+// to prevent direct dependency on the Gomega library.
 // Interface source: https://github.com/onsi/gomega/blob/master/types/types.go#L37
 type GomegaMatcher interface {
 	Match(actual any) (success bool, err error)
@@ -27,3 +26,54 @@ type BeMatcher interface {
 	GomegaMatcher
 	GomockMatcher
 }
+
+// Child is a sub-matcher of a composite matcher, along with the value it is applied to.
+// Segment is the path segment leading to that value (e.g. "body", `["key"]`, `[1]`),
+// it's empty when the child is applied to the same value as its parent.
+type Child struct {
+	Segment string
+	Matcher BeMatcher
+	Actual  any
+}
+
+// CompositeMatcher is implemented by matchers made of other matchers (All, Any, Dive, ...).
+// Children exposes the sub-matchers evaluated for the given actual value,
+// so tools (e.g. be.Check) can render the whole evaluation tree, not only the final message.
+type CompositeMatcher interface {
+	Children(actual any) []Child
+}
+
+// Outcome is the result of a single matcher evaluation, explanation included.
+type Outcome struct {
+	Success bool
+	// Err is set when the matcher could not evaluate the actual value at all.
+	// A non-nil Err always comes with !Success.
+	Err error
+	// Message explains the outcome: it's the failure message when the match failed,
+	// the negated failure message when it succeeded (i.e. why a negated assertion fails),
+	// and the error text when the matcher errored
+	Message string
+}
+
+// ExplainingMatcher is implemented by matchers that evaluate and explain in a single call.
+// Unlike Match followed by FailureMessage, Explain keeps no per-call state on the matcher,
+// so a matcher instance can be shared across goroutines (parallel subtests, gomock calls, ...).
+type ExplainingMatcher interface {
+	Explain(actual any) Outcome
+}
+
+// RememberingMatcher is implemented by matchers whose evaluation has side effects
+// (e.g. receiving from a channel or reading a reader). They remember the outcome of their
+// last evaluation of actual, so explaining it again (FailureMessage after Match, be.Check
+// walking the evaluation tree) recalls it instead of repeating the side effects.
+type RememberingMatcher interface {
+	LastOutcome(actual any) (Outcome, bool)
+}
+
+// PollingMatcher is implemented by matchers whose evaluation consumes actual
+// (e.g. receiving from a channel). be.Eventually and be.Consistently evaluate
+// through a session the matcher starts for them: the session keeps what was
+// consumed by the previous polls, so no value is lost between them.
+type PollingMatcher interface {
+	NewPollSession() BeMatcher
+}
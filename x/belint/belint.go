@@ -326,6 +326,24 @@ func rewriteCall(pass *analysis.Pass, e *ast.CallExpr, want bool, qual string) (
 			matcher: maybeNot(fmt.Sprintf("%s.ContainElement(%s)", qual, render(pass, e.Args[1]))),
 			fixable: true,
 		}, true
+	case "slices.IsSorted":
+		if len(e.Args) != 1 {
+			return rewrite{}, false
+		}
+		return rewrite{
+			actual:  render(pass, e.Args[0]),
+			matcher: maybeNot(fmt.Sprintf("%s.Sorted()", qual)),
+			fixable: true,
+		}, true
+	case "slices.IsSortedFunc":
+		if len(e.Args) != 2 {
+			return rewrite{}, false
+		}
+		return rewrite{
+			actual:  render(pass, e.Args[0]),
+			matcher: maybeNot(fmt.Sprintf("%s.SortedBy(%s)", qual, render(pass, e.Args[1]))),
+			fixable: true,
+		}, true
 	case "strings.Contains":
 		if len(e.Args) != 2 {
 			return rewrite{}, false
@@ -46,9 +46,13 @@ func nils(t be.TestingT, err error) {
 	be.AssertThat(t, nil != err, be.True()) // want `prefer be\.NotNil\(\)`
 }
 
+func byValue(a, b int) int { return a - b }
+
 func stdlibIdioms(t be.TestingT, xs []int, x int, s string, err error) {
 	be.AssertThat(t, slices.Contains(xs, x), be.True())      // want `prefer be\.ContainElement\(x\)`
 	be.AssertThat(t, slices.Contains(xs, x), be.False())     // want `prefer be\.Not\(be\.ContainElement\(x\)\)`
+	be.AssertThat(t, slices.IsSorted(xs), be.True()) // want `prefer be\.Sorted\(\)`
+	be.AssertThat(t, slices.IsSortedFunc(xs, byValue), be.False()) // want `prefer be\.Not\(be\.SortedBy\(byValue\)\)`
 	be.AssertThat(t, strings.Contains(s, "ell"), be.True())  // want `prefer be\.ContainSubstring\("ell"\)`
 	be.AssertThat(t, errors.Is(err, errSentinel), be.True()) // want `prefer be\.MatchError\(errSentinel\)`
 	be.AssertThat(t, errors.Is(err, errSentinel), be.False()) // want `prefer be\.Not\(be\.MatchError\(errSentinel\)\)`
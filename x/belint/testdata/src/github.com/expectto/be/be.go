@@ -41,6 +41,8 @@ func Not(matcher any) BeMatcher           { return stub{} }
 func HaveLength(args ...any) BeMatcher    { return stub{} }
 func ContainElement(v any) BeMatcher      { return stub{} }
 func ContainSubstring(s string) BeMatcher { return stub{} }
+func Sorted(order ...any) BeMatcher       { return stub{} }
+func SortedBy(cmp any) BeMatcher          { return stub{} }
 func MatchError(expected any) BeMatcher   { return stub{} }
 func HaveKey(k any) BeMatcher             { return stub{} }
 func Gt(v any) BeMatcher                  { return stub{} }